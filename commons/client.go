@@ -2,27 +2,52 @@ package commons
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"log"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 )
 
-// Client is the means of connecting to the Fpt cloud API service
+// Client is the means of connecting to the Fpt cloud API service. It is shared by the resources Terraform
+// operates in parallel, so once configured it keeps no per-request state: the metadata of each response is
+// returned to its caller by Do.
 type Client struct {
-	BaseURL          *url.URL
-	UserAgent        string
-	APIKey           string
-	TenantName       string
-	Region           string
-	LastJSONResponse string
-	Timeout          int
+	BaseURL *url.URL
+	// UserAgent is read under the client lock, use SetUserAgent to change it once the client is in use
+	UserAgent    string
+	APIKey       string
+	TenantName   string
+	Region       string
+	Timeout      int
+	MaxRetries   int
+	RetryMaxWait time.Duration
+	// LookupCacheTTL is how long the responses of catalog endpoints are reused, zero disables the cache
+	LookupCacheTTL time.Duration
+	Tags           TagConfig
+	// VpcId is the provider vpc_id the resources without one fall back to
+	VpcId string
+	// ReadOnly refuses the requests that may change the infrastructure, for plans run with production credentials
+	ReadOnly bool
+
+	mu          sync.RWMutex
+	httpClient  *http.Client
+	lookupCache *lookupCache
+}
 
-	httpClient *http.Client
+// Response is the metadata and the body of an API response, returned per call
+type Response struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+	// RequestID is the X-Request-ID sent with the request
+	RequestID string
 }
 
 // Component is a struct to define a User-Agent from a client
@@ -60,6 +85,11 @@ func (e HTTPError) Error() string {
 	return fmt.Sprintf("%d: %s, %s", e.Code, e.Status, e.Reason)
 }
 
+// Is matches the same error kinds as the APIError parsed from the response
+func (e HTTPError) Is(target error) bool {
+	return NewAPIError(e).Is(target)
+}
+
 // NewClientWithURL initializes a Client with a specific API URL
 func NewClientWithURL(apiKey, apiUrl, region string, tenantName string, timeout int) (*Client, error) {
 	parsedURL, err := url.Parse(apiUrl)
@@ -67,65 +97,157 @@ func NewClientWithURL(apiKey, apiUrl, region string, tenantName string, timeout
 		return nil, err
 	}
 
-	var httpTransport = &http.Transport{
-		Proxy: http.ProxyFromEnvironment,
+	httpTransport, err := SharedTransport(TransportConfig{})
+	if err != nil {
+		return nil, err
 	}
 
 	client := &Client{
-		BaseURL:    parsedURL,
-		APIKey:     apiKey,
-		Region:     region,
-		TenantName: tenantName,
-		Timeout:    timeout,
+		BaseURL:        parsedURL,
+		APIKey:         apiKey,
+		Region:         region,
+		TenantName:     tenantName,
+		Timeout:        timeout,
+		MaxRetries:     DefaultMaxRetries,
+		RetryMaxWait:   DefaultRetryMaxWait,
+		LookupCacheTTL: DefaultLookupCacheTTL,
 		httpClient: &http.Client{
 			Transport: httpTransport,
 			Timeout:   time.Duration(timeout) * time.Minute,
 		},
+		lookupCache: newLookupCache(),
 	}
 	return client, nil
 }
 
+// ConfigureTransport replaces the client transport with the shared one matching the given TLS and proxy settings
+func (c *Client) ConfigureTransport(config TransportConfig) error {
+	transport, err := SharedTransport(config)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	httpClient := *c.httpClient
+	httpClient.Transport = transport
+	c.httpClient = &httpClient
+	return nil
+}
+
 func (c *Client) PrepareClientURL(requestURL string) *url.URL {
 	u, _ := url.Parse(c.BaseURL.String() + requestURL)
 	return u
 }
 
+// SendRequest sends an authenticated request to the API server and returns the response body
 func (c *Client) SendRequest(req *http.Request) ([]byte, error) {
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// Do sends an authenticated request to the API server, retrying it when allowed, and returns the
+// status, headers and body of the response. A response is also returned along with the HTTPError
+// of a failed request.
+func (c *Client) Do(req *http.Request) (*Response, error) {
+	if c.ReadOnly && isMutating(req) {
+		return nil, ReadOnlyError.Wrap(fmt.Errorf("refusing %s %s as the provider is read-only, unset read_only or FPTCLOUD_READ_ONLY to change the infrastructure", req.Method, req.URL.Path))
+	}
+
+	c.mu.RLock()
+	userAgent := c.UserAgent
+	httpClient := c.httpClient
+	c.mu.RUnlock()
+
 	req.Header.Set("Accept", "application/json")
-	req.Header.Set("User-Agent", c.UserAgent)
+	req.Header.Set("User-Agent", userAgent)
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.APIKey))
 
-	c.httpClient.Transport = &http.Transport{
-		DisableCompression: false,
-	}
-
 	if req.Method == "GET" || req.Method == "DELETE" {
 		// add the region param
 		param := req.URL.Query()
 		req.URL.RawQuery = param.Encode()
 	}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
+	if c.lookupCache != nil && isMutating(req) {
+		// Purged again once done, so that a lookup racing with the change is not kept
+		c.lookupCache.purge()
+		defer c.lookupCache.purge()
 	}
-	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
-	c.LastJSONResponse = string(body)
+	ctx := newHTTPLogContext(req.Context())
+	requestID := ensureRequestID(req)
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			if err := rewindBody(req); err != nil {
+				return nil, err
+			}
+		}
+
+		logRequest(ctx, req, requestID, attempt)
+		start := time.Now()
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			logResponse(ctx, req, requestID, 0, nil, time.Since(start), err)
+			if c.canRetry(req, attempt) && shouldRetryError(req.Method, err) {
+				if sleepErr := c.waitBeforeRetry(req, attempt, ""); sleepErr != nil {
+					return nil, err
+				}
+				continue
+			}
+			return nil, err
+		}
 
-	if resp.StatusCode >= 300 {
-		return nil, HTTPError{Code: resp.StatusCode, Status: resp.Status, Reason: string(body)}
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		logResponse(ctx, req, requestID, resp.StatusCode, body, time.Since(start), err)
+		response := &Response{StatusCode: resp.StatusCode, Header: resp.Header, Body: body, RequestID: requestID}
+
+		if resp.StatusCode >= 300 {
+			if c.canRetry(req, attempt) && shouldRetryStatus(req.Method, resp.StatusCode) {
+				if sleepErr := c.waitBeforeRetry(req, attempt, resp.Header.Get("Retry-After")); sleepErr == nil {
+					continue
+				}
+			}
+			return response, HTTPError{Code: resp.StatusCode, Status: resp.Status, Reason: string(body)}
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return response, nil
 	}
+}
 
-	return body, err
+// canRetry reports whether another attempt is allowed for the request
+func (c *Client) canRetry(req *http.Request, attempt int) bool {
+	if attempt >= c.MaxRetries {
+		return false
+	}
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// waitBeforeRetry sleeps for the backoff of the given attempt, aborting when the request context is done
+func (c *Client) waitBeforeRetry(req *http.Request, attempt int, retryAfter string) error {
+	wait := retryWait(attempt, retryAfter, c.RetryMaxWait)
+	log.Printf("[DEBUG] Retrying %s %s in %s (attempt %d of %d)", req.Method, req.URL.Path, wait, attempt+1, c.MaxRetries)
+	return SleepWithContext(req.Context(), wait)
 }
 
 // SendGetRequest sends a correctly authenticated get request to the API server
 func (c *Client) SendGetRequest(requestURL string) ([]byte, error) {
+	return c.SendGetRequestWithContext(context.Background(), requestURL)
+}
+
+// SendGetRequestWithContext sends a correctly authenticated get request to the API server, bound to ctx
+func (c *Client) SendGetRequestWithContext(ctx context.Context, requestURL string) ([]byte, error) {
 	u := c.PrepareClientURL(requestURL)
-	req, err := http.NewRequest("GET", u.String(), nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -135,12 +257,17 @@ func (c *Client) SendGetRequest(requestURL string) ([]byte, error) {
 
 // SendPostRequest sends a correctly authenticated post request to the API server
 func (c *Client) SendPostRequest(requestURL string, params interface{}) ([]byte, error) {
+	return c.SendPostRequestWithContext(context.Background(), requestURL, params)
+}
+
+// SendPostRequestWithContext sends a correctly authenticated post request to the API server, bound to ctx
+func (c *Client) SendPostRequestWithContext(ctx context.Context, requestURL string, params interface{}) ([]byte, error) {
 	u := c.PrepareClientURL(requestURL)
 
 	// we create a new buffer and encode everything to json to send it in the request
 	jsonValue, _ := json.Marshal(params)
 
-	req, err := http.NewRequest("POST", u.String(), bytes.NewBuffer(jsonValue))
+	req, err := http.NewRequestWithContext(ctx, "POST", u.String(), bytes.NewBuffer(jsonValue))
 	if err != nil {
 		return nil, err
 	}
@@ -149,12 +276,17 @@ func (c *Client) SendPostRequest(requestURL string, params interface{}) ([]byte,
 
 // SendPutRequest sends a correctly authenticated put request to the API server
 func (c *Client) SendPutRequest(requestURL string, params interface{}) ([]byte, error) {
+	return c.SendPutRequestWithContext(context.Background(), requestURL, params)
+}
+
+// SendPutRequestWithContext sends a correctly authenticated put request to the API server, bound to ctx
+func (c *Client) SendPutRequestWithContext(ctx context.Context, requestURL string, params interface{}) ([]byte, error) {
 	u := c.PrepareClientURL(requestURL)
 
 	// we create a new buffer and encode everything to json to send it in the request
 	jsonValue, _ := json.Marshal(params)
 
-	req, err := http.NewRequest("PUT", u.String(), bytes.NewBuffer(jsonValue))
+	req, err := http.NewRequestWithContext(ctx, "PUT", u.String(), bytes.NewBuffer(jsonValue))
 	if err != nil {
 		return nil, err
 	}
@@ -163,8 +295,13 @@ func (c *Client) SendPutRequest(requestURL string, params interface{}) ([]byte,
 
 // SendDeleteRequest sends a correctly authenticated delete request to the API server
 func (c *Client) SendDeleteRequest(requestURL string) ([]byte, error) {
+	return c.SendDeleteRequestWithContext(context.Background(), requestURL)
+}
+
+// SendDeleteRequestWithContext sends a correctly authenticated delete request to the API server, bound to ctx
+func (c *Client) SendDeleteRequestWithContext(ctx context.Context, requestURL string) ([]byte, error) {
 	u := c.PrepareClientURL(requestURL)
-	req, err := http.NewRequest("DELETE", u.String(), nil)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", u.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -173,12 +310,17 @@ func (c *Client) SendDeleteRequest(requestURL string) ([]byte, error) {
 
 // SendDeleteRequestWithBody sends a correctly authenticated delete request to the API server
 func (c *Client) SendDeleteRequestWithBody(requestURL string, params interface{}) ([]byte, error) {
+	return c.SendDeleteRequestWithBodyWithContext(context.Background(), requestURL, params)
+}
+
+// SendDeleteRequestWithBodyWithContext sends a correctly authenticated delete request with a body to the API server, bound to ctx
+func (c *Client) SendDeleteRequestWithBodyWithContext(ctx context.Context, requestURL string, params interface{}) ([]byte, error) {
 	u := c.PrepareClientURL(requestURL)
 
 	// we create a new buffer and encode everything to json to send it in the request
 	jsonValue, _ := json.Marshal(params)
 
-	req, err := http.NewRequest("DELETE", u.String(), bytes.NewBuffer(jsonValue))
+	req, err := http.NewRequestWithContext(ctx, "DELETE", u.String(), bytes.NewBuffer(jsonValue))
 	if err != nil {
 		return nil, err
 	}
@@ -186,8 +328,11 @@ func (c *Client) SendDeleteRequestWithBody(requestURL string, params interface{}
 	return c.SendRequest(req)
 }
 
-// SetUserAgent sets the user agent for the client
+// SetUserAgent prepends a component to the user agent of the client
 func (c *Client) SetUserAgent(component *Component) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	if component.ID == "" {
 		c.UserAgent = fmt.Sprintf("%s/%s %s", component.Name, component.Version, c.UserAgent)
 	} else {
@@ -1,8 +1,14 @@
 package commons
 
 import (
-	"github.com/stretchr/testify/assert"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestNewClientWithURL_ValidURL(t *testing.T) {
@@ -103,3 +109,123 @@ func TestDecodeSimpleResponse_ValidResponse(t *testing.T) {
 	assert.Equal(t, "success", simpleResp.Data)
 	assert.Equal(t, "ok", simpleResp.Status)
 }
+
+func TestSendGetRequestWithContext_CanceledContext(t *testing.T) {
+	client, server, err := NewClientForTesting(map[string]string{
+		"/test": `{"data": "success"}`,
+	})
+	assert.NoError(t, err)
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	resp, err := client.SendGetRequestWithContext(ctx, "/test")
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Nil(t, resp)
+}
+
+func TestSendPostRequestWithContext_ValidResponse(t *testing.T) {
+	client, server, err := NewClientForTesting(map[string]string{
+		"/test": `{"data": "success"}`,
+	})
+	assert.NoError(t, err)
+	defer server.Close()
+
+	resp, err := client.SendPostRequestWithContext(context.Background(), "/test", map[string]string{"key": "value"})
+	assert.NoError(t, err)
+	assert.Contains(t, string(resp), "success")
+}
+
+func TestDo_ReturnsResponseMetadata(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Header().Set("X-Total-Count", "3")
+		if req.URL.Path == "/missing" {
+			rw.WriteHeader(http.StatusNotFound)
+			_, _ = rw.Write([]byte(`{"message": "not found"}`))
+			return
+		}
+		rw.WriteHeader(http.StatusCreated)
+		_, _ = rw.Write([]byte(`{"data": "created"}`))
+	}))
+	defer server.Close()
+	client, err := NewClientForTestingWithServer(server)
+	assert.NoError(t, err)
+	client.MaxRetries = 0
+
+	req, _ := http.NewRequestWithContext(context.Background(), http.MethodPost, server.URL+"/items", nil)
+	resp, err := client.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, "3", resp.Header.Get("X-Total-Count"))
+	assert.JSONEq(t, `{"data": "created"}`, string(resp.Body))
+	assert.NotEmpty(t, resp.RequestID)
+
+	req, _ = http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL+"/missing", nil)
+	resp, err = client.Do(req)
+	assert.True(t, IsNotFound(err))
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	assert.Contains(t, string(resp.Body), "not found")
+}
+
+func TestClient_ConcurrentUse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		_, _ = rw.Write([]byte(req.URL.Path))
+	}))
+	defer server.Close()
+	client, err := NewClientWithURL("apiKey", server.URL, "region", "tenant", 5)
+	assert.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			switch i % 4 {
+			case 0:
+				client.SetUserAgent(&Component{Name: "component", Version: fmt.Sprint(i)})
+			case 1:
+				assert.NoError(t, client.ConfigureTransport(TransportConfig{}))
+			}
+			path := fmt.Sprintf("/items/%d", i)
+			resp, err := client.SendGetRequestWithContext(context.Background(), path)
+			assert.NoError(t, err)
+			assert.Equal(t, path, string(resp))
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestDo_ReadOnlyRefusesMutatingRequests(t *testing.T) {
+	var methods []string
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		methods = append(methods, req.Method)
+		_, _ = rw.Write([]byte(`{"data": "success"}`))
+	}))
+	defer server.Close()
+	client, err := NewClientForTestingWithServer(server)
+	assert.NoError(t, err)
+	client.ReadOnly = true
+	ctx := context.Background()
+
+	_, err = client.SendGetRequestWithContext(ctx, "/test")
+	assert.NoError(t, err)
+	_, err = client.SendCachedPostRequestWithContext(ctx, "/lookup", map[string]string{"name": "flavor"})
+	assert.NoError(t, err)
+
+	_, err = client.SendPostRequestWithContext(ctx, "/test", nil)
+	assert.ErrorIs(t, err, ReadOnlyError)
+	assert.ErrorIs(t, DecodeError(err), ReadOnlyError)
+	assert.Contains(t, err.Error(), "refusing POST /test")
+	_, err = client.SendPutRequestWithContext(ctx, "/test", nil)
+	assert.ErrorIs(t, err, ReadOnlyError)
+	_, err = client.SendDeleteRequestWithContext(ctx, "/test")
+	assert.ErrorIs(t, err, ReadOnlyError)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, server.URL+"/test", nil)
+	assert.NoError(t, err)
+	_, err = client.SendRegionalRequest(req, "")
+	assert.ErrorIs(t, err, ReadOnlyError)
+
+	assert.Equal(t, []string{http.MethodGet, http.MethodPost}, methods)
+}
@@ -0,0 +1,248 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	common "terraform-provider-fptcloud/commons"
+)
+
+const (
+	// DefaultTimeout is the timeout in minutes of the API requests
+	DefaultTimeout = 15
+)
+
+// Config is the provider configuration as written in HCL. A nil field is unset and falls back to its
+// environment variable, then to the shared config file profile for the credentials, then to its default.
+type Config struct {
+	Token        *string
+	TenantName   *string
+	Region       *string
+	ApiEndpoint  *string
+	Profile      *string
+	Timeout      *int
+	MaxRetries   *int
+	RetryMaxWait *int
+
+	CACertFile         *string
+	CACertPEM          *string
+	ClientCertFile     *string
+	ClientCertPEM      *string
+	ClientKeyFile      *string
+	ClientKeyPEM       *string
+	InsecureSkipVerify *bool
+	ProxyURL           *string
+
+	ReadOnly                  *bool
+	SkipCredentialsValidation *bool
+	VpcId                     *string
+	DefaultTagIds             []string
+	IgnoreTagIds              []string
+}
+
+// Settings is the resolved provider configuration the API client is built from
+type Settings struct {
+	common.Credentials
+	Timeout      int
+	MaxRetries   int
+	RetryMaxWait time.Duration
+	Transport    common.TransportConfig
+	Tags         common.TagConfig
+	VpcId        string
+	ReadOnly     bool
+	// SkipCredentialsValidation configures the provider without checking the credentials against the API
+	SkipCredentialsValidation bool
+}
+
+// AttributeError is a configuration error scoped to a provider attribute
+type AttributeError struct {
+	Attribute string
+	Summary   string
+	Detail    string
+}
+
+func (e *AttributeError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Attribute, e.Detail)
+}
+
+// Resolve applies the environment variables, the shared config file profile and the defaults to the
+// provider configuration, in that order, and validates the result. Both provider servers resolve their
+// configuration here so that they behave the same under the same HCL.
+func Resolve(config Config) (*Settings, []*AttributeError) {
+	var errs []*AttributeError
+	invalid := func(attribute string, summary string, detail string) {
+		errs = append(errs, &AttributeError{Attribute: attribute, Summary: summary, Detail: detail})
+	}
+
+	credentials := common.Credentials{
+		Token:       stringValue(config.Token, "FPTCLOUD_TOKEN"),
+		TenantName:  stringValue(config.TenantName, "FPTCLOUD_TENANT_NAME"),
+		Region:      stringValue(config.Region, "FPTCLOUD_REGION"),
+		ApiEndpoint: stringValue(config.ApiEndpoint, "FPTCLOUD_API_URL"),
+	}
+	if err := credentials.ApplyProfile(stringValue(config.Profile, "FPTCLOUD_PROFILE")); err != nil {
+		invalid("profile", "Invalid profile", err.Error())
+	}
+	if credentials.ApiEndpoint == "" {
+		credentials.ApiEndpoint = common.DefaultApiUrl
+	}
+
+	if credentials.Token == "" {
+		invalid("token", "Missing token", "token must be set in the provider configuration, the FPTCLOUD_TOKEN environment variable or the shared config file profile")
+	}
+	if credentials.TenantName == "" {
+		invalid("tenant_name", "Missing tenant_name", "tenant_name must be set in the provider configuration, the FPTCLOUD_TENANT_NAME environment variable or the shared config file profile")
+	}
+	if credentials.Region == "" {
+		invalid("region", "Missing region", "region must be set in the provider configuration, the FPTCLOUD_REGION environment variable or the shared config file profile")
+	} else if _, ok := common.LookupRegion(credentials.Region); !ok {
+		invalid("region", "Invalid region", fmt.Sprintf("region must be one of %s, got %q", strings.Join(common.RegionCodes(), ", "), credentials.Region))
+	}
+
+	settings := &Settings{Credentials: credentials}
+
+	var err error
+	if settings.Timeout, err = intValue(config.Timeout, "FPTCLOUD_TIMEOUT", DefaultTimeout); err != nil {
+		invalid("timeout", "Invalid timeout", err.Error())
+	} else if settings.Timeout < 1 {
+		invalid("timeout", "Invalid timeout", "timeout must be at least 1 minute")
+	}
+
+	if settings.MaxRetries, err = intValue(config.MaxRetries, "FPTCLOUD_MAX_RETRIES", common.DefaultMaxRetries); err != nil {
+		invalid("max_retries", "Invalid max_retries", err.Error())
+	} else if settings.MaxRetries < 0 {
+		invalid("max_retries", "Invalid max_retries", "max_retries must be greater than or equal to 0")
+	}
+
+	retryMaxWait, err := intValue(config.RetryMaxWait, "FPTCLOUD_RETRY_MAX_WAIT", int(common.DefaultRetryMaxWait/time.Second))
+	if err != nil {
+		invalid("retry_max_wait", "Invalid retry_max_wait", err.Error())
+	} else if retryMaxWait < 1 {
+		invalid("retry_max_wait", "Invalid retry_max_wait", "retry_max_wait must be at least 1 second")
+	}
+	settings.RetryMaxWait = time.Duration(retryMaxWait) * time.Second
+
+	settings.Transport = common.TransportConfig{
+		CACertFile:     stringValue(config.CACertFile, "FPTCLOUD_CA_CERT_FILE"),
+		CACertPEM:      stringValue(config.CACertPEM, ""),
+		ClientCertFile: stringValue(config.ClientCertFile, "FPTCLOUD_CLIENT_CERT_FILE"),
+		ClientCertPEM:  stringValue(config.ClientCertPEM, ""),
+		ClientKeyFile:  stringValue(config.ClientKeyFile, "FPTCLOUD_CLIENT_KEY_FILE"),
+		ClientKeyPEM:   stringValue(config.ClientKeyPEM, ""),
+		ProxyURL:       stringValue(config.ProxyURL, "FPTCLOUD_PROXY_URL"),
+	}
+	if settings.Transport.InsecureSkipVerify, err = boolValue(config.InsecureSkipVerify, "FPTCLOUD_INSECURE_SKIP_VERIFY"); err != nil {
+		invalid("insecure_skip_verify", "Invalid insecure_skip_verify", err.Error())
+	}
+	if settings.Transport.CACertFile != "" && settings.Transport.CACertPEM != "" {
+		invalid("ca_cert_pem", "Conflicting CA certificate", "only one of ca_cert_file and ca_cert_pem can be set")
+	}
+	if settings.Transport.ClientCertFile != "" && settings.Transport.ClientCertPEM != "" {
+		invalid("client_cert_pem", "Conflicting client certificate", "only one of client_cert_file and client_cert_pem can be set")
+	}
+	if settings.Transport.ClientKeyFile != "" && settings.Transport.ClientKeyPEM != "" {
+		invalid("client_key_pem", "Conflicting client key", "only one of client_key_file and client_key_pem can be set")
+	}
+
+	if settings.ReadOnly, err = boolValue(config.ReadOnly, "FPTCLOUD_READ_ONLY"); err != nil {
+		invalid("read_only", "Invalid read_only", err.Error())
+	}
+	if settings.SkipCredentialsValidation, err = boolValue(config.SkipCredentialsValidation, "FPTCLOUD_SKIP_CREDENTIALS_VALIDATION"); err != nil {
+		invalid("skip_credentials_validation", "Invalid skip_credentials_validation", err.Error())
+	}
+	settings.VpcId = stringValue(config.VpcId, "FPTCLOUD_VPC_ID")
+	settings.Tags = common.TagConfig{
+		DefaultTagIds: config.DefaultTagIds,
+		IgnoreTagIds:  config.IgnoreTagIds,
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	return settings, nil
+}
+
+// sharedClientKey identifies the settings by their Go syntax representation, as the tag IDs make them incomparable
+type sharedClientKey struct {
+	settings  string
+	userAgent common.Component
+}
+
+var (
+	sharedClientsMu sync.Mutex
+	sharedClients   = map[sharedClientKey]*common.Client{}
+)
+
+// SharedClient returns the API client built for the given settings, creating it on first use. The SDKv2
+// and the framework provider servers run in the same process and are configured with the same HCL, so
+// their resources share one client along with its lookup cache.
+func SharedClient(settings *Settings, userAgent common.Component) (*common.Client, error) {
+	sharedClientsMu.Lock()
+	defer sharedClientsMu.Unlock()
+
+	key := sharedClientKey{settings: fmt.Sprintf("%#v", *settings), userAgent: userAgent}
+	if client, ok := sharedClients[key]; ok {
+		return client, nil
+	}
+
+	client, err := common.NewClientWithURL(settings.Token, settings.ApiEndpoint, settings.Region, settings.TenantName, settings.Timeout)
+	if err != nil {
+		return nil, err
+	}
+	client.SetUserAgent(&userAgent)
+	client.MaxRetries = settings.MaxRetries
+	client.RetryMaxWait = settings.RetryMaxWait
+	client.Tags = settings.Tags
+	client.VpcId = settings.VpcId
+	client.ReadOnly = settings.ReadOnly
+	if err := client.ConfigureTransport(settings.Transport); err != nil {
+		return nil, fmt.Errorf("invalid TLS or proxy configuration: %w", err)
+	}
+
+	sharedClients[key] = client
+	return client, nil
+}
+
+func stringValue(value *string, envVar string) string {
+	if value != nil {
+		return *value
+	}
+	if envVar == "" {
+		return ""
+	}
+	return os.Getenv(envVar)
+}
+
+func intValue(value *int, envVar string, defaultValue int) (int, error) {
+	if value != nil {
+		return *value, nil
+	}
+	env := os.Getenv(envVar)
+	if env == "" {
+		return defaultValue, nil
+	}
+	parsed, err := strconv.Atoi(env)
+	if err != nil {
+		return 0, fmt.Errorf("%s must be an integer, got %q", envVar, env)
+	}
+	return parsed, nil
+}
+
+func boolValue(value *bool, envVar string) (bool, error) {
+	if value != nil {
+		return *value, nil
+	}
+	env := os.Getenv(envVar)
+	if env == "" {
+		return false, nil
+	}
+	parsed, err := strconv.ParseBool(env)
+	if err != nil {
+		return false, fmt.Errorf("%s must be a boolean, got %q", envVar, env)
+	}
+	return parsed, nil
+}
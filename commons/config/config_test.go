@@ -0,0 +1,204 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	common "terraform-provider-fptcloud/commons"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func setTestEnv(t *testing.T, profile string) {
+	path := filepath.Join(t.TempDir(), "config")
+	assert.NoError(t, os.WriteFile(path, []byte(profile), 0600))
+	t.Setenv(common.ConfigFileEnvVar, path)
+	for _, envVar := range []string{
+		"FPTCLOUD_TOKEN", "FPTCLOUD_TENANT_NAME", "FPTCLOUD_REGION", "FPTCLOUD_API_URL", "FPTCLOUD_PROFILE",
+		"FPTCLOUD_TIMEOUT", "FPTCLOUD_MAX_RETRIES", "FPTCLOUD_RETRY_MAX_WAIT", "FPTCLOUD_INSECURE_SKIP_VERIFY",
+		"FPTCLOUD_VPC_ID", "FPTCLOUD_READ_ONLY", "FPTCLOUD_SKIP_CREDENTIALS_VALIDATION",
+	} {
+		t.Setenv(envVar, "")
+	}
+}
+
+func pointer[T any](value T) *T {
+	return &value
+}
+
+func TestResolve_Precedence(t *testing.T) {
+	setTestEnv(t, "[default]\ntoken = profile-token\ntenant_name = profile-tenant\nregion = VN/SGN\n")
+	t.Setenv("FPTCLOUD_TENANT_NAME", "env-tenant")
+	t.Setenv("FPTCLOUD_REGION", "VN/HAN")
+
+	settings, errs := Resolve(Config{Region: pointer("JP/JCSI2")})
+	assert.Empty(t, errs)
+	assert.Equal(t, "profile-token", settings.Token)
+	assert.Equal(t, "env-tenant", settings.TenantName)
+	assert.Equal(t, "JP/JCSI2", settings.Region)
+	assert.Equal(t, common.DefaultApiUrl, settings.ApiEndpoint)
+}
+
+func TestResolve_Defaults(t *testing.T) {
+	setTestEnv(t, "")
+
+	settings, errs := Resolve(Config{Token: pointer("token"), TenantName: pointer("tenant"), Region: pointer("VN/HAN")})
+	assert.Empty(t, errs)
+	assert.Equal(t, DefaultTimeout, settings.Timeout)
+	assert.Equal(t, common.DefaultMaxRetries, settings.MaxRetries)
+	assert.Equal(t, common.DefaultRetryMaxWait, settings.RetryMaxWait)
+	assert.False(t, settings.Transport.InsecureSkipVerify)
+}
+
+func TestResolve_ExplicitZeroOverridesEnv(t *testing.T) {
+	setTestEnv(t, "")
+	t.Setenv("FPTCLOUD_MAX_RETRIES", "5")
+	t.Setenv("FPTCLOUD_INSECURE_SKIP_VERIFY", "true")
+	t.Setenv("FPTCLOUD_TIMEOUT", "30")
+
+	settings, errs := Resolve(Config{
+		Token:              pointer("token"),
+		TenantName:         pointer("tenant"),
+		Region:             pointer("VN/HAN"),
+		MaxRetries:         pointer(0),
+		InsecureSkipVerify: pointer(false),
+	})
+	assert.Empty(t, errs)
+	assert.Equal(t, 0, settings.MaxRetries)
+	assert.False(t, settings.Transport.InsecureSkipVerify)
+	assert.Equal(t, 30, settings.Timeout)
+}
+
+func TestResolve_AttributeErrors(t *testing.T) {
+	setTestEnv(t, "")
+	t.Setenv("FPTCLOUD_MAX_RETRIES", "many")
+
+	_, errs := Resolve(Config{Region: pointer("VN/HAN"), RetryMaxWait: pointer(0), Profile: pointer("missing")})
+
+	attributes := make([]string, 0, len(errs))
+	for _, err := range errs {
+		attributes = append(attributes, err.Attribute)
+	}
+	assert.Equal(t, []string{"profile", "token", "tenant_name", "max_retries", "retry_max_wait"}, attributes)
+}
+
+func TestResolve_UnknownRegion(t *testing.T) {
+	setTestEnv(t, "")
+
+	_, errs := Resolve(Config{Token: pointer("token"), TenantName: pointer("tenant"), Region: pointer("VN/DNG")})
+	if assert.Len(t, errs, 1) {
+		assert.Equal(t, "region", errs[0].Attribute)
+		assert.Contains(t, errs[0].Detail, "VN/HAN, VN/SGN")
+	}
+}
+
+func TestSharedClient_ReusedForSameSettings(t *testing.T) {
+	setTestEnv(t, "")
+	userAgent := common.Component{Name: "terraform-provider-fptcloud", Version: "test"}
+
+	settings, errs := Resolve(Config{Token: pointer("token"), TenantName: pointer("tenant"), Region: pointer("VN/HAN"), MaxRetries: pointer(1)})
+	assert.Empty(t, errs)
+	client, err := SharedClient(settings, userAgent)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, client.MaxRetries)
+	assert.Equal(t, 30*time.Second, client.RetryMaxWait)
+	assert.Contains(t, client.UserAgent, "terraform-provider-fptcloud/test")
+
+	again, err := SharedClient(settings, userAgent)
+	assert.NoError(t, err)
+	assert.Same(t, client, again)
+
+	settings.Region = "VN/SGN"
+	other, err := SharedClient(settings, userAgent)
+	assert.NoError(t, err)
+	assert.NotSame(t, client, other)
+}
+
+func TestSharedClient_TagConfig(t *testing.T) {
+	setTestEnv(t, "")
+	userAgent := common.Component{Name: "terraform-provider-fptcloud", Version: "test"}
+
+	settings, errs := Resolve(Config{
+		Token: pointer("token"), TenantName: pointer("tenant"), Region: pointer("VN/HAN"),
+		DefaultTagIds: []string{"owner"}, IgnoreTagIds: []string{"backup"},
+	})
+	assert.Empty(t, errs)
+	client, err := SharedClient(settings, userAgent)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"owner"}, client.Tags.DefaultTagIds)
+	assert.Equal(t, []string{"backup"}, client.Tags.IgnoreTagIds)
+
+	settings.Tags.DefaultTagIds = []string{"cost-center"}
+	other, err := SharedClient(settings, userAgent)
+	assert.NoError(t, err)
+	assert.NotSame(t, client, other)
+	assert.Equal(t, []string{"cost-center"}, other.Tags.DefaultTagIds)
+}
+
+func TestResolve_VpcId(t *testing.T) {
+	setTestEnv(t, "")
+	credentials := Config{Token: pointer("token"), TenantName: pointer("tenant"), Region: pointer("VN/HAN")}
+
+	settings, errs := Resolve(credentials)
+	assert.Empty(t, errs)
+	assert.Empty(t, settings.VpcId)
+
+	t.Setenv("FPTCLOUD_VPC_ID", "env-vpc")
+	settings, errs = Resolve(credentials)
+	assert.Empty(t, errs)
+	assert.Equal(t, "env-vpc", settings.VpcId)
+
+	credentials.VpcId = pointer("config-vpc")
+	settings, errs = Resolve(credentials)
+	assert.Empty(t, errs)
+	assert.Equal(t, "config-vpc", settings.VpcId)
+
+	client, err := SharedClient(settings, common.Component{Name: "terraform-provider-fptcloud", Version: "test"})
+	assert.NoError(t, err)
+	assert.Equal(t, "config-vpc", client.VpcId)
+}
+
+func TestResolve_ReadOnly(t *testing.T) {
+	setTestEnv(t, "")
+	credentials := Config{Token: pointer("token"), TenantName: pointer("tenant"), Region: pointer("VN/HAN")}
+
+	settings, errs := Resolve(credentials)
+	assert.Empty(t, errs)
+	assert.False(t, settings.ReadOnly)
+
+	t.Setenv("FPTCLOUD_READ_ONLY", "true")
+	settings, errs = Resolve(credentials)
+	assert.Empty(t, errs)
+	assert.True(t, settings.ReadOnly)
+
+	credentials.ReadOnly = pointer(false)
+	settings, errs = Resolve(credentials)
+	assert.Empty(t, errs)
+	assert.False(t, settings.ReadOnly)
+
+	t.Setenv("FPTCLOUD_READ_ONLY", "maybe")
+	_, errs = Resolve(Config{Token: pointer("token"), TenantName: pointer("tenant"), Region: pointer("VN/HAN")})
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "read_only", errs[0].Attribute)
+}
+
+func TestResolve_SkipCredentialsValidation(t *testing.T) {
+	setTestEnv(t, "")
+	credentials := Config{Token: pointer("token"), TenantName: pointer("tenant"), Region: pointer("VN/HAN")}
+
+	settings, errs := Resolve(credentials)
+	assert.Empty(t, errs)
+	assert.False(t, settings.SkipCredentialsValidation)
+
+	t.Setenv("FPTCLOUD_SKIP_CREDENTIALS_VALIDATION", "true")
+	settings, errs = Resolve(credentials)
+	assert.Empty(t, errs)
+	assert.True(t, settings.SkipCredentialsValidation)
+
+	credentials.SkipCredentialsValidation = pointer(false)
+	settings, errs = Resolve(credentials)
+	assert.Empty(t, errs)
+	assert.False(t, settings.SkipCredentialsValidation)
+}
@@ -1,5 +1,18 @@
 package commons
 
+import "time"
+
 const (
 	DefaultApiUrl = "https://console-api.fptcloud.com/api"
+
+	// DefaultMaxRetries is the number of times a failed request is retried
+	DefaultMaxRetries = 3
+	// DefaultRetryMaxWait is the upper bound of the wait between two retries
+	DefaultRetryMaxWait = 30 * time.Second
+	// DefaultLookupCacheTTL is how long the responses of catalog endpoints are reused
+	DefaultLookupCacheTTL = 5 * time.Minute
+	// DefaultPageSize is the number of items requested per page when walking a paged listing
+	DefaultPageSize = 100
+	// DefaultPageConcurrency is the number of pages of a listing fetched at once
+	DefaultPageConcurrency = 4
 )
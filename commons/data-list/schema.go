@@ -26,7 +26,7 @@ type ResourceConfig struct {
 	// Return all of the records on which the data list resource should operate.
 	// The `meta` argument is the same meta argument passed into the resource's Read
 	// function.
-	GetRecords func(meta interface{}, extra map[string]interface{}) ([]interface{}, error)
+	GetRecords func(ctx context.Context, meta interface{}, extra map[string]interface{}) ([]interface{}, error)
 
 	// Extra parameters to expose on the datasource alongside `filter` and `sort`.
 	ExtraQuerySchema map[string]*schema.Schema
@@ -83,13 +83,13 @@ func NewResource(config *ResourceConfig) *schema.Resource {
 }
 
 func dataListResourceRead(config *ResourceConfig) schema.ReadContextFunc {
-	return func(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		extra := map[string]interface{}{}
 		for key := range config.ExtraQuerySchema {
 			extra[key] = d.Get(key)
 		}
 
-		records, err := config.GetRecords(meta, extra)
+		records, err := config.GetRecords(ctx, meta, extra)
 		if err != nil {
 			return diag.Errorf("Unable to load records: %s", err)
 		}
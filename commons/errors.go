@@ -1,6 +1,7 @@
 package commons
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net"
@@ -15,6 +16,16 @@ var (
 	ZeroMatchesError     = constError("ZeroMatchesError")
 	MultipleMatchesError = constError("MultipleMatchesError")
 	HttpError            = constError("HttpError")
+	ReadOnlyError        = constError("ReadOnlyError")
+)
+
+// Kinds of APIError, matched with errors.Is
+var (
+	NotFoundError      = constError("NotFoundError")
+	ConflictError      = constError("ConflictError")
+	QuotaExceededError = constError("QuotaExceededError")
+	UnauthorizedError  = constError("UnauthorizedError")
+	ValidationError    = constError("ValidationError")
 )
 
 type constError string
@@ -57,10 +68,103 @@ func (err wrapError) Is(target error) bool {
 	return constError(err.msg).Is(target)
 }
 
+// APIError is an HTTP error returned by the API, parsed into its status code, API error code and message.
+// It matches HttpError and, depending on the status, one of NotFoundError, ConflictError,
+// QuotaExceededError, UnauthorizedError or ValidationError.
+type APIError struct {
+	StatusCode int
+	Code       string
+	Message    string
+}
+
+func (err APIError) Error() string {
+	if err.Code != "" {
+		return fmt.Sprintf("%s: %s (error code %s)", HttpError, err.Message, err.Code)
+	}
+	return fmt.Sprintf("%s: %s", HttpError, err.Message)
+}
+
+func (err APIError) Is(target error) bool {
+	if target == HttpError {
+		return true
+	}
+	kind := err.Kind()
+	return kind != nil && target == kind
+}
+
+// Kind returns the kind of the error, or nil when the status has no specific meaning
+func (err APIError) Kind() error {
+	lowerMessage := strings.ToLower(err.Code + " " + err.Message)
+	if strings.Contains(lowerMessage, "quota") {
+		return QuotaExceededError
+	}
+
+	switch err.StatusCode {
+	case 401, 403:
+		return UnauthorizedError
+	case 404:
+		return NotFoundError
+	case 409:
+		return ConflictError
+	case 400, 422:
+		return ValidationError
+	}
+	return nil
+}
+
+// NewAPIError parses the body of an HTTP error returned by the API
+func NewAPIError(httpErr HTTPError) APIError {
+	apiErr := APIError{
+		StatusCode: httpErr.Code,
+		Message:    strings.TrimSpace(httpErr.Reason),
+	}
+
+	var body struct {
+		Message   string      `json:"message"`
+		Detail    string      `json:"detail"`
+		ErrorCode interface{} `json:"error_code"`
+		Code      interface{} `json:"code"`
+	}
+	if json.Unmarshal([]byte(httpErr.Reason), &body) == nil {
+		switch {
+		case body.Message != "":
+			apiErr.Message = body.Message
+		case body.Detail != "":
+			apiErr.Message = body.Detail
+		}
+
+		code := body.ErrorCode
+		if code == nil {
+			code = body.Code
+		}
+		if code != nil {
+			apiErr.Code = fmt.Sprint(code)
+		}
+	}
+
+	if apiErr.Message == "" {
+		apiErr.Message = httpErr.Status
+	}
+	return apiErr
+}
+
+// IsNotFound reports whether the error means the requested resource does not exist,
+// either because the API answered 404 or because a lookup had zero matches
+func IsNotFound(err error) bool {
+	return errors.Is(err, NotFoundError) || errors.Is(err, ZeroMatchesError)
+}
+
 func DecodeError(err error) error {
 	var urlErr *url.Error
 	var netErr net.Error
 	var httpErr HTTPError
+	var apiErr APIError
+	var decodedErr wrapError
+
+	// Already decoded errors are returned as is, so decoding twice keeps the original message
+	if errors.As(err, &apiErr) || errors.As(err, &decodedErr) {
+		return err
+	}
 
 	if errors.As(err, &urlErr) {
 		if errors.As(urlErr.Err, &netErr) {
@@ -69,9 +173,7 @@ func DecodeError(err error) error {
 	}
 
 	if errors.As(err, &httpErr) {
-		if httpErr.Code == 400 {
-			return HttpError.WrapString(httpErr.Reason)
-		}
+		return NewAPIError(httpErr)
 	}
 	return UnknownError.WrapString("System error, please try again !")
 }
@@ -43,3 +43,38 @@ func TestDecodeError_ReturnsUnknownError(t *testing.T) {
 	assert.True(t, errors.Is(err, UnknownError))
 	assert.Equal(t, "UnknownError: System error, please try again !", err.Error())
 }
+
+func TestDecodeError_ParsesApiErrorBody(t *testing.T) {
+	httpErr := HTTPError{Code: 409, Status: "409 Conflict", Reason: `{"status": false, "error_code": "NAME_EXISTED", "message": "Name already exists"}`}
+	err := DecodeError(httpErr)
+
+	var apiErr APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, 409, apiErr.StatusCode)
+	assert.Equal(t, "NAME_EXISTED", apiErr.Code)
+	assert.Equal(t, "Name already exists", apiErr.Message)
+	assert.True(t, errors.Is(err, ConflictError))
+	assert.True(t, errors.Is(err, HttpError))
+	assert.Equal(t, "HttpError: Name already exists (error code NAME_EXISTED)", err.Error())
+}
+
+func TestDecodeError_ClassifiesStatusCodes(t *testing.T) {
+	assert.True(t, IsNotFound(DecodeError(HTTPError{Code: 404, Status: "404 Not Found"})))
+	assert.True(t, errors.Is(DecodeError(HTTPError{Code: 401, Reason: "{}"}), UnauthorizedError))
+	assert.True(t, errors.Is(DecodeError(HTTPError{Code: 403, Reason: "{}"}), UnauthorizedError))
+	assert.True(t, errors.Is(DecodeError(HTTPError{Code: 422, Reason: "{}"}), ValidationError))
+	assert.True(t, errors.Is(DecodeError(HTTPError{Code: 400, Reason: `{"message": "Storage quota exceeded", "error_code": 1001}`}), QuotaExceededError))
+	assert.False(t, IsNotFound(DecodeError(HTTPError{Code: 500, Reason: "{}"})))
+	assert.True(t, IsNotFound(ZeroMatchesError.WrapString("unable to find key, zero matches")))
+}
+
+func TestDecodeError_KeepsMessageOfServerErrors(t *testing.T) {
+	err := DecodeError(HTTPError{Code: 500, Status: "500 Internal Server Error", Reason: `{"message": "Database is unavailable"}`})
+	assert.Equal(t, "HttpError: Database is unavailable", err.Error())
+}
+
+func TestDecodeError_ReturnsDecodedErrorUnchanged(t *testing.T) {
+	err := DecodeError(HTTPError{Code: 404, Reason: `{"message": "Instance not found"}`})
+	assert.Equal(t, err, DecodeError(err))
+	assert.Equal(t, TimeoutError.WrapString("timeout"), DecodeError(TimeoutError.WrapString("timeout")))
+}
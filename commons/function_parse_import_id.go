@@ -0,0 +1,70 @@
+package commons
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &functionParseImportId{}
+
+// importIdAttributeTypes are the attributes of the object returned by parse_import_id
+var importIdAttributeTypes = map[string]attr.Type{
+	"vpc_id":     types.StringType,
+	"id_or_name": types.StringType,
+}
+
+type functionParseImportId struct{}
+
+// NewFunctionParseImportId returns the parse_import_id function, splitting the import ID of a VPC-scoped resource
+// like its importer does
+func NewFunctionParseImportId() function.Function {
+	return &functionParseImportId{}
+}
+
+func (f *functionParseImportId) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "parse_import_id"
+}
+
+func (f *functionParseImportId) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:     "Parse the import ID of a VPC-scoped resource",
+		Description: "Splits an import ID of the form <vpc_id>/<id> or <vpc_id>/<name> into an object with the VPC ID and the resource ID or name. The VPC ID is null for an import ID without a slash, which is imported in the provider vpc_id.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "import_id",
+				Description: "The import ID.",
+			},
+		},
+		Return: function.ObjectReturn{AttributeTypes: importIdAttributeTypes},
+	}
+}
+
+func (f *functionParseImportId) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var importId string
+	response.Error = request.Arguments.Get(ctx, &importId)
+	if response.Error != nil {
+		return
+	}
+
+	vpcId, ref, err := ParseVpcImportId(importId)
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(0, err.Error()+", expected <vpc_id>/<id>, <vpc_id>/<name> or an ID alone")
+		return
+	}
+	vpcIdValue := types.StringNull()
+	if vpcId != "" {
+		vpcIdValue = types.StringValue(vpcId)
+	}
+	parsed, diags := types.ObjectValue(importIdAttributeTypes, map[string]attr.Value{
+		"vpc_id":     vpcIdValue,
+		"id_or_name": types.StringValue(ref),
+	})
+	response.Error = function.FuncErrorFromDiags(ctx, diags)
+	if response.Error != nil {
+		return
+	}
+	response.Error = response.Result.Set(ctx, parsed)
+}
@@ -0,0 +1,167 @@
+package commons
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// VpcImportFunc resolves the reference of an imported resource, its ID or its name, in the given VPC. It returns the
+// resource ID and may set the attributes that the resource Read does not.
+type VpcImportFunc func(ctx context.Context, d *schema.ResourceData, m interface{}, vpcId string, ref string) (string, error)
+
+// ParseVpcImportId splits an import ID of the form <vpc_id>/<ref>. An import ID without a slash is the reference
+// alone, and the VPC is left empty for the provider vpc_id.
+func ParseVpcImportId(importId string) (vpcId string, ref string, err error) {
+	vpcId, ref, found := strings.Cut(importId, "/")
+	if !found {
+		vpcId, ref = "", importId
+	}
+	if ref == "" || (found && vpcId == "") {
+		return "", "", fmt.Errorf("invalid import ID %q", importId)
+	}
+	return vpcId, ref, nil
+}
+
+// ImportVpcResource returns the importer of a resource scoped to a VPC, taking <vpc_id>/<ref> or a reference alone in
+// the provider VPC. The format, such as "<vpc_id>/<id> or <vpc_id>/<name>", completes the error of a malformed ID.
+func ImportVpcResource(format string, resolve VpcImportFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		vpcId, ref, err := ParseVpcImportId(d.Id())
+		if err != nil {
+			return nil, fmt.Errorf("%w, expected %s", err, format)
+		}
+		client, _ := m.(*Client)
+		if vpcId, err = client.DefaultVpcId(vpcId); err != nil {
+			return nil, fmt.Errorf("%w, or import with %s", err, format)
+		}
+
+		id, err := resolve(ctx, d, m, vpcId, ref)
+		if err != nil {
+			return nil, fmt.Errorf("failed to import %q in VPC %s: %w", ref, vpcId, err)
+		}
+		if err := d.Set("vpc_id", vpcId); err != nil {
+			return nil, err
+		}
+		d.SetId(id)
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+// VpcImportStateFunc is VpcImportFunc for a framework resource, which may set the attributes that the resource Read
+// does not in the state of the response
+type VpcImportStateFunc func(ctx context.Context, vpcId string, ref string, response *resource.ImportStateResponse) (string, error)
+
+// ImportVpcResourceState is ImportVpcResource for a framework resource, setting its id and vpc_id
+func (c *Client) ImportVpcResourceState(ctx context.Context, format string, request resource.ImportStateRequest, response *resource.ImportStateResponse, resolve VpcImportStateFunc) {
+	vpcId, ref, err := ParseVpcImportId(request.ID)
+	if err != nil {
+		response.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("%s, expected %s", err, format))
+		return
+	}
+	if vpcId, err = c.DefaultVpcId(vpcId); err != nil {
+		response.Diagnostics.AddError("Missing vpc_id", fmt.Sprintf("%s, or import with %s", err, format))
+		return
+	}
+
+	id, err := resolve(ctx, vpcId, ref, response)
+	if err != nil {
+		response.Diagnostics.AddError("Unable to import the resource", fmt.Sprintf("failed to import %q in VPC %s: %s", ref, vpcId, err))
+		return
+	}
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("vpc_id"), vpcId)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// FindByIdOrName looks an imported resource up by ID, then by name when no resource has that ID
+func FindByIdOrName[T any](ctx context.Context, ref string, byId func(ctx context.Context, id string) (T, error), byName func(ctx context.Context, name string) (T, error)) (T, error) {
+	found, err := byId(ctx, ref)
+	if err == nil || !(IsNotFound(err) || errors.Is(err, ValidationError)) {
+		return found, err
+	}
+	return byName(ctx, ref)
+}
+
+// ForceNewUnlessUnsetAfterImport forces a new resource on a change of the given attributes, which the API does not
+// return, unless the unset attributes are all unset in the state of an existing resource, which only happens once it
+// was imported. The configured values are then recorded by an update in place, the resource Read leaving them as is.
+func ForceNewUnlessUnsetAfterImport(attributes []string, unset ...string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+		if d.Id() != "" && unsetAfterImportDiff(d, unset) {
+			return nil
+		}
+		for _, attribute := range attributes {
+			if d.HasChange(attribute) {
+				if err := d.ForceNew(attribute); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+}
+
+// unsetAfterImportDiff reports whether the given string attributes are all unset in the state of an SDKv2 resource
+func unsetAfterImportDiff(d *schema.ResourceDiff, attributes []string) bool {
+	for _, attribute := range attributes {
+		if previous, _ := d.GetChange(attribute); previous != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// RequiresReplaceUnlessUnsetAfterImport is ForceNewUnlessUnsetAfterImport for a framework resource. A change of the
+// attribute replaces the resource, unless the given attributes are all unset in the state of an existing resource,
+// which only happens once it was imported. The configured value is then recorded by an update in place.
+func RequiresReplaceUnlessUnsetAfterImport(attributes ...string) planmodifier.String {
+	description := requiresReplaceUnlessUnsetAfterImportDescription(attributes)
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, request planmodifier.StringRequest, response *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			response.RequiresReplace = !unsetAfterImport(request.State, attributes)
+		},
+		description, description,
+	)
+}
+
+// RequiresReplaceInt64UnlessUnsetAfterImport is RequiresReplaceUnlessUnsetAfterImport for an Int64 attribute
+func RequiresReplaceInt64UnlessUnsetAfterImport(attributes ...string) planmodifier.Int64 {
+	description := requiresReplaceUnlessUnsetAfterImportDescription(attributes)
+	return int64planmodifier.RequiresReplaceIf(
+		func(ctx context.Context, request planmodifier.Int64Request, response *int64planmodifier.RequiresReplaceIfFuncResponse) {
+			response.RequiresReplace = !unsetAfterImport(request.State, attributes)
+		},
+		description, description,
+	)
+}
+
+func requiresReplaceUnlessUnsetAfterImportDescription(attributes []string) string {
+	return fmt.Sprintf("Changing the value replaces the resource, unless %s are unset after import.", strings.Join(attributes, ", "))
+}
+
+// unsetAfterImport tells whether the given attributes are all null or empty strings in the state of a resource
+func unsetAfterImport(state tfsdk.State, attributes []string) bool {
+	for _, attribute := range attributes {
+		found, _, err := tftypes.WalkAttributePath(state.Raw, tftypes.NewAttributePath().WithAttributeName(attribute))
+		value, ok := found.(tftypes.Value)
+		if err != nil || !ok || value.IsNull() || !value.IsKnown() {
+			continue
+		}
+		var stringValue string
+		if value.Type().Is(tftypes.String) && value.As(&stringValue) == nil && stringValue == "" {
+			continue
+		}
+		return false
+	}
+	return true
+}
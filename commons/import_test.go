@@ -0,0 +1,261 @@
+package commons
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	frameworkschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseVpcImportId(t *testing.T) {
+	vpcId, ref, err := ParseVpcImportId("vpc/id")
+	assert.NoError(t, err)
+	assert.Equal(t, "vpc", vpcId)
+	assert.Equal(t, "id", ref)
+
+	vpcId, ref, err = ParseVpcImportId("vpc/name/with/slashes")
+	assert.NoError(t, err)
+	assert.Equal(t, "vpc", vpcId)
+	assert.Equal(t, "name/with/slashes", ref)
+
+	vpcId, ref, err = ParseVpcImportId("id")
+	assert.NoError(t, err)
+	assert.Empty(t, vpcId)
+	assert.Equal(t, "id", ref)
+
+	for _, importId := range []string{"", "/id", "vpc/"} {
+		_, _, err = ParseVpcImportId(importId)
+		assert.Error(t, err, importId)
+	}
+}
+
+func testImport(t *testing.T, importId string, vpcId string, resolve VpcImportFunc) (*schema.ResourceData, error) {
+	resource, _, _ := testVpcResources()
+	resource.Importer = &schema.ResourceImporter{StateContext: ImportVpcResource("<vpc_id>/<id> or <vpc_id>/<name>", resolve)}
+
+	d := resource.Data(&terraform.InstanceState{ID: importId})
+	imported, err := resource.Importer.StateContext(context.Background(), d, testVpcClient(t, vpcId))
+	if err != nil {
+		return nil, err
+	}
+	assert.Len(t, imported, 1)
+	return imported[0], nil
+}
+
+func TestImportVpcResource(t *testing.T) {
+	resolve := func(_ context.Context, d *schema.ResourceData, _ interface{}, vpcId string, ref string) (string, error) {
+		if err := d.Set("name", ref); err != nil {
+			return "", err
+		}
+		return vpcId + "-" + ref, nil
+	}
+
+	d, err := testImport(t, "own-vpc/web", "provider-vpc", resolve)
+	assert.NoError(t, err)
+	assert.Equal(t, "own-vpc-web", d.Id())
+	assert.Equal(t, "own-vpc", d.Get("vpc_id"))
+	assert.Equal(t, "web", d.Get("name"))
+
+	d, err = testImport(t, "web", "provider-vpc", resolve)
+	assert.NoError(t, err)
+	assert.Equal(t, "provider-vpc-web", d.Id())
+	assert.Equal(t, "provider-vpc", d.Get("vpc_id"))
+
+	_, err = testImport(t, "web", "", resolve)
+	assert.ErrorIs(t, err, ErrMissingVpcId)
+
+	_, err = testImport(t, "own-vpc/", "", resolve)
+	assert.ErrorContains(t, err, "expected <vpc_id>/<id> or <vpc_id>/<name>")
+
+	_, err = testImport(t, "own-vpc/web", "", func(context.Context, *schema.ResourceData, interface{}, string, string) (string, error) {
+		return "", APIError{StatusCode: 404, Message: "not found"}
+	})
+	assert.True(t, IsNotFound(err))
+	assert.ErrorContains(t, err, `failed to import "web" in VPC own-vpc`)
+}
+
+func TestFindByIdOrName(t *testing.T) {
+	byId := func(_ context.Context, id string) (string, error) {
+		switch id {
+		case "id":
+			return "by id", nil
+		case "invalid":
+			return "", APIError{StatusCode: 400, Message: "invalid id"}
+		case "broken":
+			return "", APIError{StatusCode: 500, Message: "internal error"}
+		}
+		return "", APIError{StatusCode: 404, Message: "not found"}
+	}
+	byName := func(_ context.Context, name string) (string, error) {
+		return "by name " + name, nil
+	}
+
+	found, err := FindByIdOrName(context.Background(), "id", byId, byName)
+	assert.NoError(t, err)
+	assert.Equal(t, "by id", found)
+
+	found, err = FindByIdOrName(context.Background(), "web", byId, byName)
+	assert.NoError(t, err)
+	assert.Equal(t, "by name web", found)
+
+	found, err = FindByIdOrName(context.Background(), "invalid", byId, byName)
+	assert.NoError(t, err)
+	assert.Equal(t, "by name invalid", found)
+
+	_, err = FindByIdOrName(context.Background(), "broken", byId, byName)
+	assert.True(t, errors.Is(err, HttpError))
+}
+
+func TestForceNewUnlessUnsetAfterImport(t *testing.T) {
+	noop := func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics { return nil }
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"cidr": {Type: schema.TypeString, Required: true},
+			"type": {Type: schema.TypeString, Required: true},
+		},
+		CreateContext: noop,
+		ReadContext:   noop,
+		UpdateContext: noop,
+		DeleteContext: noop,
+		CustomizeDiff: ForceNewUnlessUnsetAfterImport([]string{"cidr", "type"}, "cidr"),
+	}
+	assert.NoError(t, resource.InternalValidate(nil, true))
+	config := func() map[string]cty.Value {
+		return map[string]cty.Value{"cidr": cty.StringVal("10.0.0.0/24"), "type": cty.StringVal("ISOLATED")}
+	}
+
+	imported := &terraform.InstanceState{ID: "id", Attributes: map[string]string{"id": "id"}}
+	diff, err := testVpcDiff(resource, imported, config(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "10.0.0.0/24", diff.Attributes["cidr"].New)
+	assert.Equal(t, "ISOLATED", diff.Attributes["type"].New)
+	assert.False(t, diff.RequiresNew())
+
+	existing := &terraform.InstanceState{ID: "id", Attributes: map[string]string{"id": "id", "cidr": "10.0.0.0/24", "type": "ISOLATED"}}
+	diff, err = testVpcDiff(resource, existing, config(), nil)
+	assert.NoError(t, err)
+	assert.Empty(t, diff.Attributes)
+
+	changed := config()
+	changed["type"] = cty.StringVal("NAT_ROUTED")
+	diff, err = testVpcDiff(resource, existing, changed, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "NAT_ROUTED", diff.Attributes["type"].New)
+	assert.True(t, diff.RequiresNew())
+}
+
+// testFrameworkState returns an empty state of a framework resource having the given string attributes
+func testFrameworkState(attributes ...string) tfsdk.State {
+	resourceSchema := frameworkschema.Schema{Attributes: map[string]frameworkschema.Attribute{}}
+	for _, attribute := range attributes {
+		resourceSchema.Attributes[attribute] = frameworkschema.StringAttribute{Optional: true}
+	}
+	return tfsdk.State{Schema: resourceSchema, Raw: tftypes.NewValue(resourceSchema.Type().TerraformType(context.Background()), nil)}
+}
+
+func testImportState(t *testing.T, importId string, vpcId string, resolve VpcImportStateFunc) *frameworkresource.ImportStateResponse {
+	response := &frameworkresource.ImportStateResponse{State: testFrameworkState("id", "vpc_id", "name")}
+	client := testVpcClient(t, vpcId)
+	client.ImportVpcResourceState(context.Background(), "<vpc_id>/<id>", frameworkresource.ImportStateRequest{ID: importId}, response, resolve)
+	return response
+}
+
+func TestImportVpcResourceState(t *testing.T) {
+	resolve := func(ctx context.Context, vpcId string, ref string, response *frameworkresource.ImportStateResponse) (string, error) {
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("name"), ref)...)
+		return vpcId + "-" + ref, nil
+	}
+
+	response := testImportState(t, "own-vpc/web", "provider-vpc", resolve)
+	assert.False(t, response.Diagnostics.HasError(), "%v", response.Diagnostics)
+	for attribute, expected := range map[string]string{"id": "own-vpc-web", "vpc_id": "own-vpc", "name": "web"} {
+		var value types.String
+		response.State.GetAttribute(context.Background(), path.Root(attribute), &value)
+		assert.Equal(t, expected, value.ValueString(), attribute)
+	}
+
+	response = testImportState(t, "web", "provider-vpc", resolve)
+	var vpcId types.String
+	response.State.GetAttribute(context.Background(), path.Root("vpc_id"), &vpcId)
+	assert.Equal(t, "provider-vpc", vpcId.ValueString())
+
+	response = testImportState(t, "web", "", resolve)
+	assert.Equal(t, "Missing vpc_id", response.Diagnostics.Errors()[0].Summary())
+
+	response = testImportState(t, "vpc/", "", resolve)
+	assert.Equal(t, "Invalid import ID", response.Diagnostics.Errors()[0].Summary())
+
+	response = testImportState(t, "vpc/web", "", func(context.Context, string, string, *frameworkresource.ImportStateResponse) (string, error) {
+		return "", errors.New("not found")
+	})
+	assert.Contains(t, response.Diagnostics.Errors()[0].Detail(), `failed to import "web" in VPC vpc: not found`)
+}
+
+func TestRequiresReplaceUnlessUnsetAfterImport(t *testing.T) {
+	modifier := RequiresReplaceUnlessUnsetAfterImport("image_name", "ssh_key")
+	requiresReplace := func(state map[string]string) bool {
+		priorState := testFrameworkState("id", "image_name", "ssh_key")
+		for attribute, value := range state {
+			priorState.SetAttribute(context.Background(), path.Root(attribute), value)
+		}
+		plan := tfsdk.Plan{Schema: priorState.Schema, Raw: priorState.Raw}
+		response := &planmodifier.StringResponse{PlanValue: types.StringValue("ubuntu")}
+		modifier.PlanModifyString(context.Background(), planmodifier.StringRequest{
+			Path:        path.Root("image_name"),
+			State:       priorState,
+			Plan:        plan,
+			StateValue:  types.StringValue(state["image_name"]),
+			PlanValue:   types.StringValue("ubuntu"),
+			ConfigValue: types.StringValue("ubuntu"),
+		}, response)
+		assert.False(t, response.Diagnostics.HasError(), "%v", response.Diagnostics)
+		return response.RequiresReplace
+	}
+
+	assert.False(t, requiresReplace(map[string]string{"id": "id"}))
+	assert.True(t, requiresReplace(map[string]string{"id": "id", "image_name": "debian"}))
+	assert.True(t, requiresReplace(map[string]string{"id": "id", "ssh_key": "ssh-ed25519 AAAA"}))
+}
+
+func TestRequiresReplaceInt64UnlessUnsetAfterImport(t *testing.T) {
+	modifier := RequiresReplaceInt64UnlessUnsetAfterImport("password", "password_wo_version")
+	resourceSchema := frameworkschema.Schema{Attributes: map[string]frameworkschema.Attribute{
+		"id":                  frameworkschema.StringAttribute{Computed: true},
+		"password":            frameworkschema.StringAttribute{Optional: true},
+		"password_wo_version": frameworkschema.Int64Attribute{Optional: true},
+	}}
+	requiresReplace := func(password string, version *int64) bool {
+		priorState := tfsdk.State{Schema: resourceSchema, Raw: tftypes.NewValue(resourceSchema.Type().TerraformType(context.Background()), nil)}
+		priorState.SetAttribute(context.Background(), path.Root("id"), "id")
+		priorState.SetAttribute(context.Background(), path.Root("password"), password)
+		priorState.SetAttribute(context.Background(), path.Root("password_wo_version"), version)
+		response := &planmodifier.Int64Response{PlanValue: types.Int64Value(2)}
+		modifier.PlanModifyInt64(context.Background(), planmodifier.Int64Request{
+			Path:        path.Root("password_wo_version"),
+			State:       priorState,
+			Plan:        tfsdk.Plan{Schema: priorState.Schema, Raw: priorState.Raw},
+			StateValue:  types.Int64PointerValue(version),
+			PlanValue:   types.Int64Value(2),
+			ConfigValue: types.Int64Value(2),
+		}, response)
+		assert.False(t, response.Diagnostics.HasError(), "%v", response.Diagnostics)
+		return response.RequiresReplace
+	}
+
+	version := int64(1)
+	assert.False(t, requiresReplace("", nil))
+	assert.True(t, requiresReplace("", &version))
+	assert.True(t, requiresReplace("password", nil))
+}
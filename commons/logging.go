@@ -0,0 +1,142 @@
+package commons
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// HTTPLogSubsystem is the tflog subsystem logging the API traffic. Its level can be
+	// raised independently with the TF_LOG_PROVIDER_FPTCLOUD_HTTP environment variable.
+	HTTPLogSubsystem = "fptcloud_http"
+	// RequestIDHeader carries the identifier of a request, to be given to FPT support
+	RequestIDHeader = "X-Request-Id"
+
+	redactedValue = "***"
+)
+
+var (
+	// sensitiveKeySuffixes are matched against JSON keys lower cased and stripped of '_' and '-'
+	sensitiveKeySuffixes = []string{"password", "secret", "secretkey", "secretaccesskey", "privatekey", "token"}
+
+	bearerTokenRegex = regexp.MustCompile(`(?i)(bearer\s+)[^\s",]+`)
+	privateKeyRegex  = regexp.MustCompile(`-----BEGIN ([A-Z ]*)PRIVATE KEY-----[\s\S]*?-----END ([A-Z ]*)PRIVATE KEY-----`)
+	// sensitiveFieldRegex catches sensitive JSON fields in bodies that cannot be parsed
+	sensitiveFieldRegex = regexp.MustCompile(`(?i)("[a-z_\-]*(password|secret|secret_?key|secret_?access_?key|private_?key|token)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+)
+
+// newHTTPLogContext returns the request context with the fptcloud_http subsystem, masking the
+// values that could leak through a field not redacted by redactBody
+func newHTTPLogContext(ctx context.Context) context.Context {
+	ctx = tflog.NewSubsystem(ctx, HTTPLogSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER_FPTCLOUD_HTTP"))
+	return tflog.SubsystemMaskAllFieldValuesRegexes(ctx, HTTPLogSubsystem, bearerTokenRegex, privateKeyRegex)
+}
+
+// ensureRequestID sets a new X-Request-Id on the request unless one is already present, and returns it
+func ensureRequestID(req *http.Request) string {
+	requestID := req.Header.Get(RequestIDHeader)
+	if requestID == "" {
+		requestID = uuid.NewString()
+		req.Header.Set(RequestIDHeader, requestID)
+	}
+	return requestID
+}
+
+// logRequest logs an outgoing API request at TRACE level
+func logRequest(ctx context.Context, req *http.Request, requestID string, attempt int) {
+	fields := map[string]interface{}{
+		"http_method": req.Method,
+		"http_url":    req.URL.String(),
+		"request_id":  requestID,
+		"attempt":     attempt + 1,
+	}
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			if content, err := io.ReadAll(body); err == nil && len(content) > 0 {
+				fields["http_request_body"] = redactBody(content)
+			}
+			body.Close()
+		}
+	}
+	tflog.SubsystemTrace(ctx, HTTPLogSubsystem, "Sending API request", fields)
+}
+
+// logResponse logs an API response, or the transport error, at TRACE level
+func logResponse(ctx context.Context, req *http.Request, requestID string, statusCode int, body []byte, duration time.Duration, err error) {
+	fields := map[string]interface{}{
+		"http_method": req.Method,
+		"http_url":    req.URL.String(),
+		"request_id":  requestID,
+		"duration_ms": duration.Milliseconds(),
+	}
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.SubsystemTrace(ctx, HTTPLogSubsystem, "API request failed", fields)
+		return
+	}
+
+	fields["http_status_code"] = statusCode
+	if len(body) > 0 {
+		fields["http_response_body"] = redactBody(body)
+	}
+	tflog.SubsystemTrace(ctx, HTTPLogSubsystem, "Received API response", fields)
+}
+
+// redactBody returns the body with passwords, secret keys, tokens and private keys replaced by ***
+func redactBody(body []byte) string {
+	var decoded interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&decoded); err == nil && !decoder.More() {
+		if redacted, err := json.Marshal(redactValue(decoded)); err == nil {
+			return redactString(string(redacted))
+		}
+	}
+
+	return redactString(sensitiveFieldRegex.ReplaceAllString(string(body), `$1"`+redactedValue+`"`))
+}
+
+// redactString masks bearer tokens and PEM private keys anywhere in the given text
+func redactString(value string) string {
+	value = bearerTokenRegex.ReplaceAllString(value, "${1}"+redactedValue)
+	return privateKeyRegex.ReplaceAllString(value, redactedValue)
+}
+
+func redactValue(value interface{}) interface{} {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for key, item := range typed {
+			if isSensitiveKey(key) {
+				if item != nil && item != "" {
+					typed[key] = redactedValue
+				}
+				continue
+			}
+			typed[key] = redactValue(item)
+		}
+	case []interface{}:
+		for i, item := range typed {
+			typed[i] = redactValue(item)
+		}
+	}
+	return value
+}
+
+func isSensitiveKey(key string) bool {
+	normalized := strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(key))
+	for _, suffix := range sensitiveKeySuffixes {
+		if strings.HasSuffix(normalized, suffix) {
+			return true
+		}
+	}
+	return false
+}
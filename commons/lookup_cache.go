@@ -0,0 +1,137 @@
+package commons
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+type lookupContextKey struct{}
+
+// lookupCache keeps the responses of read-mostly catalog endpoints, such as flavors, images or storage
+// policies, for the life of the provider process. Concurrent lookups of the same key wait for the first
+// one instead of sending their own request.
+type lookupCache struct {
+	mu      sync.Mutex
+	entries map[string]*lookupEntry
+	now     func() time.Time
+}
+
+type lookupEntry struct {
+	ready   chan struct{}
+	body    []byte
+	err     error
+	expires time.Time
+}
+
+func newLookupCache() *lookupCache {
+	return &lookupCache{entries: map[string]*lookupEntry{}, now: time.Now}
+}
+
+// get returns the response cached under key, calling fetch when it is missing or expired.
+// Errors are returned to the callers waiting on the same lookup but never cached. A caller whose context is still
+// live does not take the context error of the lookup it waited on, and sends its own instead.
+func (c *lookupCache) get(ctx context.Context, key string, ttl time.Duration, fetch func() ([]byte, error)) ([]byte, error) {
+	for {
+		body, waited, err := c.getOrFetch(ctx, key, ttl, fetch)
+		if waited && isContextError(err) && ctx.Err() == nil {
+			continue
+		}
+		return body, err
+	}
+}
+
+// getOrFetch is a single attempt of get, also telling whether the response came from the lookup of another caller
+func (c *lookupCache) getOrFetch(ctx context.Context, key string, ttl time.Duration, fetch func() ([]byte, error)) ([]byte, bool, error) {
+	c.mu.Lock()
+	if entry, ok := c.entries[key]; ok {
+		select {
+		case <-entry.ready:
+			if c.now().Before(entry.expires) {
+				c.mu.Unlock()
+				return entry.body, true, nil
+			}
+			delete(c.entries, key)
+		default:
+			c.mu.Unlock()
+			select {
+			case <-entry.ready:
+				return entry.body, true, entry.err
+			case <-ctx.Done():
+				return nil, false, ctx.Err()
+			}
+		}
+	}
+
+	entry := &lookupEntry{ready: make(chan struct{})}
+	c.entries[key] = entry
+	c.mu.Unlock()
+
+	body, err := fetch()
+
+	c.mu.Lock()
+	entry.body, entry.err = body, err
+	entry.expires = c.now().Add(ttl)
+	if err != nil && c.entries[key] == entry {
+		delete(c.entries, key)
+	}
+	c.mu.Unlock()
+	close(entry.ready)
+
+	return body, false, err
+}
+
+// isContextError reports whether err comes from a canceled or timed out context
+func isContextError(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// purge drops every cached response. Lookups in flight still complete but are not kept.
+func (c *lookupCache) purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = map[string]*lookupEntry{}
+}
+
+// withLookup marks the context of a catalog lookup, so that a lookup sent as POST is not taken for a change
+func withLookup(ctx context.Context) context.Context {
+	return context.WithValue(ctx, lookupContextKey{}, true)
+}
+
+// isMutating reports whether a request may change the infrastructure, and so the catalog: it purges the cached
+// lookups and is refused by a read-only client
+func isMutating(req *http.Request) bool {
+	if req.Method == http.MethodGet || req.Method == http.MethodHead || req.Method == http.MethodOptions {
+		return false
+	}
+	isLookup, _ := req.Context().Value(lookupContextKey{}).(bool)
+	return !isLookup
+}
+
+// SendCachedGetRequestWithContext sends a get request to a read-mostly catalog endpoint, such as flavors,
+// images or storage policies, reusing the response for LookupCacheTTL. The cached responses are dropped
+// by every mutating request sent through the client.
+func (c *Client) SendCachedGetRequestWithContext(ctx context.Context, requestURL string) ([]byte, error) {
+	if c.LookupCacheTTL <= 0 || c.lookupCache == nil {
+		return c.SendGetRequestWithContext(ctx, requestURL)
+	}
+	return c.lookupCache.get(ctx, http.MethodGet+" "+requestURL, c.LookupCacheTTL, func() ([]byte, error) {
+		return c.SendGetRequestWithContext(ctx, requestURL)
+	})
+}
+
+// SendCachedPostRequestWithContext sends a catalog lookup that the API exposes as a post request, such as
+// the flavor search by name, and caches its response like SendCachedGetRequestWithContext
+func (c *Client) SendCachedPostRequestWithContext(ctx context.Context, requestURL string, params interface{}) ([]byte, error) {
+	ctx = withLookup(ctx)
+	if c.LookupCacheTTL <= 0 || c.lookupCache == nil {
+		return c.SendPostRequestWithContext(ctx, requestURL, params)
+	}
+	jsonValue, _ := json.Marshal(params)
+	return c.lookupCache.get(ctx, http.MethodPost+" "+requestURL+" "+string(jsonValue), c.LookupCacheTTL, func() ([]byte, error) {
+		return c.SendPostRequestWithContext(ctx, requestURL, params)
+	})
+}
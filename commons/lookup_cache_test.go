@@ -0,0 +1,178 @@
+package commons
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newLookupTestClient(t *testing.T, handler http.HandlerFunc) (*Client, *int32) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method == http.MethodGet || req.URL.Path == "/flavor/find-by-name" {
+			atomic.AddInt32(&calls, 1)
+		}
+		handler(rw, req)
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := NewClientForTestingWithServer(server)
+	assert.NoError(t, err)
+	client.MaxRetries = 0
+	return client, &calls
+}
+
+func okHandler(rw http.ResponseWriter, req *http.Request) {
+	_, _ = rw.Write([]byte(`{"data": "success"}`))
+}
+
+func TestSendCachedGetRequest_ReusesResponse(t *testing.T) {
+	client, calls := newLookupTestClient(t, okHandler)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		resp, err := client.SendCachedGetRequestWithContext(ctx, "/flavors")
+		assert.NoError(t, err)
+		assert.Contains(t, string(resp), "success")
+	}
+	_, err := client.SendCachedGetRequestWithContext(ctx, "/images")
+	assert.NoError(t, err)
+
+	assert.Equal(t, int32(2), atomic.LoadInt32(calls))
+}
+
+func TestSendCachedGetRequest_PurgedByMutatingRequest(t *testing.T) {
+	client, calls := newLookupTestClient(t, okHandler)
+	ctx := context.Background()
+
+	_, _ = client.SendCachedGetRequestWithContext(ctx, "/flavors")
+	_, err := client.SendPutRequestWithContext(ctx, "/instance/rename", map[string]string{"new_name": "renamed"})
+	assert.NoError(t, err)
+	_, _ = client.SendCachedGetRequestWithContext(ctx, "/flavors")
+
+	assert.Equal(t, int32(2), atomic.LoadInt32(calls))
+}
+
+func TestSendCachedPostRequest_KeyedByBodyWithoutPurging(t *testing.T) {
+	client, calls := newLookupTestClient(t, okHandler)
+	ctx := context.Background()
+
+	_, _ = client.SendCachedGetRequestWithContext(ctx, "/flavors")
+	_, _ = client.SendCachedPostRequestWithContext(ctx, "/flavor/find-by-name", map[string]string{"flavor_name": "2C2G"})
+	_, _ = client.SendCachedPostRequestWithContext(ctx, "/flavor/find-by-name", map[string]string{"flavor_name": "2C2G"})
+	_, _ = client.SendCachedPostRequestWithContext(ctx, "/flavor/find-by-name", map[string]string{"flavor_name": "4C8G"})
+	_, _ = client.SendCachedGetRequestWithContext(ctx, "/flavors")
+
+	assert.Equal(t, int32(3), atomic.LoadInt32(calls))
+}
+
+func TestSendCachedGetRequest_ExpiresAfterTTL(t *testing.T) {
+	client, calls := newLookupTestClient(t, okHandler)
+	now := time.Now()
+	client.lookupCache.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	_, _ = client.SendCachedGetRequestWithContext(ctx, "/flavors")
+	now = now.Add(DefaultLookupCacheTTL - time.Second)
+	_, _ = client.SendCachedGetRequestWithContext(ctx, "/flavors")
+	now = now.Add(2 * time.Second)
+	_, _ = client.SendCachedGetRequestWithContext(ctx, "/flavors")
+
+	assert.Equal(t, int32(2), atomic.LoadInt32(calls))
+}
+
+func TestSendCachedGetRequest_DoesNotCacheErrors(t *testing.T) {
+	var failed int32
+	client, calls := newLookupTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		if atomic.CompareAndSwapInt32(&failed, 0, 1) {
+			rw.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		okHandler(rw, req)
+	})
+	ctx := context.Background()
+
+	_, err := client.SendCachedGetRequestWithContext(ctx, "/flavors")
+	assert.Error(t, err)
+	resp, err := client.SendCachedGetRequestWithContext(ctx, "/flavors")
+	assert.NoError(t, err)
+	assert.Contains(t, string(resp), "success")
+
+	assert.Equal(t, int32(2), atomic.LoadInt32(calls))
+}
+
+func TestSendCachedGetRequest_SharesConcurrentLookups(t *testing.T) {
+	release := make(chan struct{})
+	client, calls := newLookupTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		<-release
+		okHandler(rw, req)
+	})
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.SendCachedGetRequestWithContext(ctx, "/storage-policies")
+			assert.NoError(t, err)
+			assert.Contains(t, string(resp), "success")
+		}()
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(calls))
+}
+
+func TestSendCachedGetRequest_DisabledWithZeroTTL(t *testing.T) {
+	client, calls := newLookupTestClient(t, okHandler)
+	client.LookupCacheTTL = 0
+	ctx := context.Background()
+
+	_, _ = client.SendCachedGetRequestWithContext(ctx, "/flavors")
+	_, _ = client.SendCachedGetRequestWithContext(ctx, "/flavors")
+
+	assert.Equal(t, int32(2), atomic.LoadInt32(calls))
+}
+
+func TestSendCachedGetRequest_RetriesLookupCanceledByAnotherCaller(t *testing.T) {
+	var requests int32
+	started := make(chan struct{})
+	client, calls := newLookupTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		if atomic.AddInt32(&requests, 1) == 1 {
+			close(started)
+			<-req.Context().Done()
+			return
+		}
+		okHandler(rw, req)
+	})
+	leaderCtx, cancel := context.WithCancel(context.Background())
+
+	leaderErr := make(chan error, 1)
+	go func() {
+		_, err := client.SendCachedGetRequestWithContext(leaderCtx, "/flavors")
+		leaderErr <- err
+	}()
+	<-started
+
+	followerResp := make(chan []byte, 1)
+	go func() {
+		resp, err := client.SendCachedGetRequestWithContext(context.Background(), "/flavors")
+		assert.NoError(t, err)
+		followerResp <- resp
+	}()
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+
+	assert.ErrorIs(t, <-leaderErr, context.Canceled)
+	assert.Contains(t, string(<-followerResp), "success")
+	assert.Equal(t, int32(2), atomic.LoadInt32(calls))
+}
@@ -0,0 +1,97 @@
+package commons
+
+import (
+	"context"
+	"sync"
+)
+
+// PageFetcher returns the items of a page of a listing, pages starting at 1, along with the total
+// number of items of the listing as reported by the API
+type PageFetcher[T any] func(ctx context.Context, page int, pageSize int) ([]T, int, error)
+
+// Paginator walks every page of a paged listing, such as the buckets or the load balancers of a VPC
+type Paginator[T any] struct {
+	Fetch PageFetcher[T]
+	// PageSize is the number of items requested per page, DefaultPageSize when unset
+	PageSize int
+	// Concurrency bounds the number of pages fetched at once once the total is known, 1 when unset
+	Concurrency int
+}
+
+// ListAll returns the items of every page of a listing, in order
+func ListAll[T any](ctx context.Context, pageSize int, fetch PageFetcher[T]) ([]T, error) {
+	return Paginator[T]{Fetch: fetch, PageSize: pageSize, Concurrency: DefaultPageConcurrency}.All(ctx)
+}
+
+// All fetches the first page, then the remaining ones announced by its total, and returns their items in order
+func (p Paginator[T]) All(ctx context.Context) ([]T, error) {
+	pageSize := p.PageSize
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	concurrency := p.Concurrency
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+
+	first, total, err := p.Fetch(ctx, 1, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	if total <= len(first) || len(first) == 0 {
+		return first, nil
+	}
+
+	pageCount := (total + pageSize - 1) / pageSize
+	pages := make([][]T, pageCount)
+	pages[0] = first
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	slots := make(chan struct{}, concurrency)
+	for page := 2; page <= pageCount; page++ {
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(page int) {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			items, _, err := p.Fetch(ctx, page, pageSize)
+			if err != nil {
+				errOnce.Do(func() {
+					firstErr = err
+					cancel()
+				})
+				return
+			}
+			pages[page-1] = items
+		}(page)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	items := make([]T, 0, total)
+	for _, page := range pages {
+		items = append(items, page...)
+	}
+	return items, nil
+}
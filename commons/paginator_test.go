@@ -0,0 +1,116 @@
+package commons
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeListing serves the items 0 to total-1 in pages, recording the requested pages
+type fakeListing struct {
+	total    int
+	mu       sync.Mutex
+	requests []int
+	inFlight int32
+	maxSeen  int32
+	failPage int
+}
+
+func (l *fakeListing) fetch(ctx context.Context, page int, pageSize int) ([]int, int, error) {
+	l.mu.Lock()
+	l.requests = append(l.requests, page)
+	l.mu.Unlock()
+
+	inFlight := atomic.AddInt32(&l.inFlight, 1)
+	defer atomic.AddInt32(&l.inFlight, -1)
+	for {
+		seen := atomic.LoadInt32(&l.maxSeen)
+		if inFlight <= seen || atomic.CompareAndSwapInt32(&l.maxSeen, seen, inFlight) {
+			break
+		}
+	}
+	time.Sleep(5 * time.Millisecond)
+
+	if page == l.failPage {
+		return nil, 0, fmt.Errorf("page %d failed", page)
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	items := make([]int, 0, pageSize)
+	for i := (page - 1) * pageSize; i < page*pageSize && i < l.total; i++ {
+		items = append(items, i)
+	}
+	return items, l.total, nil
+}
+
+func TestPaginator_SinglePage(t *testing.T) {
+	listing := &fakeListing{total: 3}
+
+	items, err := ListAll(context.Background(), 10, listing.fetch)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0, 1, 2}, items)
+	assert.Equal(t, []int{1}, listing.requests)
+}
+
+func TestPaginator_WalksEveryPageInOrder(t *testing.T) {
+	listing := &fakeListing{total: 95}
+
+	items, err := Paginator[int]{Fetch: listing.fetch, PageSize: 10}.All(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, items, 95)
+	for i, item := range items {
+		assert.Equal(t, i, item)
+	}
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, listing.requests)
+	assert.Equal(t, int32(1), listing.maxSeen)
+}
+
+func TestPaginator_BoundsConcurrency(t *testing.T) {
+	listing := &fakeListing{total: 200}
+
+	items, err := Paginator[int]{Fetch: listing.fetch, PageSize: 10, Concurrency: 3}.All(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, items, 200)
+	assert.Equal(t, 199, items[199])
+	assert.LessOrEqual(t, listing.maxSeen, int32(3))
+	assert.Greater(t, listing.maxSeen, int32(1))
+}
+
+func TestPaginator_StopsOnError(t *testing.T) {
+	listing := &fakeListing{total: 500, failPage: 3}
+
+	items, err := Paginator[int]{Fetch: listing.fetch, PageSize: 10, Concurrency: 2}.All(context.Background())
+	assert.EqualError(t, err, "page 3 failed")
+	assert.Nil(t, items)
+	assert.Less(t, len(listing.requests), 50)
+}
+
+func TestPaginator_FirstPageError(t *testing.T) {
+	fetch := func(ctx context.Context, page int, pageSize int) ([]string, int, error) {
+		return nil, 0, errors.New("unauthorized")
+	}
+
+	_, err := ListAll(context.Background(), 0, fetch)
+	assert.EqualError(t, err, "unauthorized")
+}
+
+func TestPaginator_DefaultPageSize(t *testing.T) {
+	var pageSizes []int
+	fetch := func(ctx context.Context, page int, pageSize int) ([]string, int, error) {
+		pageSizes = append(pageSizes, pageSize)
+		return []string{"only"}, 0, nil
+	}
+
+	items, err := Paginator[string]{Fetch: fetch}.All(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"only"}, items)
+	assert.Equal(t, []int{DefaultPageSize}, pageSizes)
+}
@@ -0,0 +1,153 @@
+package commons
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// DefaultProfileName is the profile used when neither the profile attribute nor FPTCLOUD_PROFILE is set
+	DefaultProfileName = "default"
+	// ConfigFileEnvVar overrides the location of the shared config file
+	ConfigFileEnvVar = "FPTCLOUD_CONFIG_FILE"
+)
+
+// Credentials are the settings identifying the API endpoint and the tenant to manage
+type Credentials struct {
+	Token       string
+	TenantName  string
+	Region      string
+	ApiEndpoint string
+}
+
+// DefaultConfigFilePath returns the path of the shared config file, ~/.fptcloud/config unless
+// FPTCLOUD_CONFIG_FILE is set
+func DefaultConfigFilePath() string {
+	if path := os.Getenv(ConfigFileEnvVar); path != "" {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".fptcloud", "config")
+}
+
+// LoadProfiles parses the named profiles of an INI shared config file:
+//
+//	[default]
+//	token       = ...
+//	tenant_name = my-tenant
+//	region      = VN/HAN
+//
+//	[japan]
+//	region       = JP/JCSI2
+//	api_endpoint = https://console-api.fptcloud.jp/api
+func LoadProfiles(path string) (map[string]Credentials, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	profiles := map[string]Credentials{}
+	var current string
+	scanner := bufio.NewScanner(file)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			current = strings.TrimSpace(strings.TrimPrefix(strings.Trim(line, "[]"), "profile "))
+			if current == "" {
+				return nil, fmt.Errorf("%s:%d: empty profile name", path, lineNumber)
+			}
+			profiles[current] = profiles[current]
+			continue
+		}
+
+		key, value, found := strings.Cut(line, "=")
+		if !found {
+			return nil, fmt.Errorf("%s:%d: expected key = value", path, lineNumber)
+		}
+		if current == "" {
+			return nil, fmt.Errorf("%s:%d: setting outside of a [profile] section", path, lineNumber)
+		}
+
+		profile := profiles[current]
+		value = strings.Trim(strings.TrimSpace(value), `"'`)
+		switch strings.TrimSpace(key) {
+		case "token":
+			profile.Token = value
+		case "tenant_name":
+			profile.TenantName = value
+		case "region":
+			profile.Region = value
+		case "api_endpoint":
+			profile.ApiEndpoint = value
+		default:
+			return nil, fmt.Errorf("%s:%d: unknown setting %q", path, lineNumber, strings.TrimSpace(key))
+		}
+		profiles[current] = profile
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return profiles, nil
+}
+
+// ApplyProfile fills the credentials left empty by the provider configuration and the environment with the
+// ones of the named profile of the shared config file. An empty name selects the default profile, which,
+// unlike a named one, may be missing along with the file.
+func (c *Credentials) ApplyProfile(name string) error {
+	explicit := name != ""
+	if !explicit {
+		name = DefaultProfileName
+	}
+
+	path := DefaultConfigFilePath()
+	if path == "" {
+		if explicit {
+			return fmt.Errorf("profile %q can not be loaded: the home directory is unknown", name)
+		}
+		return nil
+	}
+
+	profiles, err := LoadProfiles(path)
+	if err != nil {
+		if !explicit && errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("failed to read the shared config file: %w", err)
+	}
+
+	profile, ok := profiles[name]
+	if !ok {
+		if explicit {
+			return fmt.Errorf("profile %q not found in %s", name, path)
+		}
+		return nil
+	}
+
+	if c.Token == "" {
+		c.Token = profile.Token
+	}
+	if c.TenantName == "" {
+		c.TenantName = profile.TenantName
+	}
+	if c.Region == "" {
+		c.Region = profile.Region
+	}
+	if c.ApiEndpoint == "" {
+		c.ApiEndpoint = profile.ApiEndpoint
+	}
+	return nil
+}
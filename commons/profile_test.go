@@ -0,0 +1,84 @@
+package commons
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testConfigFile = `
+# shared credentials
+[default]
+token       = default-token
+tenant_name = default-tenant
+region      = VN/HAN
+
+[profile japan]
+token        = "japan-token"
+region       = JP/JCSI2
+api_endpoint = https://console-api.fptcloud.jp/api
+`
+
+func writeTestConfigFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "config")
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	t.Setenv(ConfigFileEnvVar, path)
+	return path
+}
+
+func TestLoadProfiles_ParsesNamedProfiles(t *testing.T) {
+	path := writeTestConfigFile(t, testConfigFile)
+
+	profiles, err := LoadProfiles(path)
+	assert.NoError(t, err)
+	assert.Equal(t, Credentials{Token: "default-token", TenantName: "default-tenant", Region: "VN/HAN"}, profiles["default"])
+	assert.Equal(t, Credentials{Token: "japan-token", Region: "JP/JCSI2", ApiEndpoint: "https://console-api.fptcloud.jp/api"}, profiles["japan"])
+}
+
+func TestLoadProfiles_RejectsUnknownSetting(t *testing.T) {
+	path := writeTestConfigFile(t, "[default]\ntokn = typo\n")
+
+	_, err := LoadProfiles(path)
+	assert.ErrorContains(t, err, `config:2: unknown setting "tokn"`)
+}
+
+func TestApplyProfile_KeepsExplicitValues(t *testing.T) {
+	writeTestConfigFile(t, testConfigFile)
+
+	credentials := Credentials{Token: "explicit-token", TenantName: "explicit-tenant"}
+	assert.NoError(t, credentials.ApplyProfile("japan"))
+	assert.Equal(t, Credentials{
+		Token:       "explicit-token",
+		TenantName:  "explicit-tenant",
+		Region:      "JP/JCSI2",
+		ApiEndpoint: "https://console-api.fptcloud.jp/api",
+	}, credentials)
+}
+
+func TestApplyProfile_UsesDefaultProfile(t *testing.T) {
+	writeTestConfigFile(t, testConfigFile)
+
+	credentials := Credentials{}
+	assert.NoError(t, credentials.ApplyProfile(""))
+	assert.Equal(t, "default-token", credentials.Token)
+	assert.Equal(t, "default-tenant", credentials.TenantName)
+}
+
+func TestApplyProfile_MissingProfile(t *testing.T) {
+	writeTestConfigFile(t, "[japan]\nregion = JP/JCSI2\n")
+
+	credentials := Credentials{}
+	assert.ErrorContains(t, credentials.ApplyProfile("staging"), `profile "staging" not found`)
+	assert.NoError(t, credentials.ApplyProfile(""))
+	assert.Equal(t, Credentials{}, credentials)
+}
+
+func TestApplyProfile_MissingFile(t *testing.T) {
+	t.Setenv(ConfigFileEnvVar, filepath.Join(t.TempDir(), "missing"))
+
+	credentials := Credentials{}
+	assert.NoError(t, credentials.ApplyProfile(""))
+	assert.Error(t, credentials.ApplyProfile("staging"))
+}
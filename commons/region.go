@@ -0,0 +1,88 @@
+package commons
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// PlatformVMW is the VMware platform of a VPC
+	PlatformVMW = "VMW"
+	// PlatformOSP is the OpenStack platform of a VPC
+	PlatformOSP = "OSP"
+)
+
+// Region is an FPT Cloud region the provider can manage
+type Region struct {
+	// Code is the value of the provider region attribute, such as VN/HAN
+	Code string
+	// Header is the value of the fpt-region header sent to the regional services
+	Header string
+	// Platforms are the VPC platforms known to be available in the region, another one is warned about but still sent
+	Platforms []string
+}
+
+// regions is the registry of the supported regions, adding a region only takes a line here
+var regions = []Region{
+	{Code: "VN/HAN", Header: "hanoi-vn", Platforms: []string{PlatformVMW, PlatformOSP}},
+	{Code: "VN/SGN", Header: "saigon-vn", Platforms: []string{PlatformVMW, PlatformOSP}},
+	{Code: "VN/HAN2", Header: "hanoi-2-vn", Platforms: []string{PlatformOSP}},
+	{Code: "VN/SGN2", Header: "saigon-02-vn", Platforms: []string{PlatformOSP}},
+	{Code: "JP/JCSI2", Header: "JP/JCSI2", Platforms: []string{PlatformVMW}},
+}
+
+// LookupRegion returns the registered region with the given code
+func LookupRegion(code string) (Region, bool) {
+	for _, region := range regions {
+		if region.Code == code {
+			return region, true
+		}
+	}
+	return Region{}, false
+}
+
+// RegionCodes returns the codes of the registered regions
+func RegionCodes() []string {
+	codes := make([]string, 0, len(regions))
+	for _, region := range regions {
+		codes = append(codes, region.Code)
+	}
+	return codes
+}
+
+// SupportsPlatform reports whether VPCs of the given platform, such as VMW or osp, are available in the region
+func (r Region) SupportsPlatform(platform string) bool {
+	for _, supported := range r.Platforms {
+		if strings.EqualFold(supported, platform) {
+			return true
+		}
+	}
+	return false
+}
+
+// SetRegionHeaders sets the fpt-region header of the client region on a request to a regional service,
+// and the infra-type header when a platform is given. The client region is one of the registry, the
+// provider configuration rejecting the others, so no fpt-region header is sent for an unregistered one.
+// A platform the region is not known to offer is only warned about, the API having the final say.
+func (c *Client) SetRegionHeaders(req *http.Request, platform string) {
+	if region, ok := LookupRegion(c.Region); ok {
+		if platform != "" && !region.SupportsPlatform(platform) {
+			tflog.Warn(req.Context(), fmt.Sprintf("The %s platform is not known to be available in region %s", strings.ToUpper(platform), region.Code))
+		}
+		req.Header.Set("fpt-region", region.Header)
+	}
+
+	if platform != "" {
+		req.Header.Set("infra-type", strings.ToUpper(platform))
+	}
+}
+
+// SendRegionalRequest sends a request to a regional service, such as the database or the MFKE one,
+// with the region headers set
+func (c *Client) SendRegionalRequest(req *http.Request, platform string) ([]byte, error) {
+	c.SetRegionHeaders(req, platform)
+	return c.SendRequest(req)
+}
@@ -0,0 +1,71 @@
+package commons
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func newRegionTestRequest(t *testing.T) *http.Request {
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://console-api.fptcloud.com/api/v1/xplat/database", nil)
+	assert.NoError(t, err)
+	return req
+}
+
+func TestLookupRegion(t *testing.T) {
+	region, ok := LookupRegion("VN/SGN2")
+	assert.True(t, ok)
+	assert.Equal(t, "saigon-02-vn", region.Header)
+	assert.True(t, region.SupportsPlatform("osp"))
+
+	_, ok = LookupRegion("VN/DNG")
+	assert.False(t, ok)
+	assert.Contains(t, RegionCodes(), "JP/JCSI2")
+}
+
+func TestSetRegionHeaders(t *testing.T) {
+	client := &Client{Region: "VN/HAN"}
+
+	req := newRegionTestRequest(t)
+	client.SetRegionHeaders(req, "")
+	assert.Equal(t, "hanoi-vn", req.Header.Get("fpt-region"))
+	assert.Empty(t, req.Header.Get("infra-type"))
+
+	req = newRegionTestRequest(t)
+	client.SetRegionHeaders(req, "osp")
+	assert.Equal(t, "hanoi-vn", req.Header.Get("fpt-region"))
+	assert.Equal(t, "OSP", req.Header.Get("infra-type"))
+}
+
+func TestSetRegionHeaders_UnsupportedPlatform(t *testing.T) {
+	client := &Client{Region: "JP/JCSI2"}
+
+	var output bytes.Buffer
+	req, err := http.NewRequestWithContext(tflogtest.RootLogger(context.Background(), &output), http.MethodGet, "https://console-api.fptcloud.com/api/v1/xplat/database", nil)
+	assert.NoError(t, err)
+
+	client.SetRegionHeaders(req, "osp")
+	assert.Equal(t, "JP/JCSI2", req.Header.Get("fpt-region"))
+	assert.Equal(t, "OSP", req.Header.Get("infra-type"))
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	assert.NoError(t, err)
+	if assert.Len(t, entries, 1) {
+		assert.Equal(t, "warn", entries[0]["@level"])
+		assert.Equal(t, "The OSP platform is not known to be available in region JP/JCSI2", entries[0]["@message"])
+	}
+}
+
+func TestSetRegionHeaders_UnregisteredRegion(t *testing.T) {
+	client := &Client{Region: "TEST"}
+
+	req := newRegionTestRequest(t)
+	client.SetRegionHeaders(req, "vmw")
+	_, ok := req.Header["Fpt-Region"]
+	assert.False(t, ok)
+	assert.Equal(t, "VMW", req.Header.Get("infra-type"))
+}
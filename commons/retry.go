@@ -0,0 +1,130 @@
+package commons
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// retryBaseWait is the first backoff step, doubled on every following attempt
+var retryBaseWait = 1 * time.Second
+
+// isIdempotentMethod reports whether a request with the given method can be replayed safely
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// shouldRetryStatus reports whether a response status is worth retrying.
+// 429 and 503 mean the request was rejected before being processed, so they are
+// retried for every method. 502 and 504 may hide a request that was applied, so
+// they are only retried for idempotent methods.
+func shouldRetryStatus(method string, statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		return isIdempotentMethod(method)
+	}
+	return false
+}
+
+// shouldRetryError reports whether a transport error is transient and the request can be replayed
+func shouldRetryError(method string, err error) bool {
+	if !isIdempotentMethod(method) {
+		return false
+	}
+	if isContextError(err) {
+		return false
+	}
+
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) && dnsErr.IsNotFound {
+		return false
+	}
+
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
+
+// retryWait computes the delay before the next attempt using jittered exponential
+// backoff, or the server supplied Retry-After value when present. The result never
+// exceeds maxWait.
+func retryWait(attempt int, retryAfter string, maxWait time.Duration) time.Duration {
+	if wait, ok := parseRetryAfter(retryAfter); ok {
+		if wait > maxWait {
+			return maxWait
+		}
+		return wait
+	}
+
+	backoff := float64(retryBaseWait) * math.Pow(2, float64(attempt))
+	if backoff > float64(maxWait) {
+		backoff = float64(maxWait)
+	}
+	if backoff <= 0 {
+		return 0
+	}
+
+	// Full jitter keeps parallel resource operations from retrying in lockstep
+	return time.Duration(rand.Int63n(int64(backoff)) + 1)
+}
+
+// parseRetryAfter parses a Retry-After header in either delay-seconds or HTTP-date form
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
+
+// SleepWithContext waits for the given duration unless the context is done first
+func SleepWithContext(ctx context.Context, wait time.Duration) error {
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// rewindBody resets the request body so the request can be sent again
+func rewindBody(req *http.Request) error {
+	if req.Body == nil || req.GetBody == nil {
+		return nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return err
+	}
+	req.Body = body
+	return nil
+}
@@ -0,0 +1,139 @@
+package commons
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newRetryTestClient(t *testing.T, handler http.HandlerFunc) (*Client, *int32) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		handler(rw, req)
+	}))
+	t.Cleanup(server.Close)
+
+	previousBaseWait := retryBaseWait
+	retryBaseWait = time.Millisecond
+	t.Cleanup(func() { retryBaseWait = previousBaseWait })
+
+	client, err := NewClientForTestingWithServer(server)
+	assert.NoError(t, err)
+	client.RetryMaxWait = 10 * time.Millisecond
+	return client, &calls
+}
+
+func TestSendRequest_RetriesOnServiceUnavailable(t *testing.T) {
+	var attempts int32
+	client, calls := newRetryTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			rw.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = rw.Write([]byte(`{"data": "success"}`))
+	})
+
+	resp, err := client.SendGetRequest("/test")
+	assert.NoError(t, err)
+	assert.Contains(t, string(resp), "success")
+	assert.Equal(t, int32(3), atomic.LoadInt32(calls))
+}
+
+func TestSendRequest_StopsAfterMaxRetries(t *testing.T) {
+	client, calls := newRetryTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusTooManyRequests)
+	})
+	client.MaxRetries = 2
+
+	_, err := client.SendGetRequest("/test")
+	assert.Error(t, err)
+	var httpErr HTTPError
+	assert.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, http.StatusTooManyRequests, httpErr.Code)
+	assert.Equal(t, int32(3), atomic.LoadInt32(calls))
+}
+
+func TestSendRequest_DoesNotRetryPostOnBadGateway(t *testing.T) {
+	client, calls := newRetryTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusBadGateway)
+	})
+
+	_, err := client.SendPostRequest("/test", map[string]string{"key": "value"})
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(calls))
+}
+
+func TestSendRequest_ReplaysBodyOnRetry(t *testing.T) {
+	var bodies []string
+	client, _ := newRetryTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		bodies = append(bodies, string(body))
+		if len(bodies) == 1 {
+			rw.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = rw.Write([]byte(`{}`))
+	})
+
+	_, err := client.SendPostRequest("/test", map[string]string{"key": "value"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{`{"key":"value"}`, `{"key":"value"}`}, bodies)
+}
+
+func TestSendRequest_DoesNotRetryClientErrors(t *testing.T) {
+	client, calls := newRetryTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusBadRequest)
+	})
+
+	_, err := client.SendGetRequest("/test")
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(calls))
+}
+
+func TestSendRequest_RetriesDisabled(t *testing.T) {
+	client, calls := newRetryTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusServiceUnavailable)
+	})
+	client.MaxRetries = 0
+
+	_, err := client.SendGetRequest("/test")
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(calls))
+}
+
+func TestRetryWait_HonorsRetryAfterSeconds(t *testing.T) {
+	assert.Equal(t, 2*time.Second, retryWait(0, "2", time.Minute))
+}
+
+func TestRetryWait_CapsRetryAfterAtMaxWait(t *testing.T) {
+	assert.Equal(t, 5*time.Second, retryWait(0, "120", 5*time.Second))
+}
+
+func TestRetryWait_HonorsRetryAfterDate(t *testing.T) {
+	date := time.Now().Add(3 * time.Second).UTC().Format(http.TimeFormat)
+	wait := retryWait(0, date, time.Minute)
+	assert.True(t, wait > 0 && wait <= 3*time.Second)
+}
+
+func TestRetryWait_BackoffIsBoundedByMaxWait(t *testing.T) {
+	for attempt := 0; attempt < 10; attempt++ {
+		wait := retryWait(attempt, "", 4*time.Second)
+		assert.True(t, wait > 0 && wait <= 4*time.Second)
+	}
+}
+
+func TestShouldRetryStatus(t *testing.T) {
+	assert.True(t, shouldRetryStatus(http.MethodPost, http.StatusTooManyRequests))
+	assert.True(t, shouldRetryStatus(http.MethodPost, http.StatusServiceUnavailable))
+	assert.True(t, shouldRetryStatus(http.MethodGet, http.StatusBadGateway))
+	assert.True(t, shouldRetryStatus(http.MethodDelete, http.StatusGatewayTimeout))
+	assert.False(t, shouldRetryStatus(http.MethodPost, http.StatusBadGateway))
+	assert.False(t, shouldRetryStatus(http.MethodGet, http.StatusInternalServerError))
+	assert.False(t, shouldRetryStatus(http.MethodGet, http.StatusNotFound))
+}
@@ -0,0 +1,88 @@
+package commons
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// StateUpgradeFunc upgrades the raw state of a resource from one schema version to the next. The raw state holds the
+// attributes as decoded from the JSON state, numbers being json.Number for the framework resources. It returns the
+// state of the next version, which must not hold the attributes removed from the schema.
+type StateUpgradeFunc func(ctx context.Context, rawState map[string]interface{}) (map[string]interface{}, error)
+
+// UpgradeRawState applies the upgrades of a resource to its raw state of the given schema version, the upgrade at
+// index i taking the state of version i to version i+1, up to the current version of the schema
+func UpgradeRawState(ctx context.Context, upgrades []StateUpgradeFunc, version int, rawState map[string]interface{}) (map[string]interface{}, error) {
+	if version < 0 || version > len(upgrades) {
+		return nil, fmt.Errorf("unsupported state version %d, the current schema version is %d", version, len(upgrades))
+	}
+	for ; version < len(upgrades); version++ {
+		if rawState == nil {
+			rawState = map[string]interface{}{}
+		}
+		var err error
+		if rawState, err = upgrades[version](ctx, rawState); err != nil {
+			return nil, fmt.Errorf("failed to upgrade the state from version %d: %w", version, err)
+		}
+	}
+	return rawState, nil
+}
+
+// SDKStateUpgrader returns the state upgrader of an SDKv2 resource from the given schema version, prior being the
+// resource as of that version. The SDK chains the upgraders up to the SchemaVersion of the resource.
+func SDKStateUpgrader(version int, prior *schema.Resource, upgrade StateUpgradeFunc) schema.StateUpgrader {
+	return schema.StateUpgrader{
+		Version: version,
+		Type:    prior.CoreConfigSchema().ImpliedType(),
+		Upgrade: func(ctx context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+			return UpgradeRawState(ctx, []StateUpgradeFunc{upgrade}, 0, rawState)
+		},
+	}
+}
+
+// FrameworkStateUpgraders returns the state upgraders of a framework resource whose schema version is the number of
+// upgrades, the upgrade at index i taking the state of version i to version i+1. Each upgrader applies all the
+// remaining upgrades to the JSON state, as the framework upgrades a state of any prior version in a single step.
+func FrameworkStateUpgraders(upgrades ...StateUpgradeFunc) map[int64]resource.StateUpgrader {
+	upgraders := make(map[int64]resource.StateUpgrader, len(upgrades))
+	for version := range upgrades {
+		version := version
+		upgraders[int64(version)] = resource.StateUpgrader{
+			StateUpgrader: func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+				if request.RawState == nil || request.RawState.JSON == nil {
+					response.Diagnostics.AddError("Unable to upgrade the resource state",
+						fmt.Sprintf("the state of version %d is not stored as JSON", version))
+					return
+				}
+				upgraded, err := upgradeJSONState(ctx, upgrades, version, request.RawState.JSON)
+				if err != nil {
+					response.Diagnostics.AddError("Unable to upgrade the resource state", err.Error())
+					return
+				}
+				response.DynamicValue = &tfprotov6.DynamicValue{JSON: upgraded}
+			},
+		}
+	}
+	return upgraders
+}
+
+// upgradeJSONState applies the upgrades to a JSON state, keeping its numbers as they were written
+func upgradeJSONState(ctx context.Context, upgrades []StateUpgradeFunc, version int, state []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(state))
+	decoder.UseNumber()
+	var rawState map[string]interface{}
+	if err := decoder.Decode(&rawState); err != nil {
+		return nil, fmt.Errorf("failed to decode the state of version %d: %w", version, err)
+	}
+	rawState, err := UpgradeRawState(ctx, upgrades, version, rawState)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(rawState)
+}
@@ -0,0 +1,93 @@
+package commons
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+// testUpgrades renames the attribute "label" of version 0 to "name", then sets "upgraded" in version 2
+var testUpgrades = []StateUpgradeFunc{
+	func(_ context.Context, rawState map[string]interface{}) (map[string]interface{}, error) {
+		rawState["name"] = rawState["label"]
+		delete(rawState, "label")
+		return rawState, nil
+	},
+	func(_ context.Context, rawState map[string]interface{}) (map[string]interface{}, error) {
+		rawState["upgraded"] = true
+		return rawState, nil
+	},
+}
+
+func TestUpgradeRawState(t *testing.T) {
+	upgraded, err := UpgradeRawState(context.Background(), testUpgrades, 0, map[string]interface{}{"label": "web"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"name": "web", "upgraded": true}, upgraded)
+
+	upgraded, err = UpgradeRawState(context.Background(), testUpgrades, 1, map[string]interface{}{"name": "web"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"name": "web", "upgraded": true}, upgraded)
+
+	upgraded, err = UpgradeRawState(context.Background(), testUpgrades, 2, map[string]interface{}{"name": "web"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"name": "web"}, upgraded)
+
+	_, err = UpgradeRawState(context.Background(), testUpgrades, 3, map[string]interface{}{})
+	assert.ErrorContains(t, err, "unsupported state version 3")
+
+	failing := []StateUpgradeFunc{func(context.Context, map[string]interface{}) (map[string]interface{}, error) {
+		return nil, errors.New("invalid label")
+	}}
+	_, err = UpgradeRawState(context.Background(), failing, 0, map[string]interface{}{})
+	assert.ErrorContains(t, err, "failed to upgrade the state from version 0: invalid label")
+}
+
+func TestSDKStateUpgrader(t *testing.T) {
+	prior := &schema.Resource{Schema: map[string]*schema.Schema{
+		"label": {Type: schema.TypeString, Optional: true},
+	}}
+	upgrader := SDKStateUpgrader(0, prior, testUpgrades[0])
+	assert.Equal(t, 0, upgrader.Version)
+	assert.True(t, upgrader.Type.HasAttribute("label"))
+
+	upgraded, err := upgrader.Upgrade(context.Background(), map[string]interface{}{"id": "id", "label": "web"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"id": "id", "name": "web"}, upgraded)
+}
+
+func TestFrameworkStateUpgraders(t *testing.T) {
+	upgraders := FrameworkStateUpgraders(testUpgrades...)
+	assert.Len(t, upgraders, 2)
+
+	upgrade := func(version int64, state string) (map[string]interface{}, *resource.UpgradeStateResponse) {
+		response := &resource.UpgradeStateResponse{}
+		request := resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: []byte(state)}}
+		upgraders[version].StateUpgrader(context.Background(), request, response)
+		if response.DynamicValue == nil {
+			return nil, response
+		}
+		var upgraded map[string]interface{}
+		assert.NoError(t, json.Unmarshal(response.DynamicValue.JSON, &upgraded))
+		return upgraded, response
+	}
+
+	upgraded, response := upgrade(0, `{"id": "id", "label": "web", "size": 9007199254740993}`)
+	assert.False(t, response.Diagnostics.HasError())
+	assert.Equal(t, "web", upgraded["name"])
+	assert.Equal(t, true, upgraded["upgraded"])
+	assert.NotContains(t, upgraded, "label")
+	assert.Contains(t, string(response.DynamicValue.JSON), `"size":9007199254740993`)
+
+	upgraded, response = upgrade(1, `{"id": "id", "name": "web"}`)
+	assert.False(t, response.Diagnostics.HasError())
+	assert.Equal(t, map[string]interface{}{"id": "id", "name": "web", "upgraded": true}, upgraded)
+
+	_, response = upgrade(0, `{"id": `)
+	assert.True(t, response.Diagnostics.HasError())
+}
@@ -0,0 +1,165 @@
+package commons
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// TagConfig is the provider-wide tagging of the taggable resources
+type TagConfig struct {
+	// DefaultTagIds are merged into the tag IDs of every taggable resource on create and update
+	DefaultTagIds []string
+	// IgnoreTagIds are applied outside of Terraform. They are kept on update and left out of the state.
+	IgnoreTagIds []string
+}
+
+// MergeTagIds returns the tag IDs to send on create or update of a resource: the tag IDs of its configuration, the
+// default tag IDs and the ignored tag IDs among the ones currently applied to it, without duplicates
+func (c TagConfig) MergeTagIds(tagIds []string, currentTagIds []string) []string {
+	merged := make([]string, 0, len(tagIds)+len(c.DefaultTagIds))
+	add := func(tagId string) {
+		if tagId != "" && !slices.Contains(merged, tagId) {
+			merged = append(merged, tagId)
+		}
+	}
+
+	for _, tagId := range tagIds {
+		add(tagId)
+	}
+	for _, tagId := range c.DefaultTagIds {
+		add(tagId)
+	}
+	for _, tagId := range currentTagIds {
+		if slices.Contains(c.IgnoreTagIds, tagId) {
+			add(tagId)
+		}
+	}
+	return merged
+}
+
+// MergeAppliedTagIds is MergeTagIds for an existing resource. The tag IDs currently applied to it are only fetched
+// when some tag IDs are ignored.
+func (c TagConfig) MergeAppliedTagIds(ctx context.Context, tagIds []string, applied func(ctx context.Context) ([]string, error)) ([]string, error) {
+	if len(c.IgnoreTagIds) == 0 {
+		return c.MergeTagIds(tagIds, nil), nil
+	}
+	currentTagIds, err := applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.MergeTagIds(tagIds, currentTagIds), nil
+}
+
+// FilterTagIds returns the tag IDs of a resource read from the API to store in its state. The default and ignored tag
+// IDs are left out unless configured on the resource, so that they don't show as drift.
+func (c TagConfig) FilterTagIds(tagIds []string, configuredTagIds []string) []string {
+	filtered := make([]string, 0, len(tagIds))
+	for _, tagId := range tagIds {
+		managedElsewhere := slices.Contains(c.DefaultTagIds, tagId) || slices.Contains(c.IgnoreTagIds, tagId)
+		if !managedElsewhere || slices.Contains(configuredTagIds, tagId) {
+			filtered = append(filtered, tagId)
+		}
+	}
+	return filtered
+}
+
+// AllTagIds returns the tag IDs of a resource read from the API to store in its tag_ids_all. The ignored tag IDs are
+// left out unless configured on the resource, while the default ones are kept so that a missing one shows as drift.
+func (c TagConfig) AllTagIds(tagIds []string, configuredTagIds []string) []string {
+	all := make([]string, 0, len(tagIds))
+	for _, tagId := range tagIds {
+		if !slices.Contains(c.IgnoreTagIds, tagId) || slices.Contains(configuredTagIds, tagId) {
+			all = append(all, tagId)
+		}
+	}
+	return all
+}
+
+// TagIdsAllDescription is the description of the tag_ids_all attribute of the taggable resources
+const TagIdsAllDescription = "The tag IDs applied to the resource: its `tag_ids` along with the provider `default_tag_ids`. A default tag ID missing from the resource, such as one added to the provider, is applied by the next apply."
+
+// TagIdsAllSchema returns the tag_ids_all attribute of an SDKv2 taggable resource, planned by CustomizeDiffTagIdsAll
+func TagIdsAllSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: TagIdsAllDescription,
+	}
+}
+
+// CustomizeDiffTagIdsAll plans the tag_ids_all of an SDKv2 taggable resource, its tag_ids merged with the provider
+// default_tag_ids, so that the resource is updated whenever its applied tags differ
+func CustomizeDiffTagIdsAll(_ context.Context, d *schema.ResourceDiff, m interface{}) error {
+	// The provider is not configured yet when its own configuration is unknown
+	client, ok := m.(*Client)
+	if !ok || client == nil {
+		return nil
+	}
+	if !d.NewValueKnown("tag_ids") {
+		return d.SetNewComputed("tag_ids_all")
+	}
+
+	planned := client.Tags.MergeTagIds(ExpandTagIds(d.Get("tag_ids").(*schema.Set)), nil)
+	current, _ := d.GetChange("tag_ids_all")
+	if d.Id() != "" && SameTagIds(planned, ExpandTagIds(current.(*schema.Set))) {
+		return nil
+	}
+	return d.SetNew("tag_ids_all", planned)
+}
+
+// PlanTagIdsAll is CustomizeDiffTagIdsAll for a framework resource, planning its tag_ids_all. The value in state is
+// kept while it holds the tag IDs to apply, otherwise it is left unknown until read after the apply, the framework
+// failing on a read value other than the planned one. Nothing is planned until the provider is configured.
+func (c *Client) PlanTagIdsAll(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if c == nil || request.Plan.Raw.IsNull() || request.State.Raw.IsNull() {
+		return
+	}
+
+	var tagIds, current types.Set
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("tag_ids"), &tagIds)...)
+	response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root("tag_ids_all"), &current)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !tagIds.IsUnknown() && !current.IsNull() {
+		var configured, currentTagIds []string
+		response.Diagnostics.Append(tagIds.ElementsAs(ctx, &configured, false)...)
+		response.Diagnostics.Append(current.ElementsAs(ctx, &currentTagIds, false)...)
+		if SameTagIds(c.Tags.MergeTagIds(configured, nil), currentTagIds) {
+			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("tag_ids_all"), current)...)
+			return
+		}
+	}
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("tag_ids_all"), types.SetUnknown(types.StringType))...)
+}
+
+// SameTagIds reports whether two lists hold the same tag IDs, in any order
+func SameTagIds(tagIds []string, otherTagIds []string) bool {
+	for _, tagId := range tagIds {
+		if !slices.Contains(otherTagIds, tagId) {
+			return false
+		}
+	}
+	for _, tagId := range otherTagIds {
+		if !slices.Contains(tagIds, tagId) {
+			return false
+		}
+	}
+	return true
+}
+
+// ExpandTagIds returns the tag IDs of a tag_ids set attribute
+func ExpandTagIds(tagSet *schema.Set) []string {
+	tagIds := make([]string, 0, tagSet.Len())
+	for _, tag := range tagSet.List() {
+		tagIds = append(tagIds, tag.(string))
+	}
+	return tagIds
+}
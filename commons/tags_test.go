@@ -0,0 +1,126 @@
+package commons
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	frameworkresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	frameworkschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeTagIds_AddsDefaultTagIds(t *testing.T) {
+	tags := TagConfig{DefaultTagIds: []string{"cost-center", "owner"}}
+
+	assert.Equal(t, []string{"app", "owner", "cost-center"}, tags.MergeTagIds([]string{"app", "owner"}, nil))
+	assert.Equal(t, []string{"cost-center", "owner"}, tags.MergeTagIds(nil, nil))
+}
+
+func TestMergeTagIds_KeepsAppliedIgnoredTagIds(t *testing.T) {
+	tags := TagConfig{IgnoreTagIds: []string{"backup", "audit"}}
+
+	merged := tags.MergeTagIds([]string{"app"}, []string{"old", "backup"})
+	assert.Equal(t, []string{"app", "backup"}, merged)
+}
+
+func TestMergeTagIds_EmptyIsNotNil(t *testing.T) {
+	merged := TagConfig{}.MergeTagIds(nil, nil)
+	assert.NotNil(t, merged)
+	assert.Empty(t, merged)
+}
+
+func TestMergeAppliedTagIds(t *testing.T) {
+	calls := 0
+	applied := func(context.Context) ([]string, error) {
+		calls++
+		return []string{"backup", "old"}, nil
+	}
+
+	merged, err := TagConfig{}.MergeAppliedTagIds(context.Background(), []string{"app"}, applied)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"app"}, merged)
+	assert.Equal(t, 0, calls)
+
+	tags := TagConfig{IgnoreTagIds: []string{"backup"}}
+	merged, err = tags.MergeAppliedTagIds(context.Background(), []string{"app"}, applied)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"app", "backup"}, merged)
+	assert.Equal(t, 1, calls)
+
+	_, err = tags.MergeAppliedTagIds(context.Background(), nil, func(context.Context) ([]string, error) {
+		return nil, errors.New("boom")
+	})
+	assert.EqualError(t, err, "boom")
+}
+
+func TestFilterTagIds(t *testing.T) {
+	tags := TagConfig{DefaultTagIds: []string{"owner"}, IgnoreTagIds: []string{"backup"}}
+
+	assert.Equal(t, []string{"app"}, tags.FilterTagIds([]string{"app", "owner", "backup"}, nil))
+	assert.Equal(t, []string{"app", "owner"}, tags.FilterTagIds([]string{"app", "owner", "backup"}, []string{"app", "owner"}))
+}
+
+func TestAllTagIds(t *testing.T) {
+	tags := TagConfig{DefaultTagIds: []string{"owner"}, IgnoreTagIds: []string{"backup"}}
+
+	assert.Equal(t, []string{"app", "owner"}, tags.AllTagIds([]string{"app", "owner", "backup"}, nil))
+	assert.Equal(t, []string{"app", "backup"}, tags.AllTagIds([]string{"app", "backup"}, []string{"app", "backup"}))
+}
+
+func TestSameTagIds(t *testing.T) {
+	assert.True(t, SameTagIds([]string{"app", "owner"}, []string{"owner", "app"}))
+	assert.True(t, SameTagIds(nil, []string{}))
+	assert.False(t, SameTagIds([]string{"app"}, []string{"app", "owner"}))
+	assert.False(t, SameTagIds([]string{"app", "owner"}, []string{"app"}))
+}
+
+// testTagPlan plans the tag_ids_all of a framework resource having the given tag_ids, and tag_ids_all in state
+func testTagPlan(t *testing.T, client *Client, tagIds tftypes.Value, currentTagIdsAll tftypes.Value) tftypes.Value {
+	resourceSchema := frameworkschema.Schema{Attributes: map[string]frameworkschema.Attribute{
+		"tag_ids":     frameworkschema.SetAttribute{ElementType: types.StringType, Optional: true, Computed: true},
+		"tag_ids_all": frameworkschema.SetAttribute{ElementType: types.StringType, Computed: true},
+	}}
+	objectType := resourceSchema.Type().TerraformType(context.Background())
+	plan := tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"tag_ids":     tagIds,
+		"tag_ids_all": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, tftypes.UnknownValue),
+	})
+	state := tftypes.NewValue(objectType, map[string]tftypes.Value{"tag_ids": tagIds, "tag_ids_all": currentTagIdsAll})
+
+	response := &frameworkresource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: resourceSchema, Raw: plan}}
+	client.PlanTagIdsAll(context.Background(), frameworkresource.ModifyPlanRequest{
+		Plan:  tfsdk.Plan{Schema: resourceSchema, Raw: plan},
+		State: tfsdk.State{Schema: resourceSchema, Raw: state},
+	}, response)
+	assert.False(t, response.Diagnostics.HasError(), "%v", response.Diagnostics)
+
+	planned := map[string]tftypes.Value{}
+	assert.NoError(t, response.Plan.Raw.As(&planned))
+	return planned["tag_ids_all"]
+}
+
+func TestPlanTagIdsAll(t *testing.T) {
+	client := testVpcClient(t, "")
+	client.Tags = TagConfig{DefaultTagIds: []string{"owner"}}
+	tagSet := func(tagIds ...string) tftypes.Value {
+		values := make([]tftypes.Value, 0, len(tagIds))
+		for _, tagId := range tagIds {
+			values = append(values, tftypes.NewValue(tftypes.String, tagId))
+		}
+		return tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, values)
+	}
+
+	assert.True(t, testTagPlan(t, client, tagSet("app"), tagSet("owner", "app")).Equal(tagSet("app", "owner")))
+	assert.False(t, testTagPlan(t, client, tagSet("app"), tagSet("app")).IsKnown())
+	assert.False(t, testTagPlan(t, client, tagSet("app", "web"), tagSet("app", "owner")).IsKnown())
+}
+
+func TestExpandTagIds(t *testing.T) {
+	set := schema.NewSet(schema.HashString, []interface{}{"a", "b"})
+	assert.ElementsMatch(t, []string{"a", "b"}, ExpandTagIds(set))
+}
@@ -0,0 +1,312 @@
+package test_helper
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	common "terraform-provider-fptcloud/commons"
+)
+
+const (
+	FakeToken         = "fake-token"
+	FakeTenantName    = "fake-tenant"
+	FakeTenantID      = "fake-tenant-id"
+	FakeRegion        = "VN/HAN"
+	FakeVpcID         = "fake-vpc-id"
+	FakeVpcName       = "fake-vpc"
+	FakeS3ServiceID   = "fake-s3-service-id"
+	FakeS3ServiceName = "HN-02"
+	FakeFlavorName    = "1C1G"
+)
+
+// FakeAPI is an in-memory FPT Cloud API for offline tests. It serves the ApiPath routes of the VPC,
+// subnet, instance, storage, security group, floating IP, LBv2 and object storage services, keeps the
+// created resources in memory and walks them through their transitional statuses, such as
+// CREATING to POWERED_ON, the way the real API does.
+type FakeAPI struct {
+	*httptest.Server
+
+	// PendingReads is the number of reads a resource keeps its transitional status before settling
+	PendingReads int
+
+	mu      sync.Mutex
+	nextID  int
+	records map[string]*fakeRecord
+	routes  []fakeRoute
+}
+
+// fakeRecord is a resource stored by the fake API, rendered as is in the responses
+type fakeRecord struct {
+	kind         string
+	fields       map[string]interface{}
+	statusField  string
+	targetStatus string
+	pendingReads int
+	deleting     bool
+}
+
+// fakeRequest is the request given to the route handlers, with the path parameters and the decoded body
+type fakeRequest struct {
+	*http.Request
+	params map[string]string
+	body   map[string]interface{}
+}
+
+type fakeHandler func(request *fakeRequest) (int, interface{})
+
+type fakeRoute struct {
+	method   string
+	segments []string
+	handler  fakeHandler
+}
+
+// NewFakeAPI starts a fake FPT Cloud API with the fake tenant, VPC and object storage service.
+// The caller must Close it once done.
+func NewFakeAPI() *FakeAPI {
+	f := &FakeAPI{
+		PendingReads: 1,
+		records:      map[string]*fakeRecord{},
+	}
+	f.registerRoutes()
+	f.Server = httptest.NewServer(http.HandlerFunc(f.serveHTTP))
+	return f
+}
+
+// NewClient returns an API client authenticated against the fake API
+func (f *FakeAPI) NewClient() (*common.Client, error) {
+	return common.NewClientWithURL(FakeToken, f.URL, FakeRegion, FakeTenantName, 1)
+}
+
+// ProviderConfig returns the provider block pointing the fptcloud provider at the fake API
+func (f *FakeAPI) ProviderConfig() string {
+	return fmt.Sprintf(`
+provider "fptcloud" {
+  token        = %q
+  tenant_name  = %q
+  region       = %q
+  api_endpoint = %q
+  max_retries  = 0
+}
+`, FakeToken, FakeTenantName, FakeRegion, f.URL)
+}
+
+// Count returns the number of resources of the given kind stored by the fake API, such as "instance"
+func (f *FakeAPI) Count(kind string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	count := 0
+	for _, record := range f.records {
+		if record.kind == kind {
+			count++
+		}
+	}
+	return count
+}
+
+func (f *FakeAPI) handle(method string, pattern string, handler fakeHandler) {
+	f.routes = append(f.routes, fakeRoute{
+		method:   method,
+		segments: strings.Split(strings.Trim(pattern, "/"), "/"),
+		handler:  handler,
+	})
+}
+
+func (f *FakeAPI) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Bearer "+FakeToken {
+		writeFakeResponse(w, http.StatusUnauthorized, fakeError("invalid token"))
+		return
+	}
+
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	for _, route := range f.routes {
+		params, ok := route.match(r.Method, segments)
+		if !ok {
+			continue
+		}
+
+		request := &fakeRequest{Request: r, params: params, body: map[string]interface{}{}}
+		if r.Body != nil && r.ContentLength != 0 {
+			if err := json.NewDecoder(r.Body).Decode(&request.body); err != nil && !errors.Is(err, io.EOF) {
+				writeFakeResponse(w, http.StatusBadRequest, fakeError("invalid request body: "+err.Error()))
+				return
+			}
+		}
+
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		status, body := route.handler(request)
+		writeFakeResponse(w, status, body)
+		return
+	}
+
+	writeFakeResponse(w, http.StatusNotFound, fakeError(fmt.Sprintf("no route for %s %s", r.Method, r.URL.Path)))
+}
+
+func (route fakeRoute) match(method string, segments []string) (map[string]string, bool) {
+	if route.method != method || len(route.segments) != len(segments) {
+		return nil, false
+	}
+
+	params := map[string]string{}
+	for i, segment := range route.segments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			params[strings.Trim(segment, "{}")] = segments[i]
+			continue
+		}
+		if segment != segments[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+func writeFakeResponse(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func fakeError(message string) map[string]interface{} {
+	return map[string]interface{}{"status": false, "message": message}
+}
+
+func fakeNotFound(kind string, id string) (int, interface{}) {
+	return http.StatusNotFound, fakeError(fmt.Sprintf("%s %s not found", kind, id))
+}
+
+// create stores a new resource of the given kind. When pendingStatus is set, the resource reports
+// it for PendingReads reads before settling on targetStatus.
+func (f *FakeAPI) create(kind string, fields map[string]interface{}, statusField string, pendingStatus string, targetStatus string) *fakeRecord {
+	f.nextID++
+	id := fmt.Sprintf("%s-%04d", strings.ReplaceAll(kind, "_", "-"), f.nextID)
+
+	record := &fakeRecord{kind: kind, fields: fields, statusField: statusField}
+	record.fields["id"] = id
+	record.fields["vpc_id"] = FakeVpcID
+	record.fields["created_at"] = time.Now().UTC().Format(time.RFC3339)
+	if statusField != "" {
+		f.transition(record, pendingStatus, targetStatus)
+	}
+	f.records[kind+"/"+id] = record
+	return record
+}
+
+// transition moves a resource to the pending status, settling on the target status after PendingReads reads
+func (f *FakeAPI) transition(record *fakeRecord, pendingStatus string, targetStatus string) {
+	if pendingStatus == "" || f.PendingReads == 0 {
+		record.fields[record.statusField] = targetStatus
+		record.targetStatus = ""
+		record.pendingReads = 0
+		return
+	}
+	record.fields[record.statusField] = pendingStatus
+	record.targetStatus = targetStatus
+	record.pendingReads = f.PendingReads
+}
+
+// remove deletes a resource, right away or after it reported the pending status for PendingReads reads
+func (f *FakeAPI) remove(record *fakeRecord, pendingStatus string) {
+	if pendingStatus == "" || f.PendingReads == 0 {
+		delete(f.records, record.kind+"/"+record.fields["id"].(string))
+		return
+	}
+	record.fields[record.statusField] = pendingStatus
+	record.targetStatus = ""
+	record.pendingReads = f.PendingReads
+	record.deleting = true
+}
+
+// lookup returns a resource without reading it, so its status does not progress
+func (f *FakeAPI) lookup(kind string, id string) *fakeRecord {
+	return f.records[kind+"/"+id]
+}
+
+// read returns a snapshot of a resource and progresses its status, or nil once it is deleted
+func (f *FakeAPI) read(record *fakeRecord) map[string]interface{} {
+	if record == nil {
+		return nil
+	}
+
+	if record.pendingReads > 0 {
+		record.pendingReads--
+	} else if record.deleting {
+		delete(f.records, record.kind+"/"+record.fields["id"].(string))
+		return nil
+	} else if record.targetStatus != "" {
+		record.fields[record.statusField] = record.targetStatus
+		record.targetStatus = ""
+	}
+
+	snapshot := make(map[string]interface{}, len(record.fields))
+	for key, value := range record.fields {
+		snapshot[key] = value
+	}
+	return snapshot
+}
+
+// find returns the first resource of the given kind matching the id and name query parameters
+func (f *FakeAPI) find(kind string, request *fakeRequest, nameField string) *fakeRecord {
+	id := request.URL.Query().Get("id")
+	name := request.URL.Query().Get(nameField)
+	for _, record := range f.list(kind) {
+		if id != "" && record.fields["id"] != id {
+			continue
+		}
+		if name != "" && record.fields[nameField] != name {
+			continue
+		}
+		if id != "" || name != "" {
+			return record
+		}
+	}
+	return nil
+}
+
+// list returns the resources of the given kind in creation order
+func (f *FakeAPI) list(kind string) []*fakeRecord {
+	records := make([]*fakeRecord, 0)
+	for _, record := range f.records {
+		if record.kind == kind {
+			records = append(records, record)
+		}
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].fields["id"].(string) < records[j].fields["id"].(string)
+	})
+	return records
+}
+
+// readAll reads every resource of the given kind, leaving out the ones deleted meanwhile
+func (f *FakeAPI) readAll(kind string) []map[string]interface{} {
+	items := make([]map[string]interface{}, 0)
+	for _, record := range f.list(kind) {
+		if item := f.read(record); item != nil {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// copyFields copies the given body fields into the resource
+func copyFields(fields map[string]interface{}, body map[string]interface{}, keys ...string) map[string]interface{} {
+	for _, key := range keys {
+		if value, ok := body[key]; ok {
+			fields[key] = value
+		}
+	}
+	return fields
+}
+
+func stringField(body map[string]interface{}, key string) string {
+	value, _ := body[key].(string)
+	return value
+}
@@ -0,0 +1,499 @@
+package test_helper
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	fakeVmwarePrefix = "/v1/vmware/vpc/{vpc}"
+	fakeLBv2Prefix   = "/v2/vmware/vpc/{vpc}/load_balancer_v2"
+	fakeS3Prefix     = "/v1/vmware/vpc/{vpc}/s3"
+)
+
+func (f *FakeAPI) registerRoutes() {
+	f.registerTenantRoutes()
+	f.registerStorageRoutes()
+	f.registerInstanceRoutes()
+	f.registerSecurityGroupRoutes()
+	f.registerSubnetRoutes()
+	f.registerFloatingIpRoutes()
+	f.registerLoadBalancerRoutes()
+	f.registerObjectStorageRoutes()
+}
+
+func fakeOK(data interface{}) (int, interface{}) {
+	return http.StatusOK, map[string]interface{}{"status": true, "message": "", "data": data}
+}
+
+func fakeList(items []map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{"data": items, "total": len(items)}
+}
+
+func (f *FakeAPI) registerTenantRoutes() {
+	f.handle(http.MethodGet, "/v2/tenant/{tenant}", func(request *fakeRequest) (int, interface{}) {
+		if request.params["tenant"] != FakeTenantName {
+			return fakeNotFound("tenant", request.params["tenant"])
+		}
+		return fakeOK(map[string]interface{}{"id": FakeTenantID, "name": FakeTenantName})
+	})
+	f.handle(http.MethodGet, "/v1/vmware/org/{tenant}/list/regions", func(request *fakeRequest) (int, interface{}) {
+		if request.params["tenant"] != FakeTenantID {
+			return fakeNotFound("tenant", request.params["tenant"])
+		}
+		return fakeOK([]map[string]interface{}{{"id": "fake-region-id", "abbreviation_name": FakeRegion}})
+	})
+	f.handle(http.MethodGet, "/v2/org/{tenant}/vpc", func(request *fakeRequest) (int, interface{}) {
+		id := request.URL.Query().Get("id")
+		name := request.URL.Query().Get("name")
+		if request.params["tenant"] != FakeTenantID || (id != "" && id != FakeVpcID) || (name != "" && name != FakeVpcName) {
+			return fakeNotFound("vpc", id+name)
+		}
+		return fakeOK(map[string]interface{}{"id": FakeVpcID, "name": FakeVpcName, "status": "ACTIVE"})
+	})
+}
+
+func (f *FakeAPI) registerStorageRoutes() {
+	const kind = "storage"
+	f.handle(http.MethodGet, "/v2/vpc/{vpc}/storage", func(request *fakeRequest) (int, interface{}) {
+		storage := f.read(f.find(kind, request, "name"))
+		if storage == nil {
+			return fakeNotFound(kind, request.URL.Query().Get("id"))
+		}
+		return http.StatusOK, storage
+	})
+	f.handle(http.MethodPost, "/v2/vpc/{vpc}/storage", func(request *fakeRequest) (int, interface{}) {
+		fields := copyFields(map[string]interface{}{"instance_id": ""}, request.body,
+			"name", "type", "size_gb", "storage_policy_id", "instance_id", "tag_ids")
+		fields["storage_policy"] = fields["storage_policy_id"]
+		storage := f.create(kind, fields, "status", "PENDING", "ENABLED")
+		return http.StatusOK, map[string]interface{}{"storage_id": storage.fields["id"]}
+	})
+	f.handle(http.MethodPut, "/v2/vpc/{vpc}/storage/{id}", func(request *fakeRequest) (int, interface{}) {
+		storage := f.lookup(kind, request.params["id"])
+		if storage == nil {
+			return fakeNotFound(kind, request.params["id"])
+		}
+		copyFields(storage.fields, request.body, "name", "size_gb", "storage_policy_id")
+		storage.fields["storage_policy"] = storage.fields["storage_policy_id"]
+		f.transition(storage, "UPDATING", "ENABLED")
+		return fakeOK(nil)
+	})
+	f.handle(http.MethodPut, "/v2/vpc/{vpc}/storage/{id}/update-attached", func(request *fakeRequest) (int, interface{}) {
+		storage := f.lookup(kind, request.params["id"])
+		if storage == nil {
+			return fakeNotFound(kind, request.params["id"])
+		}
+		storage.fields["instance_id"] = stringField(request.body, "instance_id")
+		return fakeOK(nil)
+	})
+	f.handle(http.MethodPut, "/v2/vpc/{vpc}/storage/{id}/tags", f.updateTags(kind))
+	f.handle(http.MethodDelete, "/v2/vpc/{vpc}/storage/{id}", func(request *fakeRequest) (int, interface{}) {
+		storage := f.lookup(kind, request.params["id"])
+		if storage == nil {
+			return fakeNotFound(kind, request.params["id"])
+		}
+		f.remove(storage, "")
+		return fakeOK(nil)
+	})
+}
+
+func (f *FakeAPI) registerInstanceRoutes() {
+	const kind = "instance"
+	f.handle(http.MethodGet, "/v2/vpc/{vpc}/instance", func(request *fakeRequest) (int, interface{}) {
+		instance := f.read(f.find(kind, request, "name"))
+		if instance == nil {
+			return fakeNotFound(kind, request.URL.Query().Get("id"))
+		}
+		return http.StatusOK, map[string]interface{}{"data": instance}
+	})
+	f.handle(http.MethodPost, "/v2/vpc/{vpc}/instance", func(request *fakeRequest) (int, interface{}) {
+		fields := copyFields(map[string]interface{}{}, request.body,
+			"name", "flavor_name", "subnet_id", "storage_size_gb", "storage_policy_id",
+			"security_group_ids", "private_ip", "public_ip", "tag_ids")
+		fields["guest_os"] = stringField(request.body, "image_name")
+		fields["storage_policy"] = fields["storage_policy_id"]
+		// An instance created without a flavor or IPs gets the default flavor and allocated ones
+		if stringField(fields, "flavor_name") == "" {
+			fields["flavor_name"] = FakeFlavorName
+		}
+		fields["flavor_id"] = fakeFlavorID(stringField(fields, "flavor_name"))
+		if stringField(fields, "private_ip") == "" {
+			fields["private_ip"] = fmt.Sprintf("10.0.0.%d", f.nextID%250+2)
+		}
+		if stringField(fields, "public_ip") == "" {
+			fields["public_ip"] = fmt.Sprintf("203.0.113.%d", f.nextID%250+2)
+		}
+		instance := f.create(kind, fields, "status", "CREATING", "POWERED_ON")
+		return http.StatusOK, map[string]interface{}{"instance_id": instance.fields["id"]}
+	})
+	f.handle(http.MethodPut, "/v2/vpc/{vpc}/instance/{id}/rename", func(request *fakeRequest) (int, interface{}) {
+		instance := f.lookup(kind, request.params["id"])
+		if instance == nil {
+			return fakeNotFound(kind, request.params["id"])
+		}
+		instance.fields["name"] = stringField(request.body, "new_name")
+		return fakeOK(nil)
+	})
+	f.handle(http.MethodPut, "/v2/vpc/{vpc}/instance/{id}/change-status", func(request *fakeRequest) (int, interface{}) {
+		instance := f.lookup(kind, request.params["id"])
+		if instance == nil {
+			return fakeNotFound(kind, request.params["id"])
+		}
+		f.transition(instance, "", stringField(request.body, "status"))
+		return fakeOK(nil)
+	})
+	f.handle(http.MethodPost, fakeVmwarePrefix+"/compute/instance/{id}/reconfigure-vm", func(request *fakeRequest) (int, interface{}) {
+		instance := f.lookup(kind, request.params["id"])
+		if instance == nil {
+			return fakeNotFound(kind, request.params["id"])
+		}
+		flavorID := stringField(request.body, "hw_flavor")
+		instance.fields["flavor_id"] = flavorID
+		instance.fields["flavor_name"] = strings.TrimPrefix(flavorID, "flavor-")
+		f.transition(instance, "VERIFY_RESIZE", stringField(instance.fields, "status"))
+		return fakeOK(nil)
+	})
+	f.handle(http.MethodPut, "/v2/vpc/{vpc}/instance/{id}/tags", f.updateTags(kind))
+	f.handle(http.MethodDelete, "/v2/vpc/{vpc}/instance/{id}", func(request *fakeRequest) (int, interface{}) {
+		instance := f.lookup(kind, request.params["id"])
+		if instance == nil {
+			return fakeNotFound(kind, request.params["id"])
+		}
+		f.remove(instance, "DELETING")
+		return fakeOK(nil)
+	})
+	f.handle(http.MethodPost, "/v2/vpc/{vpc}/flavor/find-by-name", func(request *fakeRequest) (int, interface{}) {
+		name := stringField(request.body, "flavor_name")
+		return http.StatusOK, map[string]interface{}{"id": fakeFlavorID(name), "name": name}
+	})
+}
+
+func fakeFlavorID(name string) string {
+	return "flavor-" + name
+}
+
+func (f *FakeAPI) registerSecurityGroupRoutes() {
+	const kind = "security_group"
+	f.handle(http.MethodGet, "/v2/vpc/{vpc}/security-group", func(request *fakeRequest) (int, interface{}) {
+		securityGroup := f.read(f.find(kind, request, "name"))
+		if securityGroup == nil {
+			return fakeNotFound(kind, request.URL.Query().Get("id"))
+		}
+		return http.StatusOK, map[string]interface{}{"data": securityGroup}
+	})
+	f.handle(http.MethodPost, "/v2/vpc/{vpc}/security-group", func(request *fakeRequest) (int, interface{}) {
+		fields := copyFields(map[string]interface{}{"edge_gateway_id": "fake-edge-gateway-id", "rules": []interface{}{}}, request.body,
+			"name", "apply_to", "tag_ids")
+		fields["firewall_type"] = stringField(request.body, "type")
+		securityGroup := f.create(kind, fields, "status", "PENDING", "REALIZED")
+		return http.StatusOK, map[string]interface{}{"security_group_id": securityGroup.fields["id"]}
+	})
+	f.handle(http.MethodPut, "/v2/vpc/{vpc}/security-group/{id}/rename", func(request *fakeRequest) (int, interface{}) {
+		securityGroup := f.lookup(kind, request.params["id"])
+		if securityGroup == nil {
+			return fakeNotFound(kind, request.params["id"])
+		}
+		securityGroup.fields["name"] = stringField(request.body, "new_name")
+		return fakeOK(nil)
+	})
+	f.handle(http.MethodPut, "/v2/vpc/{vpc}/security-group/{id}/apply-to", func(request *fakeRequest) (int, interface{}) {
+		securityGroup := f.lookup(kind, request.params["id"])
+		if securityGroup == nil {
+			return fakeNotFound(kind, request.params["id"])
+		}
+		copyFields(securityGroup.fields, request.body, "apply_to")
+		f.transition(securityGroup, "UPDATING", "REALIZED")
+		return fakeOK(nil)
+	})
+	f.handle(http.MethodPut, "/v2/vpc/{vpc}/security-group/{id}/tags", f.updateTags(kind))
+	f.handle(http.MethodDelete, "/v2/vpc/{vpc}/security-group/{id}", func(request *fakeRequest) (int, interface{}) {
+		securityGroup := f.lookup(kind, request.params["id"])
+		if securityGroup == nil {
+			return fakeNotFound(kind, request.params["id"])
+		}
+		f.remove(securityGroup, "DELETING")
+		return fakeOK(nil)
+	})
+
+	const ruleKind = kind + "_rule"
+	f.handle(http.MethodGet, "/v2/vpc/{vpc}/security-group-rule/{id}", func(request *fakeRequest) (int, interface{}) {
+		rule := f.read(f.lookup(ruleKind, request.params["id"]))
+		if rule == nil {
+			return fakeNotFound(ruleKind, request.params["id"])
+		}
+		return http.StatusOK, map[string]interface{}{"data": rule}
+	})
+	f.handle(http.MethodPost, "/v2/vpc/{vpc}/security-group-rule", func(request *fakeRequest) (int, interface{}) {
+		securityGroupID := stringField(request.body, "security_group_id")
+		if f.lookup(kind, securityGroupID) == nil {
+			return fakeNotFound(kind, securityGroupID)
+		}
+		fields := copyFields(map[string]interface{}{"ip_type": "IPV4", "description": ""}, request.body,
+			"direction", "action", "protocol", "port_range", "sources", "description", "security_group_id")
+		rule := f.create(ruleKind, fields, "status", "PENDING", "ACTIVE")
+		return http.StatusOK, map[string]interface{}{"security_group_rule_id": rule.fields["id"]}
+	})
+	f.handle(http.MethodDelete, "/v2/vpc/{vpc}/security-group-rule/{id}", func(request *fakeRequest) (int, interface{}) {
+		rule := f.lookup(ruleKind, request.params["id"])
+		if rule == nil {
+			return fakeNotFound(ruleKind, request.params["id"])
+		}
+		f.remove(rule, "DELETING")
+		return fakeOK(nil)
+	})
+}
+
+func (f *FakeAPI) registerSubnetRoutes() {
+	const kind = "subnet"
+	f.handle(http.MethodGet, "/v2/vpc/{vpc}/networks", func(request *fakeRequest) (int, interface{}) {
+		return fakeOK(fakeList(f.readAll(kind)))
+	})
+	f.handle(http.MethodPost, "/v2/vpc/{vpc}/networks", func(request *fakeRequest) (int, interface{}) {
+		name := stringField(request.body, "name")
+		for _, subnet := range f.list(kind) {
+			if subnet.fields["name"] == name {
+				return http.StatusConflict, fakeError(fmt.Sprintf("subnet %s already exists", name))
+			}
+		}
+		fields := copyFields(map[string]interface{}{}, request.body, "name", "primary_dns_ip", "secondary_dns_ip", "tag_ids")
+		fields["network_name"] = name
+		fields["gateway"] = stringField(request.body, "gateway_ip")
+		fields["edge_gateway"] = map[string]interface{}{
+			"id":              "fake-edge-gateway-id",
+			"name":            "fake-edge-gateway",
+			"edge_gateway_id": "fake-edge-gateway-id",
+		}
+		subnet := f.create(kind, fields, "", "", "")
+		subnet.fields["network_id"] = subnet.fields["id"]
+		return fakeOK(subnet.fields)
+	})
+	f.handle(http.MethodGet, "/v2/vpc/{vpc}/network-by-name", func(request *fakeRequest) (int, interface{}) {
+		subnet := f.read(f.find(kind, request, "network_name"))
+		if subnet == nil {
+			return fakeNotFound(kind, request.URL.Query().Get("network_name"))
+		}
+		return fakeOK(subnet)
+	})
+	f.handle(http.MethodGet, "/v2/vpc/{vpc}/network/{id}", func(request *fakeRequest) (int, interface{}) {
+		subnet := f.read(f.lookup(kind, request.params["id"]))
+		if subnet == nil {
+			return fakeNotFound(kind, request.params["id"])
+		}
+		return fakeOK(subnet)
+	})
+	f.handle(http.MethodPut, "/v2/vpc/{vpc}/network/{id}/tags", f.updateTags(kind))
+	f.handle(http.MethodPut, fakeVmwarePrefix+"/network/{id}/edit-dns", func(request *fakeRequest) (int, interface{}) {
+		subnet := f.lookup(kind, request.params["id"])
+		if subnet == nil {
+			return fakeNotFound(kind, request.params["id"])
+		}
+		copyFields(subnet.fields, request.body, "primary_dns_ip", "secondary_dns_ip")
+		return fakeOK(nil)
+	})
+	f.handle(http.MethodDelete, "/v2/vpc/{vpc}/network/{id}", func(request *fakeRequest) (int, interface{}) {
+		subnet := f.lookup(kind, request.params["id"])
+		if subnet == nil {
+			return fakeNotFound(kind, request.params["id"])
+		}
+		f.remove(subnet, "")
+		return fakeOK(nil)
+	})
+}
+
+func (f *FakeAPI) registerFloatingIpRoutes() {
+	const kind = "floating_ip"
+	f.handle(http.MethodGet, "/v2/vpc/{vpc}/floating-ips", func(request *fakeRequest) (int, interface{}) {
+		return fakeOK(fakeList(f.readAll(kind)))
+	})
+	f.handle(http.MethodGet, "/v2/vpc/{vpc}/floating-ip-address", func(request *fakeRequest) (int, interface{}) {
+		floatingIp := f.read(f.find(kind, request, "ip_address"))
+		if floatingIp == nil {
+			return fakeNotFound(kind, request.URL.Query().Get("ip_address"))
+		}
+		return fakeOK(floatingIp)
+	})
+	f.handle(http.MethodPost, "/v2/vpc/{vpc}/floating-ip", func(request *fakeRequest) (int, interface{}) {
+		fields := copyFields(map[string]interface{}{"nat_type": "", "instance": map[string]interface{}{}}, request.body, "tag_ids")
+		fields["ip_address"] = fmt.Sprintf("203.0.113.%d", f.nextID%250+2)
+		floatingIp := f.create(kind, fields, "status", "", "IN_ACTIVE")
+		return fakeOK(floatingIp.fields)
+	})
+	f.handle(http.MethodGet, "/v2/vpc/{vpc}/floating-ip/{id}", func(request *fakeRequest) (int, interface{}) {
+		floatingIp := f.read(f.lookup(kind, request.params["id"]))
+		if floatingIp == nil {
+			return fakeNotFound(kind, request.params["id"])
+		}
+		return fakeOK(floatingIp)
+	})
+	f.handle(http.MethodPut, "/v2/vpc/{vpc}/floating-ip/{id}/tags", f.updateTags(kind))
+	f.handle(http.MethodPost, "/v2/vpc/{vpc}/floating-ip/{id}/release", func(request *fakeRequest) (int, interface{}) {
+		floatingIp := f.lookup(kind, request.params["id"])
+		if floatingIp == nil {
+			return fakeNotFound(kind, request.params["id"])
+		}
+		f.remove(floatingIp, "")
+		return fakeOK(nil)
+	})
+}
+
+func (f *FakeAPI) registerLoadBalancerRoutes() {
+	const kind = "load_balancer"
+	f.handle(http.MethodGet, fakeLBv2Prefix+"/list", func(request *fakeRequest) (int, interface{}) {
+		return http.StatusOK, fakeList(f.readAll(kind))
+	})
+	f.handle(http.MethodPost, fakeLBv2Prefix+"/create", func(request *fakeRequest) (int, interface{}) {
+		fields := copyFields(map[string]interface{}{"operating_status": "ONLINE", "tags": []string{}}, request.body,
+			"name", "description", "cidr")
+		size := stringField(request.body, "size")
+		fields["size"] = map[string]interface{}{"id": "size-" + size, "name": size}
+		fields["public_ip"] = map[string]interface{}{"id": "", "ip_address": stringField(request.body, "floating_ip")}
+		fields["network"] = map[string]interface{}{"id": stringField(request.body, "network_id"), "name": ""}
+		fields["edge_gateway"] = map[string]interface{}{"id": stringField(request.body, "egw_id"), "name": ""}
+		fields["private_ip"] = stringField(request.body, "vip_address")
+		loadBalancer := f.create(kind, fields, "provisioning_status", "PENDING_CREATE", "ACTIVE")
+		return http.StatusOK, map[string]interface{}{"data": loadBalancer.fields}
+	})
+	readLoadBalancer := func(request *fakeRequest) (int, interface{}) {
+		loadBalancer := f.read(f.lookup(kind, request.params["id"]))
+		if loadBalancer == nil {
+			return fakeNotFound(kind, request.params["id"])
+		}
+		return http.StatusOK, map[string]interface{}{"data": loadBalancer}
+	}
+	f.handle(http.MethodGet, fakeLBv2Prefix+"/{id}", readLoadBalancer)
+	f.handle(http.MethodGet, fakeLBv2Prefix+"/{id}/read", readLoadBalancer)
+	f.handle(http.MethodPut, fakeLBv2Prefix+"/{id}/update", func(request *fakeRequest) (int, interface{}) {
+		loadBalancer := f.lookup(kind, request.params["id"])
+		if loadBalancer == nil {
+			return fakeNotFound(kind, request.params["id"])
+		}
+		copyFields(loadBalancer.fields, request.body, "name", "description")
+		loadBalancer.fields["public_ip"] = map[string]interface{}{"id": "", "ip_address": stringField(request.body, "floating_ip")}
+		f.transition(loadBalancer, "PENDING_UPDATE", "ACTIVE")
+		return http.StatusOK, map[string]interface{}{"data": loadBalancer.fields}
+	})
+	f.handle(http.MethodPut, fakeLBv2Prefix+"/{id}/resize", func(request *fakeRequest) (int, interface{}) {
+		loadBalancer := f.lookup(kind, request.params["id"])
+		if loadBalancer == nil {
+			return fakeNotFound(kind, request.params["id"])
+		}
+		size := stringField(request.body, "new_size")
+		loadBalancer.fields["size"] = map[string]interface{}{"id": "size-" + size, "name": size}
+		f.transition(loadBalancer, "PENDING_UPDATE", "ACTIVE")
+		return http.StatusOK, map[string]interface{}{"data": loadBalancer.fields}
+	})
+	f.handle(http.MethodDelete, fakeLBv2Prefix+"/{id}/delete", func(request *fakeRequest) (int, interface{}) {
+		loadBalancer := f.lookup(kind, request.params["id"])
+		if loadBalancer == nil {
+			return fakeNotFound(kind, request.params["id"])
+		}
+		f.remove(loadBalancer, "PENDING_DELETE")
+		return http.StatusOK, map[string]interface{}{"data": loadBalancer.fields}
+	})
+}
+
+func (f *FakeAPI) registerObjectStorageRoutes() {
+	const bucketKind = "bucket"
+	const accessKeyKind = "access_key"
+	f.handle(http.MethodGet, fakeS3Prefix+"/check-service-enabled", func(request *fakeRequest) (int, interface{}) {
+		return http.StatusOK, map[string]interface{}{
+			"data": []map[string]interface{}{{
+				"s3_service_name": FakeS3ServiceName,
+				"s3_service_id":   FakeS3ServiceID,
+				"s3_platform":     "ceph",
+			}},
+			"total": 1,
+		}
+	})
+	f.handle(http.MethodGet, fakeS3Prefix+"/buckets", func(request *fakeRequest) (int, interface{}) {
+		buckets := make([]map[string]interface{}, 0)
+		for _, bucket := range f.readAll(bucketKind) {
+			buckets = append(buckets, map[string]interface{}{
+				"Name":          bucket["name"],
+				"CreationDate":  bucket["created_at"],
+				"isEmpty":       true,
+				"s3_service_id": FakeS3ServiceID,
+				"endpoint":      "https://s3.fake.fptcloud.local",
+			})
+		}
+		return http.StatusOK, map[string]interface{}{"buckets": buckets, "total": len(buckets)}
+	})
+	f.handle(http.MethodPost, fakeS3Prefix+"/{s3}/buckets/create", func(request *fakeRequest) (int, interface{}) {
+		name := stringField(request.body, "name")
+		if f.findBucket(name) != nil {
+			return http.StatusConflict, fakeError(fmt.Sprintf("bucket %s already exists", name))
+		}
+		f.create(bucketKind, copyFields(map[string]interface{}{}, request.body, "name", "versioning", "acl"), "", "", "")
+		return http.StatusOK, map[string]interface{}{"status": true}
+	})
+	f.handle(http.MethodDelete, fakeS3Prefix+"/{s3}/buckets/delete", func(request *fakeRequest) (int, interface{}) {
+		name := stringField(request.body, "name")
+		bucket := f.findBucket(name)
+		if bucket == nil {
+			return fakeNotFound(bucketKind, name)
+		}
+		f.remove(bucket, "")
+		return http.StatusOK, map[string]interface{}{"status": true}
+	})
+
+	f.handle(http.MethodGet, fakeS3Prefix+"/user/credentials", func(request *fakeRequest) (int, interface{}) {
+		credentials := make([]map[string]interface{}, 0)
+		for _, accessKey := range f.readAll(accessKeyKind) {
+			credentials = append(credentials, map[string]interface{}{
+				"accessKey":   accessKey["access_key"],
+				"active":      true,
+				"createdDate": accessKey["created_at"],
+			})
+		}
+		return http.StatusOK, map[string]interface{}{
+			"credentials": []map[string]interface{}{{"id": FakeTenantID, "credentials": credentials}},
+		}
+	})
+	f.handle(http.MethodPost, fakeS3Prefix+"/{s3}/user/credentials", func(request *fakeRequest) (int, interface{}) {
+		accessKey := f.create(accessKeyKind, map[string]interface{}{}, "", "", "")
+		accessKey.fields["access_key"] = fmt.Sprintf("FAKEACCESSKEY%04d", f.nextID)
+		return http.StatusOK, map[string]interface{}{
+			"status": true,
+			"credential": map[string]interface{}{
+				"accessKey":   accessKey.fields["access_key"],
+				"secretKey":   fmt.Sprintf("fake-secret-key-%d", time.Now().UnixNano()),
+				"active":      true,
+				"createdDate": accessKey.fields["created_at"],
+			},
+		}
+	})
+	f.handle(http.MethodDelete, fakeS3Prefix+"/{s3}/user/credentials/delete", func(request *fakeRequest) (int, interface{}) {
+		accessKeyID := stringField(request.body, "accessKey")
+		for _, accessKey := range f.list(accessKeyKind) {
+			if accessKey.fields["access_key"] == accessKeyID {
+				f.remove(accessKey, "")
+				return http.StatusOK, map[string]interface{}{"status": true}
+			}
+		}
+		return fakeNotFound(accessKeyKind, accessKeyID)
+	})
+}
+
+func (f *FakeAPI) findBucket(name string) *fakeRecord {
+	for _, bucket := range f.list("bucket") {
+		if bucket.fields["name"] == name {
+			return bucket
+		}
+	}
+	return nil
+}
+
+// updateTags handles the PUT <resource>/{id}/tags routes shared by most services
+func (f *FakeAPI) updateTags(kind string) fakeHandler {
+	return func(request *fakeRequest) (int, interface{}) {
+		record := f.lookup(kind, request.params["id"])
+		if record == nil {
+			return fakeNotFound(kind, request.params["id"])
+		}
+		copyFields(record.fields, request.body, "tag_ids")
+		return fakeOK(nil)
+	}
+}
@@ -0,0 +1,877 @@
+package test_helper_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	common "terraform-provider-fptcloud/commons"
+	"terraform-provider-fptcloud/commons/test-helper"
+	fptcloud_floating_ip "terraform-provider-fptcloud/fptcloud/floating-ip"
+	fptcloud_instance "terraform-provider-fptcloud/fptcloud/instance"
+	fptcloud_load_balancer_v2 "terraform-provider-fptcloud/fptcloud/load_balancer_v2"
+	fptcloud_object_storage "terraform-provider-fptcloud/fptcloud/object-storage"
+	fptcloud_security_group "terraform-provider-fptcloud/fptcloud/security-group"
+	fptcloud_security_group_rule "terraform-provider-fptcloud/fptcloud/security-group-rule"
+	fptcloud_storage "terraform-provider-fptcloud/fptcloud/storage"
+	fptcloud_subnet "terraform-provider-fptcloud/fptcloud/subnet"
+	fptcloud_vpc "terraform-provider-fptcloud/fptcloud/vpc"
+)
+
+func newFakeClient(t *testing.T) (*test_helper.FakeAPI, *common.Client) {
+	fake := test_helper.NewFakeAPI()
+	t.Cleanup(fake.Close)
+	client, err := fake.NewClient()
+	assert.NoError(t, err)
+	client.MaxRetries = 0
+	return fake, client
+}
+
+func TestFakeAPI_ResolvesTenantAndVpc(t *testing.T) {
+	_, client := newFakeClient(t)
+	service := fptcloud_vpc.NewService(client)
+
+	tenant, err := service.GetTenant(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, test_helper.FakeTenantID, tenant.Id)
+
+	vpc, err := service.FindVPC(context.Background(), tenant.Id, fptcloud_vpc.FindVPCParam{Name: test_helper.FakeVpcName})
+	assert.NoError(t, err)
+	assert.Equal(t, test_helper.FakeVpcID, vpc.Id)
+}
+
+func TestFakeAPI_RejectsInvalidToken(t *testing.T) {
+	fake, client := newFakeClient(t)
+	client.APIKey = "wrong-token"
+
+	_, err := fptcloud_storage.NewStorageService(client).FindStorage(context.Background(), fptcloud_storage.FindStorageDTO{ID: "storage-0001", VpcId: test_helper.FakeVpcID})
+	assert.ErrorIs(t, err, common.UnauthorizedError)
+	assert.Equal(t, 0, fake.Count("storage"))
+}
+
+func TestFakeAPI_WalksInstanceThroughItsStatuses(t *testing.T) {
+	fake, client := newFakeClient(t)
+	service := fptcloud_instance.NewInstanceService(client)
+	ctx := context.Background()
+
+	instanceId, err := service.Create(ctx, fptcloud_instance.CreateInstanceDTO{
+		VpcId:      test_helper.FakeVpcID,
+		Name:       "instance-test",
+		FlavorName: "2C2G",
+		ImageName:  "Ubuntu-22.04",
+		SubnetId:   "subnet-id",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, fake.Count("instance"))
+
+	findModel := fptcloud_instance.FindInstanceDTO{ID: instanceId, VpcId: test_helper.FakeVpcID}
+	instance, err := service.Find(ctx, findModel)
+	assert.NoError(t, err)
+	assert.Equal(t, "CREATING", instance.Status)
+	instance, err = service.Find(ctx, findModel)
+	assert.NoError(t, err)
+	assert.Equal(t, "POWERED_ON", instance.Status)
+	assert.Equal(t, "instance-test", instance.Name)
+	assert.Equal(t, "Ubuntu-22.04", instance.GuestOs)
+
+	_, err = service.Delete(ctx, test_helper.FakeVpcID, instanceId)
+	assert.NoError(t, err)
+	instance, err = service.Find(ctx, findModel)
+	assert.NoError(t, err)
+	assert.Equal(t, "DELETING", instance.Status)
+	_, err = service.Find(ctx, findModel)
+	assert.True(t, common.IsNotFound(err))
+	assert.Equal(t, 0, fake.Count("instance"))
+}
+
+func TestFakeAPI_StoresStorage(t *testing.T) {
+	fake, client := newFakeClient(t)
+	fake.PendingReads = 0
+	service := fptcloud_storage.NewStorageService(client)
+	ctx := context.Background()
+
+	storageId, err := service.CreateStorage(ctx, fptcloud_storage.StorageDTO{
+		Name:            "storage-test",
+		Type:            fptcloud_storage.External,
+		SizeGb:          10,
+		StoragePolicyId: "policy-id",
+		VpcId:           test_helper.FakeVpcID,
+	})
+	assert.NoError(t, err)
+
+	_, err = service.UpdateStorage(ctx, test_helper.FakeVpcID, storageId, fptcloud_storage.UpdateStorageDTO{Name: "storage-renamed", SizeGb: 20, StoragePolicyId: "policy-id"})
+	assert.NoError(t, err)
+
+	storage, err := service.FindStorage(ctx, fptcloud_storage.FindStorageDTO{ID: storageId, VpcId: test_helper.FakeVpcID})
+	assert.NoError(t, err)
+	assert.Equal(t, "ENABLED", storage.Status)
+	assert.Equal(t, "storage-renamed", storage.Name)
+	assert.Equal(t, 20, storage.SizeGb)
+
+	_, err = service.DeleteStorage(ctx, test_helper.FakeVpcID, storageId)
+	assert.NoError(t, err)
+	_, err = service.FindStorage(ctx, fptcloud_storage.FindStorageDTO{ID: storageId, VpcId: test_helper.FakeVpcID})
+	assert.True(t, common.IsNotFound(err))
+}
+
+func TestFakeAPI_ParallelOperationsShareOneClient(t *testing.T) {
+	fake, client := newFakeClient(t)
+	fake.PendingReads = 0
+	service := fptcloud_storage.NewStorageService(client)
+	ctx := context.Background()
+
+	// Terraform runs up to 10 operations at once against the one configured client
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			client.SetUserAgent(&common.Component{Name: "parallel", Version: fmt.Sprint(i)})
+
+			storageId, err := service.CreateStorage(ctx, fptcloud_storage.StorageDTO{
+				Name:            fmt.Sprintf("storage-%d", i),
+				Type:            fptcloud_storage.External,
+				SizeGb:          10,
+				StoragePolicyId: "policy-id",
+				VpcId:           test_helper.FakeVpcID,
+			})
+			if !assert.NoError(t, err) {
+				return
+			}
+			storage, err := service.FindStorage(ctx, fptcloud_storage.FindStorageDTO{ID: storageId, VpcId: test_helper.FakeVpcID})
+			if assert.NoError(t, err) {
+				assert.Equal(t, fmt.Sprintf("storage-%d", i), storage.Name)
+			}
+			_, err = service.DeleteStorage(ctx, test_helper.FakeVpcID, storageId)
+			assert.NoError(t, err)
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, 0, fake.Count("storage"))
+}
+
+func TestFakeAPI_StoresSecurityGroupAndRules(t *testing.T) {
+	_, client := newFakeClient(t)
+	ctx := context.Background()
+	securityGroupService := fptcloud_security_group.NewSecurityGroupService(client)
+	ruleService := fptcloud_security_group_rule.NewSecurityGroupRuleService(client)
+
+	securityGroupId, err := securityGroupService.Create(ctx, fptcloud_security_group.CreatedSecurityGroupDTO{
+		VpcId:   test_helper.FakeVpcID,
+		Name:    "security-group-test",
+		Type:    "ACL",
+		ApplyTo: []string{"10.0.0.2"},
+	})
+	assert.NoError(t, err)
+
+	findModel := fptcloud_security_group.FindSecurityGroupDTO{ID: securityGroupId, VpcId: test_helper.FakeVpcID}
+	securityGroup, err := securityGroupService.Find(ctx, findModel)
+	assert.NoError(t, err)
+	assert.Equal(t, "PENDING", securityGroup.Status)
+	securityGroup, err = securityGroupService.Find(ctx, findModel)
+	assert.NoError(t, err)
+	assert.Equal(t, "REALIZED", securityGroup.Status)
+	assert.Equal(t, "ACL", securityGroup.Type)
+
+	ruleId, err := ruleService.Create(ctx, test_helper.FakeVpcID, fptcloud_security_group_rule.CreateSecurityGroupRuleDto{
+		Direction:       "INBOUND",
+		Action:          "ALLOW",
+		Protocol:        "TCP",
+		PortRange:       "22",
+		Sources:         []string{"0.0.0.0/0"},
+		SecurityGroupId: securityGroupId,
+	})
+	assert.NoError(t, err)
+	_, _ = ruleService.Find(ctx, test_helper.FakeVpcID, ruleId)
+	rule, err := ruleService.Find(ctx, test_helper.FakeVpcID, ruleId)
+	assert.NoError(t, err)
+	assert.Equal(t, "ACTIVE", rule.Status)
+	assert.Equal(t, []string{"0.0.0.0/0"}, rule.Sources)
+	assert.Equal(t, securityGroupId, rule.SecurityGroupId)
+
+	_, err = ruleService.Create(ctx, test_helper.FakeVpcID, fptcloud_security_group_rule.CreateSecurityGroupRuleDto{SecurityGroupId: "missing"})
+	assert.Error(t, err)
+}
+
+func TestFakeAPI_FindsSubnetByName(t *testing.T) {
+	_, client := newFakeClient(t)
+	service := fptcloud_subnet.NewSubnetService(client)
+	ctx := context.Background()
+
+	createModel := fptcloud_subnet.CreateSubnetDTO{
+		VpcId:     test_helper.FakeVpcID,
+		Name:      "subnet-test",
+		CIDR:      "10.0.1.0/24",
+		Type:      "ISOLATED",
+		GatewayIp: "10.0.1.1",
+	}
+	created, err := service.CreateSubnet(ctx, createModel)
+	assert.NoError(t, err)
+	assert.Equal(t, "subnet-test", created.NetworkName)
+
+	_, err = service.CreateSubnet(ctx, createModel)
+	assert.ErrorIs(t, err, common.ConflictError)
+
+	subnet, err := service.FindSubnetByName(ctx, fptcloud_subnet.FindSubnetDTO{NetworkName: "subnet-test", VpcId: test_helper.FakeVpcID})
+	assert.NoError(t, err)
+	assert.Equal(t, created.ID, subnet.ID)
+	assert.Equal(t, "10.0.1.1", subnet.Gateway)
+
+	subnets, err := service.ListSubnet(ctx, test_helper.FakeVpcID)
+	assert.NoError(t, err)
+	assert.Len(t, *subnets, 1)
+}
+
+func TestFakeAPI_AllocatesFloatingIp(t *testing.T) {
+	fake, client := newFakeClient(t)
+	service := fptcloud_floating_ip.NewFloatingIpService(client)
+	ctx := context.Background()
+
+	created, err := service.CreateFloatingIp(ctx, test_helper.FakeVpcID, []string{"tag-id"})
+	assert.NoError(t, err)
+	assert.Equal(t, "IN_ACTIVE", created.Status)
+	assert.NotEmpty(t, created.IpAddress)
+
+	found, err := service.FindFloatingIpByAddress(ctx, fptcloud_floating_ip.FindFloatingIpDTO{IpAddress: created.IpAddress, VpcId: test_helper.FakeVpcID})
+	assert.NoError(t, err)
+	assert.Equal(t, created.ID, found.ID)
+	assert.Equal(t, []string{"tag-id"}, found.TagIds)
+
+	released, err := service.DeleteFloatingIp(ctx, test_helper.FakeVpcID, created.ID)
+	assert.NoError(t, err)
+	assert.True(t, released)
+	assert.Equal(t, 0, fake.Count("floating_ip"))
+}
+
+func TestFakeAPI_ProvisionsLoadBalancer(t *testing.T) {
+	_, client := newFakeClient(t)
+	service := fptcloud_load_balancer_v2.NewLoadBalancerV2Service(client)
+	ctx := context.Background()
+
+	created, err := service.CreateLoadBalancer(ctx, test_helper.FakeVpcID, fptcloud_load_balancer_v2.LoadBalancerCreateModel{
+		Name:      "load-balancer-test",
+		Size:      "small",
+		NetworkId: "subnet-id",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "PENDING_CREATE", created.Data.ProvisioningStatus)
+
+	_, _ = service.GetLoadBalancer(ctx, test_helper.FakeVpcID, created.Data.Id)
+	read, err := service.ReadLoadBalancer(ctx, test_helper.FakeVpcID, created.Data.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, "ACTIVE", read.LoadBalancer.ProvisioningStatus)
+	assert.Equal(t, "small", read.LoadBalancer.Size.Name)
+	assert.Equal(t, "subnet-id", read.LoadBalancer.Network.Id)
+
+	_, err = service.DeleteLoadBalancer(ctx, test_helper.FakeVpcID, created.Data.Id)
+	assert.NoError(t, err)
+	_, _ = service.ReadLoadBalancer(ctx, test_helper.FakeVpcID, created.Data.Id)
+	_, err = service.ReadLoadBalancer(ctx, test_helper.FakeVpcID, created.Data.Id)
+	assert.True(t, common.IsNotFound(err))
+}
+
+func TestFakeAPI_StoresBucketsAndAccessKeys(t *testing.T) {
+	_, client := newFakeClient(t)
+	service := fptcloud_object_storage.NewObjectStorageService(client)
+	ctx := context.Background()
+
+	enabled := service.CheckServiceEnable(ctx, test_helper.FakeVpcID)
+	assert.Equal(t, 1, enabled.Total)
+	assert.Equal(t, test_helper.FakeS3ServiceName, enabled.Data[0].S3ServiceName)
+
+	created := service.CreateBucket(ctx, fptcloud_object_storage.BucketRequest{Name: "bucket-test"}, test_helper.FakeVpcID, test_helper.FakeS3ServiceID)
+	assert.True(t, created.Status)
+	duplicate := service.CreateBucket(ctx, fptcloud_object_storage.BucketRequest{Name: "bucket-test"}, test_helper.FakeVpcID, test_helper.FakeS3ServiceID)
+	assert.False(t, duplicate.Status)
+
+	buckets, err := service.ListBuckets(ctx, test_helper.FakeVpcID, test_helper.FakeS3ServiceID, 1, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, buckets.Total)
+	assert.Equal(t, "bucket-test", buckets.Buckets[0].Name)
+
+	accessKey := service.CreateAccessKey(ctx, test_helper.FakeVpcID, test_helper.FakeS3ServiceID)
+	assert.True(t, accessKey.Status)
+	assert.NotEmpty(t, accessKey.Credential.SecretKey)
+
+	keys, err := service.ListAccessKeys(ctx, test_helper.FakeVpcID, test_helper.FakeS3ServiceID)
+	assert.NoError(t, err)
+	assert.Equal(t, accessKey.Credential.AccessKey, keys.Credentials[0].Credentials[0].AccessKey)
+
+	assert.True(t, service.DeleteAccessKey(ctx, test_helper.FakeVpcID, test_helper.FakeS3ServiceID, accessKey.Credential.AccessKey).Status)
+	assert.True(t, service.DeleteBucket(ctx, test_helper.FakeVpcID, test_helper.FakeS3ServiceID, "bucket-test").Status)
+	assert.False(t, service.DeleteBucket(ctx, test_helper.FakeVpcID, test_helper.FakeS3ServiceID, "bucket-test").Status)
+}
+
+func TestFakeAPI_ReadsBucketStatus(t *testing.T) {
+	_, client := newFakeClient(t)
+	service := fptcloud_object_storage.NewObjectStorageService(client)
+	assert.True(t, service.CreateBucket(context.Background(), fptcloud_object_storage.BucketRequest{Name: "bucket-test"}, test_helper.FakeVpcID, test_helper.FakeS3ServiceID).Status)
+
+	// The status an earlier version of the resource left false in state
+	r := fptcloud_object_storage.ResourceBucket()
+	d := r.Data(&terraform.InstanceState{ID: "bucket-test", Attributes: map[string]string{
+		"name":        "bucket-test",
+		"vpc_id":      test_helper.FakeVpcID,
+		"region_name": test_helper.FakeS3ServiceName,
+		"status":      "false",
+	}})
+	diags := r.ReadContext(context.Background(), d, client)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, "bucket-test", d.Id())
+	assert.Equal(t, true, d.Get("status"))
+}
+
+// importResource imports a resource with the given import ID and reads it, as terraform import does
+func importResource(t *testing.T, r *schema.Resource, importId string, client *common.Client) *schema.ResourceData {
+	imported, err := r.Importer.StateContext(context.Background(), r.Data(&terraform.InstanceState{ID: importId}), client)
+	if !assert.NoError(t, err) || !assert.Len(t, imported, 1) {
+		t.FailNow()
+	}
+	diags := r.ReadWithoutTimeout(context.Background(), imported[0], client)
+	assert.False(t, diags.HasError(), "%v", diags)
+	return imported[0]
+}
+
+func TestFakeAPI_ImportsVpcResources(t *testing.T) {
+	fake, client := newFakeClient(t)
+	fake.PendingReads = 0
+	ctx := context.Background()
+
+	storageId, err := fptcloud_storage.NewStorageService(client).CreateStorage(ctx, fptcloud_storage.StorageDTO{
+		Name:            "storage-test",
+		Type:            fptcloud_storage.External,
+		SizeGb:          10,
+		StoragePolicyId: "policy-id",
+		VpcId:           test_helper.FakeVpcID,
+	})
+	assert.NoError(t, err)
+	for _, importId := range []string{test_helper.FakeVpcID + "/" + storageId, test_helper.FakeVpcID + "/storage-test"} {
+		d := importResource(t, fptcloud_storage.ResourceStorage(), importId, client)
+		assert.Equal(t, storageId, d.Id())
+		assert.Equal(t, test_helper.FakeVpcID, d.Get("vpc_id"))
+		assert.Equal(t, 10, d.Get("size_gb"))
+	}
+
+	subnet, err := fptcloud_subnet.NewSubnetService(client).CreateSubnet(ctx, fptcloud_subnet.CreateSubnetDTO{
+		VpcId:     test_helper.FakeVpcID,
+		Name:      "subnet-test",
+		CIDR:      "10.0.1.0/24",
+		Type:      "ISOLATED",
+		GatewayIp: "10.0.1.1",
+	})
+	assert.NoError(t, err)
+	d := importResource(t, fptcloud_subnet.ResourceSubnet(), test_helper.FakeVpcID+"/subnet-test", client)
+	assert.Equal(t, subnet.ID, d.Id())
+	assert.Equal(t, "10.0.1.1", d.Get("gateway_ip"))
+
+	floatingIp, err := fptcloud_floating_ip.NewFloatingIpService(client).CreateFloatingIp(ctx, test_helper.FakeVpcID, nil)
+	assert.NoError(t, err)
+	d = importResource(t, fptcloud_floating_ip.ResourceFloatingIp(), test_helper.FakeVpcID+"/"+floatingIp.IpAddress, client)
+	assert.Equal(t, floatingIp.ID, d.Id())
+
+	_, err = fptcloud_storage.ResourceStorage().Importer.StateContext(ctx, fptcloud_storage.ResourceStorage().Data(&terraform.InstanceState{ID: "storage-test"}), client)
+	assert.ErrorIs(t, err, common.ErrMissingVpcId)
+	client.VpcId = test_helper.FakeVpcID
+	d = importResource(t, fptcloud_storage.ResourceStorage(), "storage-test", client)
+	assert.Equal(t, storageId, d.Id())
+}
+
+func TestAccFakeAPI_Storage(t *testing.T) {
+	fake := test_helper.NewFakeAPI()
+	defer fake.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: test_helper.TestProviderFactories,
+		CheckDestroy: func(*terraform.State) error {
+			if count := fake.Count("storage"); count != 0 {
+				return fmt.Errorf("%d storage still exist", count)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fake.ProviderConfig() + fmt.Sprintf(`
+resource "fptcloud_storage" "example" {
+  vpc_id            = %q
+  name              = "storage-test"
+  type              = "EXTERNAL"
+  size_gb           = 10
+  storage_policy_id = "policy-id"
+}
+`, test_helper.FakeVpcID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("fptcloud_storage.example", "id"),
+					resource.TestCheckResourceAttr("fptcloud_storage.example", "size_gb", "10"),
+				),
+			},
+		},
+	})
+}
+
+// objectValue returns an object of the given type with the given attributes, leaving the others null
+func objectValue(typ tftypes.Type, attributes map[string]tftypes.Value) tftypes.Value {
+	objectType := typ.(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+		if value, ok := attributes[name]; ok {
+			values[name] = value
+		}
+	}
+	return tftypes.NewValue(objectType, values)
+}
+
+func dynamicValue(t *testing.T, typ tftypes.Type, value tftypes.Value) *tfprotov5.DynamicValue {
+	dynamic, err := tfprotov5.NewDynamicValue(typ, value)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	return &dynamic
+}
+
+// configureFakeProvider returns the muxed provider server configured against the fake API, and its schemas
+func configureFakeProvider(t *testing.T, fake *test_helper.FakeAPI, attributes map[string]tftypes.Value) (tfprotov5.ProviderServer, *tfprotov5.GetProviderSchemaResponse) {
+	ctx := context.Background()
+	server, err := test_helper.TestProtoV5ProviderFactories["fptcloud"]()
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	config := map[string]tftypes.Value{
+		"token":        tftypes.NewValue(tftypes.String, test_helper.FakeToken),
+		"tenant_name":  tftypes.NewValue(tftypes.String, test_helper.FakeTenantName),
+		"region":       tftypes.NewValue(tftypes.String, test_helper.FakeRegion),
+		"api_endpoint": tftypes.NewValue(tftypes.String, fake.URL),
+		"max_retries":  tftypes.NewValue(tftypes.Number, 0),
+	}
+	for name, value := range attributes {
+		config[name] = value
+	}
+	providerType := schemas.Provider.ValueType()
+	configured, err := server.ConfigureProvider(ctx, &tfprotov5.ConfigureProviderRequest{
+		Config: dynamicValue(t, providerType, objectValue(providerType, config)),
+	})
+	if !assert.NoError(t, err) || !assert.Empty(t, configured.Diagnostics) {
+		t.FailNow()
+	}
+	return server, schemas
+}
+
+func TestFakeAPI_InstanceKeepsItsSDKv2State(t *testing.T) {
+	fake, client := newFakeClient(t)
+	fake.PendingReads = 0
+	ctx := context.Background()
+
+	instanceId, err := fptcloud_instance.NewInstanceService(client).Create(ctx, fptcloud_instance.CreateInstanceDTO{
+		VpcId:      test_helper.FakeVpcID,
+		Name:       "instance-test",
+		FlavorName: "2C2G",
+		ImageName:  "Ubuntu-22.04",
+		SubnetId:   "subnet-id",
+	})
+	assert.NoError(t, err)
+
+	server, schemas := configureFakeProvider(t, fake, nil)
+
+	instanceType := schemas.ResourceSchemas["fptcloud_instance"].ValueType()
+	stringSet := tftypes.Set{ElementType: tftypes.String}
+	configuredAttributes := map[string]tftypes.Value{
+		"vpc_id":            tftypes.NewValue(tftypes.String, test_helper.FakeVpcID),
+		"name":              tftypes.NewValue(tftypes.String, "instance-test"),
+		"flavor_name":       tftypes.NewValue(tftypes.String, "2C2G"),
+		"image_name":        tftypes.NewValue(tftypes.String, "Ubuntu-22.04"),
+		"subnet_id":         tftypes.NewValue(tftypes.String, "subnet-id"),
+		"ssh_key":           tftypes.NewValue(tftypes.String, "ssh-ed25519 AAAA"),
+		"status":            tftypes.NewValue(tftypes.String, "POWERED_ON"),
+		"storage_size_gb":   tftypes.NewValue(tftypes.Number, 40),
+		"storage_policy_id": tftypes.NewValue(tftypes.String, "policy-id"),
+	}
+	sdkv2State := map[string]tftypes.Value{
+		"id":                 tftypes.NewValue(tftypes.String, instanceId),
+		"private_ip":         tftypes.NewValue(tftypes.String, ""),
+		"public_ip":          tftypes.NewValue(tftypes.String, ""),
+		"instance_group_id":  tftypes.NewValue(tftypes.String, ""),
+		"security_group_ids": tftypes.NewValue(stringSet, []tftypes.Value{}),
+		"tag_ids":            tftypes.NewValue(stringSet, []tftypes.Value{}),
+	}
+	for name, value := range configuredAttributes {
+		sdkv2State[name] = value
+	}
+
+	read, err := server.ReadResource(ctx, &tfprotov5.ReadResourceRequest{
+		TypeName:     "fptcloud_instance",
+		CurrentState: dynamicValue(t, instanceType, objectValue(instanceType, sdkv2State)),
+	})
+	if !assert.NoError(t, err) || !assert.Empty(t, read.Diagnostics) {
+		return
+	}
+	prior, err := read.NewState.Unmarshal(instanceType)
+	assert.NoError(t, err)
+
+	// Terraform proposes the configured values of the attributes which are not computed, and the prior ones of the others
+	config := dynamicValue(t, instanceType, objectValue(instanceType, configuredAttributes))
+	proposedAttributes := map[string]tftypes.Value{}
+	assert.NoError(t, prior.As(&proposedAttributes))
+	for _, attribute := range schemas.ResourceSchemas["fptcloud_instance"].Block.Attributes {
+		if !attribute.Computed {
+			proposedAttributes[attribute.Name] = tftypes.NewValue(attribute.ValueType(), nil)
+			if value, ok := configuredAttributes[attribute.Name]; ok {
+				proposedAttributes[attribute.Name] = value
+			}
+		}
+	}
+
+	validated, err := server.ValidateResourceTypeConfig(ctx, &tfprotov5.ValidateResourceTypeConfigRequest{
+		TypeName: "fptcloud_instance",
+		Config:   config,
+	})
+	if !assert.NoError(t, err) || !assert.Empty(t, validated.Diagnostics) {
+		return
+	}
+	planned, err := server.PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{
+		TypeName:         "fptcloud_instance",
+		PriorState:       read.NewState,
+		ProposedNewState: dynamicValue(t, instanceType, objectValue(instanceType, proposedAttributes)),
+		Config:           config,
+	})
+	if !assert.NoError(t, err) || !assert.Empty(t, planned.Diagnostics) {
+		return
+	}
+	assert.Empty(t, planned.RequiresReplace)
+
+	plan, err := planned.PlannedState.Unmarshal(instanceType)
+	assert.NoError(t, err)
+	assert.True(t, plan.Equal(prior), "planned %s, prior %s", plan, prior)
+}
+
+func TestFakeAPI_DeletesEphemeralObjectStorageCredentialsOnClose(t *testing.T) {
+	fake, client := newFakeClient(t)
+	ctx := context.Background()
+	server, schemas := configureFakeProvider(t, fake, map[string]tftypes.Value{
+		"vpc_id": tftypes.NewValue(tftypes.String, test_helper.FakeVpcID),
+	})
+
+	credentialsType := schemas.EphemeralResourceSchemas["fptcloud_object_storage_credentials"].ValueType()
+	opened, err := server.OpenEphemeralResource(ctx, &tfprotov5.OpenEphemeralResourceRequest{
+		TypeName: "fptcloud_object_storage_credentials",
+		Config: dynamicValue(t, credentialsType, objectValue(credentialsType, map[string]tftypes.Value{
+			"region_name": tftypes.NewValue(tftypes.String, test_helper.FakeS3ServiceName),
+		})),
+	})
+	if !assert.NoError(t, err) || !assert.Empty(t, opened.Diagnostics) {
+		return
+	}
+	result, err := opened.Result.Unmarshal(credentialsType)
+	assert.NoError(t, err)
+	var credentials map[string]tftypes.Value
+	assert.NoError(t, result.As(&credentials))
+	var vpcId, accessKeyId, secretAccessKey string
+	assert.NoError(t, credentials["vpc_id"].As(&vpcId))
+	assert.NoError(t, credentials["access_key_id"].As(&accessKeyId))
+	assert.NoError(t, credentials["secret_access_key"].As(&secretAccessKey))
+	assert.Equal(t, test_helper.FakeVpcID, vpcId)
+	assert.NotEmpty(t, secretAccessKey)
+
+	service := fptcloud_object_storage.NewObjectStorageService(client)
+	keys, err := service.ListAccessKeys(ctx, test_helper.FakeVpcID, test_helper.FakeS3ServiceID)
+	assert.NoError(t, err)
+	assert.Equal(t, accessKeyId, keys.Credentials[0].Credentials[0].AccessKey)
+
+	closed, err := server.CloseEphemeralResource(ctx, &tfprotov5.CloseEphemeralResourceRequest{
+		TypeName: "fptcloud_object_storage_credentials",
+		Private:  opened.Private,
+	})
+	if !assert.NoError(t, err) || !assert.Empty(t, closed.Diagnostics) {
+		return
+	}
+	keys, err = service.ListAccessKeys(ctx, test_helper.FakeVpcID, test_helper.FakeS3ServiceID)
+	assert.NoError(t, err)
+	for _, credential := range keys.Credentials {
+		assert.Empty(t, credential.Credentials)
+	}
+}
+
+func TestFakeAPI_InstanceWriteOnlyPassword(t *testing.T) {
+	fake, _ := newFakeClient(t)
+	fake.PendingReads = 0
+	ctx := context.Background()
+	server, schemas := configureFakeProvider(t, fake, nil)
+
+	instanceType := schemas.ResourceSchemas["fptcloud_instance"].ValueType()
+	configuredAttributes := map[string]tftypes.Value{
+		"vpc_id":              tftypes.NewValue(tftypes.String, test_helper.FakeVpcID),
+		"name":                tftypes.NewValue(tftypes.String, "instance-test"),
+		"status":              tftypes.NewValue(tftypes.String, "POWERED_ON"),
+		"flavor_name":         tftypes.NewValue(tftypes.String, "2C2G"),
+		"image_name":          tftypes.NewValue(tftypes.String, "Ubuntu-22.04"),
+		"subnet_id":           tftypes.NewValue(tftypes.String, "subnet-id"),
+		"storage_size_gb":     tftypes.NewValue(tftypes.Number, 40),
+		"storage_policy_id":   tftypes.NewValue(tftypes.String, "policy-id"),
+		"password_wo":         tftypes.NewValue(tftypes.String, "secret"),
+		"password_wo_version": tftypes.NewValue(tftypes.Number, 1),
+	}
+	validate := func(attributes map[string]tftypes.Value) []*tfprotov5.Diagnostic {
+		validated, err := server.ValidateResourceTypeConfig(ctx, &tfprotov5.ValidateResourceTypeConfigRequest{
+			TypeName:           "fptcloud_instance",
+			Config:             dynamicValue(t, instanceType, objectValue(instanceType, attributes)),
+			ClientCapabilities: &tfprotov5.ValidateResourceTypeConfigClientCapabilities{WriteOnlyAttributesAllowed: true},
+		})
+		assert.NoError(t, err)
+		return validated.Diagnostics
+	}
+	assert.Empty(t, validate(configuredAttributes))
+	withoutVersion := map[string]tftypes.Value{}
+	for name, value := range configuredAttributes {
+		withoutVersion[name] = value
+	}
+	delete(withoutVersion, "password_wo_version")
+	assert.Equal(t, "Missing password_wo_version", validate(withoutVersion)[0].Summary)
+	withPassword := map[string]tftypes.Value{"password": tftypes.NewValue(tftypes.String, "secret")}
+	for name, value := range configuredAttributes {
+		withPassword[name] = value
+	}
+	assert.Equal(t, "Invalid credentials", validate(withPassword)[0].Summary)
+
+	config := dynamicValue(t, instanceType, objectValue(instanceType, configuredAttributes))
+	planned, err := server.PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{
+		TypeName:         "fptcloud_instance",
+		PriorState:       dynamicValue(t, instanceType, tftypes.NewValue(instanceType, nil)),
+		ProposedNewState: dynamicValue(t, instanceType, objectValue(instanceType, configuredAttributes)),
+		Config:           config,
+	})
+	if !assert.NoError(t, err) || !assert.Empty(t, planned.Diagnostics) {
+		return
+	}
+	applied, err := server.ApplyResourceChange(ctx, &tfprotov5.ApplyResourceChangeRequest{
+		TypeName:     "fptcloud_instance",
+		PriorState:   dynamicValue(t, instanceType, tftypes.NewValue(instanceType, nil)),
+		PlannedState: planned.PlannedState,
+		Config:       config,
+	})
+	if !assert.NoError(t, err) || !assert.Empty(t, applied.Diagnostics) {
+		return
+	}
+	state, err := applied.NewState.Unmarshal(instanceType)
+	assert.NoError(t, err)
+	var attributes map[string]tftypes.Value
+	assert.NoError(t, state.As(&attributes))
+	assert.True(t, attributes["password_wo"].IsNull())
+	assert.True(t, attributes["password"].IsNull())
+	assert.True(t, attributes["password_wo_version"].Equal(tftypes.NewValue(tftypes.Number, 1)))
+	assert.Equal(t, 1, fake.Count("instance"))
+
+	// Rotating the version replaces the instance, the API being unable to reset its password
+	configuredAttributes["password_wo_version"] = tftypes.NewValue(tftypes.Number, 2)
+	attributes["password_wo_version"] = configuredAttributes["password_wo_version"]
+	rotated, err := server.PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{
+		TypeName:         "fptcloud_instance",
+		PriorState:       applied.NewState,
+		ProposedNewState: dynamicValue(t, instanceType, tftypes.NewValue(instanceType, attributes)),
+		Config:           dynamicValue(t, instanceType, objectValue(instanceType, configuredAttributes)),
+	})
+	if !assert.NoError(t, err) || !assert.Len(t, rotated.Diagnostics, 1) {
+		return
+	}
+	assert.Equal(t, tfprotov5.DiagnosticSeverityWarning, rotated.Diagnostics[0].Severity)
+	assert.Equal(t, "Instance will be replaced", rotated.Diagnostics[0].Summary)
+	assert.Len(t, rotated.RequiresReplace, 1)
+	assert.Equal(t, tftypes.NewAttributePath().WithAttributeName("password_wo_version"), rotated.RequiresReplace[0])
+}
+
+func TestFakeAPI_InstanceReadsTheAttributesLeftUnset(t *testing.T) {
+	fake, _ := newFakeClient(t)
+	fake.PendingReads = 0
+	ctx := context.Background()
+	server, schemas := configureFakeProvider(t, fake, nil)
+
+	// The flavor, public IP and instance group are left to the API
+	instanceType := schemas.ResourceSchemas["fptcloud_instance"].ValueType()
+	configuredAttributes := map[string]tftypes.Value{
+		"vpc_id":            tftypes.NewValue(tftypes.String, test_helper.FakeVpcID),
+		"name":              tftypes.NewValue(tftypes.String, "instance-test"),
+		"status":            tftypes.NewValue(tftypes.String, "POWERED_ON"),
+		"image_name":        tftypes.NewValue(tftypes.String, "Ubuntu-22.04"),
+		"subnet_id":         tftypes.NewValue(tftypes.String, "subnet-id"),
+		"storage_size_gb":   tftypes.NewValue(tftypes.Number, 40),
+		"storage_policy_id": tftypes.NewValue(tftypes.String, "policy-id"),
+		"ssh_key":           tftypes.NewValue(tftypes.String, "ssh-ed25519 AAAA"),
+	}
+	config := dynamicValue(t, instanceType, objectValue(instanceType, configuredAttributes))
+	planned, err := server.PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{
+		TypeName:         "fptcloud_instance",
+		PriorState:       dynamicValue(t, instanceType, tftypes.NewValue(instanceType, nil)),
+		ProposedNewState: config,
+		Config:           config,
+	})
+	if !assert.NoError(t, err) || !assert.Empty(t, planned.Diagnostics) {
+		return
+	}
+	applied, err := server.ApplyResourceChange(ctx, &tfprotov5.ApplyResourceChangeRequest{
+		TypeName:     "fptcloud_instance",
+		PriorState:   dynamicValue(t, instanceType, tftypes.NewValue(instanceType, nil)),
+		PlannedState: planned.PlannedState,
+		Config:       config,
+	})
+	if !assert.NoError(t, err) || !assert.Empty(t, applied.Diagnostics) {
+		return
+	}
+	state, err := applied.NewState.Unmarshal(instanceType)
+	assert.NoError(t, err)
+	var attributes map[string]tftypes.Value
+	assert.NoError(t, state.As(&attributes))
+
+	// Terraform rejects a new state which does not match the known planned values
+	plan, err := planned.PlannedState.Unmarshal(instanceType)
+	assert.NoError(t, err)
+	var plannedAttributes map[string]tftypes.Value
+	assert.NoError(t, plan.As(&plannedAttributes))
+	for name, value := range plannedAttributes {
+		if value.IsFullyKnown() {
+			assert.True(t, value.Equal(attributes[name]), "planned %s = %s, applied %s", name, value, attributes[name])
+		}
+	}
+	assert.True(t, attributes["flavor_name"].Equal(tftypes.NewValue(tftypes.String, test_helper.FakeFlavorName)), attributes["flavor_name"].String())
+	var publicIp string
+	assert.NoError(t, attributes["public_ip"].As(&publicIp))
+	assert.NotEmpty(t, publicIp)
+	assert.True(t, attributes["instance_group_id"].IsNull())
+
+	// The next plan keeps the values read from the API
+	replanned, err := server.PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{
+		TypeName:         "fptcloud_instance",
+		PriorState:       applied.NewState,
+		ProposedNewState: applied.NewState,
+		Config:           config,
+	})
+	if !assert.NoError(t, err) || !assert.Empty(t, replanned.Diagnostics) {
+		return
+	}
+	plan, err = replanned.PlannedState.Unmarshal(instanceType)
+	assert.NoError(t, err)
+	assert.True(t, plan.Equal(state), "planned %s, state %s", plan, state)
+}
+
+func TestFakeAPI_AppliesDefaultTagIdsToExistingStorage(t *testing.T) {
+	fake, client := newFakeClient(t)
+	fake.PendingReads = 0
+	service := fptcloud_storage.NewStorageService(client)
+	ctx := context.Background()
+
+	storageId, err := service.CreateStorage(ctx, fptcloud_storage.StorageDTO{
+		Name:            "storage-test",
+		Type:            fptcloud_storage.External,
+		SizeGb:          10,
+		StoragePolicyId: "policy-id",
+		VpcId:           test_helper.FakeVpcID,
+		TagIds:          []string{"app"},
+	})
+	assert.NoError(t, err)
+
+	stringSet := tftypes.Set{ElementType: tftypes.String}
+	tagSet := func(tagIds ...string) tftypes.Value {
+		values := make([]tftypes.Value, 0, len(tagIds))
+		for _, tagId := range tagIds {
+			values = append(values, tftypes.NewValue(tftypes.String, tagId))
+		}
+		return tftypes.NewValue(stringSet, values)
+	}
+	server, schemas := configureFakeProvider(t, fake, map[string]tftypes.Value{"default_tag_ids": tagSet("owner")})
+
+	storageSchema := schemas.ResourceSchemas["fptcloud_storage"]
+	storageType := storageSchema.ValueType()
+	configuredAttributes := map[string]tftypes.Value{
+		"vpc_id":            tftypes.NewValue(tftypes.String, test_helper.FakeVpcID),
+		"name":              tftypes.NewValue(tftypes.String, "storage-test"),
+		"type":              tftypes.NewValue(tftypes.String, fptcloud_storage.External),
+		"size_gb":           tftypes.NewValue(tftypes.Number, 10),
+		"storage_policy_id": tftypes.NewValue(tftypes.String, "policy-id"),
+		"tag_ids":           tagSet("app"),
+	}
+	config := dynamicValue(t, storageType, objectValue(storageType, configuredAttributes))
+	state := map[string]tftypes.Value{"id": tftypes.NewValue(tftypes.String, storageId)}
+	for name, value := range configuredAttributes {
+		state[name] = value
+	}
+
+	read := func(state *tfprotov5.DynamicValue) *tfprotov5.DynamicValue {
+		response, err := server.ReadResource(ctx, &tfprotov5.ReadResourceRequest{TypeName: "fptcloud_storage", CurrentState: state})
+		if !assert.NoError(t, err) || !assert.Empty(t, response.Diagnostics) {
+			t.FailNow()
+		}
+		return response.NewState
+	}
+	// Terraform proposes the configured values of the attributes which are not computed, and the prior ones of the others
+	plan := func(prior *tfprotov5.DynamicValue) (tftypes.Value, *tfprotov5.DynamicValue) {
+		priorValue, err := prior.Unmarshal(storageType)
+		assert.NoError(t, err)
+		proposedAttributes := map[string]tftypes.Value{}
+		assert.NoError(t, priorValue.As(&proposedAttributes))
+		for _, attribute := range storageSchema.Block.Attributes {
+			if value, ok := configuredAttributes[attribute.Name]; ok {
+				proposedAttributes[attribute.Name] = value
+			}
+		}
+		response, err := server.PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{
+			TypeName:         "fptcloud_storage",
+			PriorState:       prior,
+			ProposedNewState: dynamicValue(t, storageType, objectValue(storageType, proposedAttributes)),
+			Config:           config,
+		})
+		if !assert.NoError(t, err) || !assert.Empty(t, response.Diagnostics) {
+			t.FailNow()
+		}
+		assert.Empty(t, response.RequiresReplace)
+		return priorValue, response.PlannedState
+	}
+	tagIdsAll := func(state *tfprotov5.DynamicValue) tftypes.Value {
+		value, err := state.Unmarshal(storageType)
+		assert.NoError(t, err)
+		attributes := map[string]tftypes.Value{}
+		assert.NoError(t, value.As(&attributes))
+		return attributes["tag_ids_all"]
+	}
+
+	// The default tag ID added to the provider is missing from the existing storage
+	prior := read(dynamicValue(t, storageType, objectValue(storageType, state)))
+	assert.True(t, tagIdsAll(prior).Equal(tagSet("app")), tagIdsAll(prior).String())
+	_, planned := plan(prior)
+	assert.True(t, tagIdsAll(planned).Equal(tagSet("app", "owner")), tagIdsAll(planned).String())
+
+	applied, err := server.ApplyResourceChange(ctx, &tfprotov5.ApplyResourceChangeRequest{
+		TypeName:     "fptcloud_storage",
+		PriorState:   prior,
+		PlannedState: planned,
+		Config:       config,
+	})
+	if !assert.NoError(t, err) || !assert.Empty(t, applied.Diagnostics) {
+		return
+	}
+	storage, err := service.FindStorage(ctx, fptcloud_storage.FindStorageDTO{ID: storageId, VpcId: test_helper.FakeVpcID})
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"app", "owner"}, storage.TagIds)
+
+	priorValue, planned := plan(read(applied.NewState))
+	plannedValue, err := planned.Unmarshal(storageType)
+	assert.NoError(t, err)
+	assert.True(t, plannedValue.Equal(priorValue), "planned %s, prior %s", plannedValue, priorValue)
+
+	// The default tag ID removed outside of Terraform shows as drift
+	_, err = service.UpdateTags(ctx, test_helper.FakeVpcID, storageId, []string{"app"})
+	assert.NoError(t, err)
+	drifted := read(applied.NewState)
+	assert.True(t, tagIdsAll(drifted).Equal(tagSet("app")), tagIdsAll(drifted).String())
+	_, planned = plan(drifted)
+	assert.True(t, tagIdsAll(planned).Equal(tagSet("app", "owner")), tagIdsAll(planned).String())
+}
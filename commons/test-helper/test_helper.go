@@ -8,6 +8,9 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"terraform-provider-fptcloud/fptcloud"
 )
@@ -16,7 +19,9 @@ var (
 	TestProvider          *schema.Provider
 	TestProviders         map[string]*schema.Provider
 	TestProviderFactories map[string]func() (*schema.Provider, error)
-	ENV                   = map[string]string{
+	// TestProtoV5ProviderFactories serve the SDKv2 and the framework resources muxed, as the provider binary does
+	TestProtoV5ProviderFactories map[string]func() (tfprotov5.ProviderServer, error)
+	ENV                          = map[string]string{
 		"VPC_ID": os.Getenv("VPC_ID"),
 	}
 )
@@ -31,6 +36,18 @@ func init() {
 			return TestProvider, nil
 		},
 	}
+	TestProtoV5ProviderFactories = map[string]func() (tfprotov5.ProviderServer, error){
+		"fptcloud": func() (tfprotov5.ProviderServer, error) {
+			muxServer, err := tf5muxserver.NewMuxServer(context.Background(),
+				providerserver.NewProtocol5(fptcloud.NewXplatProvider("test")()),
+				fptcloud.Provider().GRPCProvider,
+			)
+			if err != nil {
+				return nil, err
+			}
+			return muxServer.ProviderServer(), nil
+		},
+	}
 }
 
 func TestProviderImpl(t *testing.T) {
@@ -0,0 +1,46 @@
+package commons
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DefaultTimeout returns the provider timeout, which bounds the resource operations without a timeouts block
+func (c *Client) DefaultTimeout() time.Duration {
+	return time.Duration(c.Timeout) * time.Minute
+}
+
+// ResourceTimeouts declares the create, read, update and delete keys of the timeouts block of a long-running resource.
+// Their defaults are left at zero so that an operation without a timeout falls back to the provider timeout.
+func ResourceTimeouts() *schema.ResourceTimeout {
+	return &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(time.Duration(0)),
+		Read:   schema.DefaultTimeout(time.Duration(0)),
+		Update: schema.DefaultTimeout(time.Duration(0)),
+		Delete: schema.DefaultTimeout(time.Duration(0)),
+	}
+}
+
+// ResourceTimeout returns the timeout of a resource operation, as set in its timeouts block or the provider timeout otherwise
+func (c *Client) ResourceTimeout(d *schema.ResourceData, key string) time.Duration {
+	if timeout := d.Timeout(key); timeout > 0 {
+		return timeout
+	}
+	return c.DefaultTimeout()
+}
+
+// WithResourceTimeout bounds the context of a resource operation by its timeout. Resources declaring ResourceTimeouts
+// register their operations through it as the *WithoutTimeout functions, since the SDK would apply the zero defaults.
+func WithResourceTimeout(
+	key string,
+	operation func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics,
+) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		ctx, cancel := context.WithTimeout(ctx, m.(*Client).ResourceTimeout(d, key))
+		defer cancel()
+		return operation(ctx, d, m)
+	}
+}
@@ -0,0 +1,63 @@
+package commons
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func testTimeoutsResource(timeouts *schema.ResourceTimeout) *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {Type: schema.TypeString, Optional: true},
+		},
+		Timeouts: timeouts,
+	}
+}
+
+func TestResourceTimeout_FallsBackToProviderTimeout(t *testing.T) {
+	client, err := NewClientWithURL("apiKey", "https://api.example.com", "region", "tenant", 7)
+	assert.NoError(t, err)
+
+	d := testTimeoutsResource(ResourceTimeouts()).Data(nil)
+	for _, key := range []string{schema.TimeoutCreate, schema.TimeoutRead, schema.TimeoutUpdate, schema.TimeoutDelete} {
+		assert.Equal(t, 7*time.Minute, client.ResourceTimeout(d, key), key)
+	}
+}
+
+func TestResourceTimeout_UsesTimeoutsBlock(t *testing.T) {
+	client, err := NewClientWithURL("apiKey", "https://api.example.com", "region", "tenant", 7)
+	assert.NoError(t, err)
+
+	timeouts := ResourceTimeouts()
+	timeouts.Create = schema.DefaultTimeout(45 * time.Minute)
+	d := testTimeoutsResource(timeouts).Data(nil)
+
+	assert.Equal(t, 45*time.Minute, client.ResourceTimeout(d, schema.TimeoutCreate))
+	assert.Equal(t, 7*time.Minute, client.ResourceTimeout(d, schema.TimeoutDelete))
+}
+
+func TestWithResourceTimeout_BoundsContext(t *testing.T) {
+	client, err := NewClientWithURL("apiKey", "https://api.example.com", "region", "tenant", 7)
+	assert.NoError(t, err)
+
+	timeouts := ResourceTimeouts()
+	timeouts.Update = schema.DefaultTimeout(2 * time.Minute)
+	d := testTimeoutsResource(timeouts).Data(nil)
+
+	var deadline time.Time
+	var ok bool
+	operation := WithResourceTimeout(schema.TimeoutUpdate, func(ctx context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+		deadline, ok = ctx.Deadline()
+		return nil
+	})
+
+	start := time.Now()
+	assert.Empty(t, operation(context.Background(), d, client))
+	assert.True(t, ok)
+	assert.WithinDuration(t, start.Add(2*time.Minute), deadline, 5*time.Second)
+}
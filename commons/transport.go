@@ -0,0 +1,162 @@
+package commons
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	// maxIdleConnsPerHost allows every concurrent Terraform operation to keep its connection to the API alive
+	maxIdleConnsPerHost = 32
+	idleConnTimeout     = 90 * time.Second
+	dialTimeout         = 30 * time.Second
+	keepAlive           = 30 * time.Second
+	tlsHandshakeTimeout = 10 * time.Second
+)
+
+// TransportConfig holds the TLS and proxy settings of the API transport
+type TransportConfig struct {
+	CACertFile         string
+	CACertPEM          string
+	ClientCertFile     string
+	ClientCertPEM      string
+	ClientKeyFile      string
+	ClientKeyPEM       string
+	InsecureSkipVerify bool
+	ProxyURL           string
+}
+
+var (
+	sharedTransportsMu sync.Mutex
+	sharedTransports   = map[TransportConfig]*http.Transport{}
+)
+
+// SharedTransport returns the transport built for the given configuration, creating it on first use.
+// Both provider servers run in the same process, so clients configured with the same settings reuse
+// one transport and its connection pool.
+func SharedTransport(config TransportConfig) (*http.Transport, error) {
+	sharedTransportsMu.Lock()
+	defer sharedTransportsMu.Unlock()
+
+	if transport, ok := sharedTransports[config]; ok {
+		return transport, nil
+	}
+
+	transport, err := NewTransport(config)
+	if err != nil {
+		return nil, err
+	}
+	sharedTransports[config] = transport
+	return transport, nil
+}
+
+// NewTransport builds an http.Transport from the given TLS and proxy settings
+func NewTransport(config TransportConfig) (*http.Transport, error) {
+	tlsConfig, err := config.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	proxy := http.ProxyFromEnvironment
+	if config.ProxyURL != "" {
+		proxyURL, err := url.Parse(config.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy_url: %w", err)
+		}
+		if proxyURL.Scheme == "" || proxyURL.Host == "" {
+			return nil, fmt.Errorf("invalid proxy_url %q: scheme and host are required", config.ProxyURL)
+		}
+		proxy = http.ProxyURL(proxyURL)
+	}
+
+	dialer := &net.Dialer{
+		Timeout:   dialTimeout,
+		KeepAlive: keepAlive,
+	}
+
+	// Compression stays enabled so the transport requests gzip and transparently decodes
+	// the response, as long as no Accept-Encoding header is set on the request.
+	// ForceAttemptHTTP2 is required because a custom TLS config disables HTTP/2 otherwise.
+	return &http.Transport{
+		Proxy:                 proxy,
+		DialContext:           dialer.DialContext,
+		TLSClientConfig:       tlsConfig,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          100,
+		MaxIdleConnsPerHost:   maxIdleConnsPerHost,
+		IdleConnTimeout:       idleConnTimeout,
+		TLSHandshakeTimeout:   tlsHandshakeTimeout,
+		ExpectContinueTimeout: 1 * time.Second,
+		DisableCompression:    false,
+	}, nil
+}
+
+func (config TransportConfig) tlsConfig() (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: config.InsecureSkipVerify, // #nosec G402 -- explicit opt-in for lab endpoints
+	}
+
+	caPEM, err := readPEM(config.CACertPEM, config.CACertFile, "CA certificate")
+	if err != nil {
+		return nil, err
+	}
+	if len(caPEM) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, errors.New("no valid certificate found in the CA certificate bundle")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	certPEM, err := readPEM(config.ClientCertPEM, config.ClientCertFile, "client certificate")
+	if err != nil {
+		return nil, err
+	}
+	keyPEM, err := readPEM(config.ClientKeyPEM, config.ClientKeyFile, "client key")
+	if err != nil {
+		return nil, err
+	}
+	if len(certPEM) > 0 || len(keyPEM) > 0 {
+		if len(certPEM) == 0 || len(keyPEM) == 0 {
+			return nil, errors.New("client certificate and client key must be set together")
+		}
+		certificate, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	return tlsConfig, nil
+}
+
+// readPEM returns the inline PEM content when set, or the content of the given file otherwise
+func readPEM(inline string, file string, name string) ([]byte, error) {
+	if inline != "" && file != "" {
+		return nil, fmt.Errorf("only one of the %s file or PEM content can be set", name)
+	}
+	if inline != "" {
+		return []byte(inline), nil
+	}
+	if file == "" {
+		return nil, nil
+	}
+
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s file: %w", name, err)
+	}
+	return content, nil
+}
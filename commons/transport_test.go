@@ -0,0 +1,139 @@
+package commons
+
+import (
+	"compress/gzip"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTransport_UsesExplicitProxy(t *testing.T) {
+	transport, err := NewTransport(TransportConfig{ProxyURL: "http://proxy.example.com:3128"})
+	assert.NoError(t, err)
+
+	req, _ := http.NewRequest(http.MethodGet, "https://console-api.fptcloud.com/api", nil)
+	proxyURL, err := transport.Proxy(req)
+	assert.NoError(t, err)
+	assert.Equal(t, "proxy.example.com:3128", proxyURL.Host)
+}
+
+func TestNewTransport_RejectsInvalidProxy(t *testing.T) {
+	_, err := NewTransport(TransportConfig{ProxyURL: "proxy.example.com"})
+	assert.Error(t, err)
+}
+
+func TestNewTransport_InsecureSkipVerify(t *testing.T) {
+	transport, err := NewTransport(TransportConfig{InsecureSkipVerify: true})
+	assert.NoError(t, err)
+	assert.True(t, transport.TLSClientConfig.InsecureSkipVerify)
+}
+
+func TestNewTransport_RejectsInvalidCABundle(t *testing.T) {
+	_, err := NewTransport(TransportConfig{CACertPEM: "not a certificate"})
+	assert.Error(t, err)
+}
+
+func TestNewTransport_RejectsInlineAndFileTogether(t *testing.T) {
+	_, err := NewTransport(TransportConfig{CACertPEM: "pem", CACertFile: "/tmp/ca.pem"})
+	assert.Error(t, err)
+}
+
+func TestNewTransport_RequiresClientKeyWithCertificate(t *testing.T) {
+	_, err := NewTransport(TransportConfig{ClientCertPEM: "pem"})
+	assert.EqualError(t, err, "client certificate and client key must be set together")
+}
+
+func TestSharedTransport_ReusesTransportForSameConfig(t *testing.T) {
+	first, err := SharedTransport(TransportConfig{ProxyURL: "http://proxy.example.com:3128"})
+	assert.NoError(t, err)
+	second, err := SharedTransport(TransportConfig{ProxyURL: "http://proxy.example.com:3128"})
+	assert.NoError(t, err)
+	assert.Same(t, first, second)
+
+	other, err := SharedTransport(TransportConfig{})
+	assert.NoError(t, err)
+	assert.NotSame(t, first, other)
+}
+
+func TestNewTransport_KeepsConnectionsAlive(t *testing.T) {
+	transport, err := NewTransport(TransportConfig{})
+	assert.NoError(t, err)
+	assert.False(t, transport.DisableKeepAlives)
+	assert.False(t, transport.DisableCompression)
+	assert.True(t, transport.ForceAttemptHTTP2)
+	assert.Equal(t, maxIdleConnsPerHost, transport.MaxIdleConnsPerHost)
+}
+
+func TestSendRequest_DecodesGzipResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("Accept-Encoding") != "gzip" {
+			_, _ = rw.Write([]byte(`{"compressed": false}`))
+			return
+		}
+		rw.Header().Set("Content-Encoding", "gzip")
+		writer := gzip.NewWriter(rw)
+		_, _ = writer.Write([]byte(`{"compressed": true}`))
+		_ = writer.Close()
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithURL("TEST-API-KEY", server.URL, "TEST", "TEST", 5)
+	assert.NoError(t, err)
+
+	resp, err := client.SendGetRequest("/test")
+	assert.NoError(t, err)
+	assert.Equal(t, `{"compressed": true}`, string(resp))
+}
+
+// newBenchmarkClient returns a client trusting the given TLS test server through the shared transport
+func newBenchmarkClient(b *testing.B, server *httptest.Server) *Client {
+	client, err := NewClientWithURL("TEST-API-KEY", server.URL, "TEST", "TEST", 5)
+	if err != nil {
+		b.Fatal(err)
+	}
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := client.ConfigureTransport(TransportConfig{CACertPEM: string(caPEM)}); err != nil {
+		b.Fatal(err)
+	}
+	return client
+}
+
+func newBenchmarkServer(b *testing.B) *httptest.Server {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		_, _ = rw.Write([]byte(`{"data": "success"}`))
+	}))
+	b.Cleanup(server.Close)
+	return server
+}
+
+// BenchmarkSendGetRequest_PersistentTransport measures requests reusing pooled connections
+func BenchmarkSendGetRequest_PersistentTransport(b *testing.B) {
+	client := newBenchmarkClient(b, newBenchmarkServer(b))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := client.SendGetRequest("/test"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSendGetRequest_TransportPerRequest measures the former behaviour, where every
+// request went through a new transport and paid a TCP and TLS handshake
+func BenchmarkSendGetRequest_TransportPerRequest(b *testing.B) {
+	client := newBenchmarkClient(b, newBenchmarkServer(b))
+	shared := client.httpClient.Transport.(*http.Transport)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		transport := shared.Clone()
+		client.httpClient.Transport = transport
+		if _, err := client.SendGetRequest("/test"); err != nil {
+			b.Fatal(err)
+		}
+		transport.CloseIdleConnections()
+	}
+}
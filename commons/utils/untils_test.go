@@ -1,8 +1,14 @@
 package utils
 
 import (
-	"github.com/stretchr/testify/assert"
+	"context"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestNameValidation_AllowsValidName(t *testing.T) {
@@ -80,3 +86,35 @@ func TestCommaSeparatedAllowedKeys_HandlesEmptyKeys(t *testing.T) {
 	result := GetCommaSeparatedAllowedKeys(keys)
 	assert.Equal(t, expected, result)
 }
+
+func validateString(v validator.String, value types.String) diag.Diagnostics {
+	response := &validator.StringResponse{}
+	v.ValidateString(context.Background(), validator.StringRequest{Path: path.Root("name"), ConfigValue: value}, response)
+	return response.Diagnostics
+}
+
+func TestNameValidator_RejectsNameWithWhitespace(t *testing.T) {
+	assert.False(t, validateString(NameValidator(), types.StringValue("ValidName")).HasError())
+	diags := validateString(NameValidator(), types.StringValue("Invalid Name"))
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "name cannot contain whitespace. Got Invalid Name", diags.Errors()[0].Detail())
+}
+
+func TestStringValidators_SkipUnsetAndUnknownValues(t *testing.T) {
+	for _, v := range []validator.String{NameValidator(), NotEmptyValidator(), OneOfValidator("a")} {
+		assert.False(t, validateString(v, types.StringNull()).HasError())
+		assert.False(t, validateString(v, types.StringUnknown()).HasError())
+	}
+}
+
+func TestNotEmptyValidator_RejectsEmptyString(t *testing.T) {
+	assert.True(t, validateString(NotEmptyValidator(), types.StringValue("")).HasError())
+	assert.False(t, validateString(NotEmptyValidator(), types.StringValue("vpc-id")).HasError())
+}
+
+func TestOneOfValidator_RejectsOtherValues(t *testing.T) {
+	v := OneOfValidator("POWERED_ON", "POWERED_OFF")
+	assert.False(t, validateString(v, types.StringValue("POWERED_OFF")).HasError())
+	diags := validateString(v, types.StringValue("SUSPENDED"))
+	assert.Equal(t, "expected one of POWERED_ON, POWERED_OFF, got SUSPENDED", diags.Errors()[0].Detail())
+}
@@ -0,0 +1,69 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// stringValidator validates the configured value of a string attribute of a framework resource
+type stringValidator struct {
+	description string
+	validate    func(value string) error
+}
+
+// StringValidator returns a framework validator checking the known values of a string attribute with validate
+func StringValidator(description string, validate func(value string) error) validator.String {
+	return stringValidator{description: description, validate: validate}
+}
+
+func (v stringValidator) Description(context.Context) string {
+	return v.description
+}
+
+func (v stringValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v stringValidator) ValidateString(_ context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+	if err := v.validate(request.ConfigValue.ValueString()); err != nil {
+		response.Diagnostics.AddAttributeError(request.Path, "Invalid attribute value", err.Error())
+	}
+}
+
+// NameValidator is ValidateName for the framework resources
+func NameValidator() validator.String {
+	return StringValidator("value must not contain whitespace", func(value string) error {
+		if _, errs := ValidateName(value, ""); len(errs) > 0 {
+			return errs[0]
+		}
+		return nil
+	})
+}
+
+// NotEmptyValidator refuses an empty string
+func NotEmptyValidator() validator.String {
+	return StringValidator("value must not be empty", func(value string) error {
+		if value == "" {
+			return fmt.Errorf("expected a non-empty value")
+		}
+		return nil
+	})
+}
+
+// OneOfValidator refuses a string which is not one of the given values
+func OneOfValidator(values ...string) validator.String {
+	description := "value must be one of: " + strings.Join(values, ", ")
+	return StringValidator(description, func(value string) error {
+		if !slices.Contains(values, value) {
+			return fmt.Errorf("expected one of %s, got %s", strings.Join(values, ", "), value)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,168 @@
+package commons
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ErrMissingVpcId is returned when neither a resource nor the provider set vpc_id
+var ErrMissingVpcId = errors.New("vpc_id is required: set it on the resource, or on the provider with vpc_id or the FPTCLOUD_VPC_ID environment variable")
+
+// InheritedVpcIdDescription completes the description of a vpc_id attribute falling back to the provider vpc_id
+func InheritedVpcIdDescription(description string) string {
+	description = strings.TrimSpace(description)
+	if description != "" && !strings.HasSuffix(description, ".") {
+		description += "."
+	}
+	return strings.TrimSpace(description + " Defaults to the provider `vpc_id`.")
+}
+
+// DefaultVpcId returns vpcId, or the provider vpc_id when vpcId is empty
+func (c *Client) DefaultVpcId(vpcId string) (string, error) {
+	if vpcId != "" {
+		return vpcId, nil
+	}
+	if c == nil || c.VpcId == "" {
+		return "", ErrMissingVpcId
+	}
+	return c.VpcId, nil
+}
+
+// InheritVpcId makes the required top-level vpc_id of the given SDKv2 resources and data sources optional, falling back
+// to the provider vpc_id. The resolved vpc_id is planned by the resources and set by the data sources before they read,
+// so that it is recorded in state. Each resource gets its own copy of its schema, since some schemas are package-level
+// maps shared between resources and between the providers built by Provider.
+func InheritVpcId(resourceMaps ...map[string]*schema.Resource) {
+	for _, resources := range resourceMaps {
+		for _, r := range resources {
+			if s, ok := r.Schema["vpc_id"]; ok && s.Required && s.Type == schema.TypeString {
+				inheritVpcId(r)
+			}
+		}
+	}
+}
+
+func inheritVpcId(r *schema.Resource) {
+	optional := *r.Schema["vpc_id"]
+	optional.Required = false
+	optional.Optional = true
+	optional.Computed = true
+	optional.Description = InheritedVpcIdDescription(optional.Description)
+
+	resourceSchema := make(map[string]*schema.Schema, len(r.Schema))
+	for name, s := range r.Schema {
+		resourceSchema[name] = s
+	}
+	resourceSchema["vpc_id"] = &optional
+	r.Schema = resourceSchema
+
+	if r.CreateContext != nil || r.CreateWithoutTimeout != nil {
+		if r.CustomizeDiff != nil {
+			r.CustomizeDiff = customdiff.All(customizeDiffVpcId, r.CustomizeDiff)
+		} else {
+			r.CustomizeDiff = customizeDiffVpcId
+		}
+	}
+
+	switch {
+	case r.ReadContext != nil:
+		r.ReadContext = readWithVpcId(r.ReadContext)
+	case r.ReadWithoutTimeout != nil:
+		r.ReadWithoutTimeout = readWithVpcId(r.ReadWithoutTimeout)
+	}
+}
+
+// customizeDiffVpcId plans the provider vpc_id when the configuration omits vpc_id
+func customizeDiffVpcId(_ context.Context, d *schema.ResourceDiff, m interface{}) error {
+	config := d.GetRawConfig()
+	if !config.IsKnown() || config.IsNull() || !config.Type().HasAttribute("vpc_id") {
+		return nil
+	}
+	if vpcId := config.GetAttr("vpc_id"); !vpcId.IsNull() {
+		return nil
+	}
+
+	// The provider is not configured yet when its own configuration is unknown
+	client, ok := m.(*Client)
+	if !ok || client == nil {
+		return nil
+	}
+	vpcId, err := client.DefaultVpcId("")
+	if err != nil {
+		return err
+	}
+	if d.Get("vpc_id").(string) == vpcId {
+		return nil
+	}
+	return d.SetNew("vpc_id", vpcId)
+}
+
+func readWithVpcId(read schema.ReadContextFunc) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if err := setDefaultVpcId(d, m); err != nil {
+			return diag.FromErr(err)
+		}
+		return read(ctx, d, m)
+	}
+}
+
+// setDefaultVpcId sets the provider vpc_id on a data source without one, or on a resource imported by its ID only
+func setDefaultVpcId(d *schema.ResourceData, m interface{}) error {
+	if d.Get("vpc_id").(string) != "" {
+		return nil
+	}
+	client, _ := m.(*Client)
+	vpcId, err := client.DefaultVpcId("")
+	if err != nil {
+		return err
+	}
+	return d.Set("vpc_id", vpcId)
+}
+
+// DefaultVpcIdValue returns the configured vpc_id of a framework data source, or the provider vpc_id when unset
+func (c *Client) DefaultVpcIdValue(vpcId types.String, diags *fwdiag.Diagnostics) types.String {
+	resolved, err := c.DefaultVpcId(vpcId.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("vpc_id"), "Missing vpc_id", err.Error())
+		return vpcId
+	}
+	return types.StringValue(resolved)
+}
+
+// PlanDefaultVpcId plans the provider vpc_id of a framework resource whose configuration omits vpc_id. The resource
+// is replaced when the planned vpc_id differs from the one in state. Nothing is planned until the provider is configured.
+func (c *Client) PlanDefaultVpcId(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if c == nil || request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var configured types.String
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("vpc_id"), &configured)...)
+	if response.Diagnostics.HasError() || !configured.IsNull() {
+		return
+	}
+
+	vpcId, err := c.DefaultVpcId("")
+	if err != nil {
+		response.Diagnostics.AddAttributeError(path.Root("vpc_id"), "Missing vpc_id", err.Error())
+		return
+	}
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("vpc_id"), vpcId)...)
+
+	if !request.State.Raw.IsNull() {
+		var current types.String
+		response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root("vpc_id"), &current)...)
+		if current.ValueString() != vpcId {
+			response.RequiresReplace = append(response.RequiresReplace, path.Root("vpc_id"))
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package commons
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func testVpcClient(t *testing.T, vpcId string) *Client {
+	client, err := NewClientWithURL("apiKey", "https://api.example.com", "region", "tenant", 5)
+	assert.NoError(t, err)
+	client.VpcId = vpcId
+	return client
+}
+
+func testVpcResources() (*schema.Resource, *schema.Resource, *string) {
+	vpcSchema := map[string]*schema.Schema{
+		"vpc_id": {Type: schema.TypeString, Required: true, ForceNew: true, Description: "The vpc id"},
+		"name":   {Type: schema.TypeString, Optional: true, ForceNew: true},
+	}
+	readVpcId := new(string)
+	read := func(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+		*readVpcId = d.Get("vpc_id").(string)
+		return nil
+	}
+	resource := &schema.Resource{
+		Schema:        vpcSchema,
+		CreateContext: read,
+		ReadContext:   read,
+		DeleteContext: read,
+	}
+	dataSource := &schema.Resource{
+		Schema:      vpcSchema,
+		ReadContext: read,
+	}
+	return resource, dataSource, readVpcId
+}
+
+func testVpcDiff(r *schema.Resource, state *terraform.InstanceState, config map[string]cty.Value, m interface{}) (*terraform.InstanceDiff, error) {
+	schemaBlock := r.CoreConfigSchema()
+	for name := range schemaBlock.Attributes {
+		if _, ok := config[name]; !ok {
+			config[name] = cty.NullVal(cty.String)
+		}
+	}
+	// Terraform sends the raw configuration along with the prior state, empty on create
+	if state == nil {
+		state = &terraform.InstanceState{}
+	}
+	state.RawConfig = cty.ObjectVal(config)
+	return r.SimpleDiff(context.Background(), state, terraform.NewResourceConfigShimmed(state.RawConfig, schemaBlock), m)
+}
+
+func TestInheritVpcId_SharedSchema(t *testing.T) {
+	resource, dataSource, _ := testVpcResources()
+	InheritVpcId(map[string]*schema.Resource{"resource": resource}, map[string]*schema.Resource{"data_source": dataSource})
+
+	vpcId := resource.Schema["vpc_id"]
+	assert.False(t, vpcId.Required)
+	assert.True(t, vpcId.Optional)
+	assert.True(t, vpcId.Computed)
+	assert.True(t, vpcId.ForceNew)
+	assert.Equal(t, "The vpc id. Defaults to the provider `vpc_id`.", vpcId.Description)
+	assert.NotNil(t, resource.CustomizeDiff)
+	assert.Nil(t, dataSource.CustomizeDiff)
+	assert.NoError(t, resource.InternalValidate(nil, true))
+}
+
+func TestInheritVpcId_SchemaSharedBetweenProviders(t *testing.T) {
+	first, _, _ := testVpcResources()
+	shared := first.Schema
+	InheritVpcId(map[string]*schema.Resource{"resource": first})
+	assert.True(t, shared["vpc_id"].Required)
+
+	second := &schema.Resource{Schema: shared, CreateContext: first.CreateContext, ReadContext: first.ReadContext, DeleteContext: first.DeleteContext}
+	InheritVpcId(map[string]*schema.Resource{"resource": second})
+	assert.True(t, second.Schema["vpc_id"].Optional)
+	assert.NotNil(t, second.CustomizeDiff)
+}
+
+func TestInheritVpcId_DataSourceRead(t *testing.T) {
+	_, dataSource, readVpcId := testVpcResources()
+	InheritVpcId(map[string]*schema.Resource{"data_source": dataSource})
+
+	d := dataSource.TestResourceData()
+	assert.False(t, dataSource.ReadContext(context.Background(), d, testVpcClient(t, "provider-vpc")).HasError())
+	assert.Equal(t, "provider-vpc", *readVpcId)
+	assert.Equal(t, "provider-vpc", d.Get("vpc_id"))
+
+	d = dataSource.TestResourceData()
+	assert.NoError(t, d.Set("vpc_id", "own-vpc"))
+	assert.False(t, dataSource.ReadContext(context.Background(), d, testVpcClient(t, "provider-vpc")).HasError())
+	assert.Equal(t, "own-vpc", *readVpcId)
+
+	diags := dataSource.ReadContext(context.Background(), dataSource.TestResourceData(), testVpcClient(t, ""))
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, "vpc_id is required")
+}
+
+func TestInheritVpcId_PlansProviderVpcId(t *testing.T) {
+	resource, _, _ := testVpcResources()
+	InheritVpcId(map[string]*schema.Resource{"resource": resource})
+
+	diff, err := testVpcDiff(resource, nil, map[string]cty.Value{}, testVpcClient(t, "provider-vpc"))
+	assert.NoError(t, err)
+	assert.Equal(t, "provider-vpc", diff.Attributes["vpc_id"].New)
+
+	diff, err = testVpcDiff(resource, nil, map[string]cty.Value{"vpc_id": cty.StringVal("own-vpc")}, testVpcClient(t, "provider-vpc"))
+	assert.NoError(t, err)
+	assert.Equal(t, "own-vpc", diff.Attributes["vpc_id"].New)
+
+	_, err = testVpcDiff(resource, nil, map[string]cty.Value{}, testVpcClient(t, ""))
+	assert.ErrorIs(t, err, ErrMissingVpcId)
+}
+
+func TestInheritVpcId_ReplacesOnProviderVpcIdChange(t *testing.T) {
+	resource, _, _ := testVpcResources()
+	InheritVpcId(map[string]*schema.Resource{"resource": resource})
+
+	state := &terraform.InstanceState{ID: "id", Attributes: map[string]string{"id": "id", "vpc_id": "old-vpc"}}
+	diff, err := testVpcDiff(resource, state, map[string]cty.Value{}, testVpcClient(t, "old-vpc"))
+	assert.NoError(t, err)
+	assert.Empty(t, diff.Attributes)
+
+	diff, err = testVpcDiff(resource, state, map[string]cty.Value{}, testVpcClient(t, "new-vpc"))
+	assert.NoError(t, err)
+	assert.Equal(t, "new-vpc", diff.Attributes["vpc_id"].New)
+	assert.True(t, diff.RequiresNew())
+}
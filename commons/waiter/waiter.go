@@ -0,0 +1,224 @@
+// Package waiter polls the state of an asynchronous operation of the API until it reaches a target state.
+package waiter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	common "terraform-provider-fptcloud/commons"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Defaults of the poll intervals of a StateWaiter
+const (
+	DefaultMinInterval = 3 * time.Second
+	DefaultMaxInterval = 30 * time.Second
+)
+
+// UnlimitedRetries lets a StateWaiter retry the refresh errors until it times out
+const UnlimitedRetries = -1
+
+// Clock is the source of time of a StateWaiter, replaced by a fake clock in tests
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time                         { return time.Now() }
+func (realClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// RefreshFunc fetches the object being waited for and returns it with its current state
+type RefreshFunc[T any] func(ctx context.Context) (T, string, error)
+
+// StateWaiter polls Refresh until the state is one of Target. The interval between two polls starts at MinInterval
+// and doubles up to MaxInterval.
+type StateWaiter[T any] struct {
+	// Description names the awaited operation in the logs and errors, such as "instance 1234 to be created"
+	Description string
+
+	// Pending are the states to keep polling on. When empty, every state outside Target and Failure is pending.
+	Pending []string
+	// Target are the states ending the wait successfully
+	Target []string
+	// Failure are the states ending the wait with a FailureStateError
+	Failure []string
+
+	Refresh RefreshFunc[T]
+
+	// Timeout bounds the wait, which is otherwise bounded by the context only
+	Timeout time.Duration
+	// Delay is waited before the first refresh
+	Delay       time.Duration
+	MinInterval time.Duration
+	MaxInterval time.Duration
+
+	// NotFoundIsTarget ends the wait successfully when the object is not found, as expected after a deletion
+	NotFoundIsTarget bool
+	// NotFoundChecks is the number of consecutive not found errors tolerated, as the API may not list an object
+	// right after creating it
+	NotFoundChecks int
+	// ErrorRetries is the number of consecutive refresh errors tolerated, or UnlimitedRetries
+	ErrorRetries int
+
+	Clock Clock
+}
+
+// UnexpectedStateError is returned when the state is neither pending, target nor failure
+type UnexpectedStateError struct {
+	Description string
+	State       string
+	Expected    []string
+}
+
+func (err *UnexpectedStateError) Error() string {
+	return fmt.Sprintf("unexpected state %q while waiting for %s, wanted one of %s",
+		err.State, err.Description, strings.Join(err.Expected, ", "))
+}
+
+// FailureStateError is returned when the state is one of the failure states
+type FailureStateError struct {
+	Description string
+	State       string
+}
+
+func (err *FailureStateError) Error() string {
+	return fmt.Sprintf("failure state %q while waiting for %s", err.State, err.Description)
+}
+
+// TimeoutError is returned when the target state is not reached in time. It holds the last state seen and the
+// last refresh error, if any.
+type TimeoutError struct {
+	Description string
+	Elapsed     time.Duration
+	LastState   string
+	LastError   error
+}
+
+func (err *TimeoutError) Error() string {
+	msg := fmt.Sprintf("timeout after %s while waiting for %s", err.Elapsed.Round(time.Second), err.Description)
+	if err.LastState != "" {
+		msg += fmt.Sprintf(" (last state: %q)", err.LastState)
+	}
+	if err.LastError != nil {
+		msg += fmt.Sprintf(": %s", err.LastError)
+	}
+	return msg
+}
+
+func (err *TimeoutError) Unwrap() error {
+	return err.LastError
+}
+
+// Wait polls until the target state is reached and returns the last refreshed object
+func (w *StateWaiter[T]) Wait(ctx context.Context) (T, error) {
+	var zero T
+	clock := w.clock()
+	start := clock.Now()
+
+	var deadline time.Time
+	if w.Timeout > 0 {
+		deadline = start.Add(w.Timeout)
+	}
+
+	interval := w.MinInterval
+	if interval <= 0 {
+		interval = DefaultMinInterval
+	}
+	maxInterval := w.MaxInterval
+	if maxInterval <= 0 {
+		maxInterval = DefaultMaxInterval
+	}
+
+	var lastState string
+	var lastErr error
+	notFoundCount, errorCount := 0, 0
+
+	wait := w.Delay
+	for {
+		if wait > 0 {
+			if !deadline.IsZero() {
+				wait = min(wait, deadline.Sub(clock.Now()))
+			}
+			select {
+			case <-ctx.Done():
+				return zero, w.contextError(ctx, clock.Now().Sub(start), lastState, lastErr)
+			case <-clock.After(wait):
+			}
+		}
+
+		result, state, err := w.Refresh(ctx)
+		if err != nil && ctx.Err() != nil {
+			return zero, w.contextError(ctx, clock.Now().Sub(start), lastState, err)
+		}
+
+		switch {
+		case err != nil && common.IsNotFound(err):
+			if w.NotFoundIsTarget {
+				tflog.Info(ctx, "Done waiting for "+w.Description+", not found")
+				return result, nil
+			}
+			notFoundCount++
+			if notFoundCount > w.NotFoundChecks {
+				return zero, err
+			}
+			lastErr = err
+		case err != nil:
+			errorCount++
+			if w.ErrorRetries != UnlimitedRetries && errorCount > w.ErrorRetries {
+				return zero, err
+			}
+			lastErr = err
+			tflog.Warn(ctx, "Error refreshing the state while waiting for "+w.Description+": "+err.Error())
+		default:
+			notFoundCount, errorCount, lastErr = 0, 0, nil
+			lastState = state
+
+			if slices.Contains(w.Target, state) {
+				tflog.Info(ctx, fmt.Sprintf("Done waiting for %s, state %q", w.Description, state))
+				return result, nil
+			}
+			if slices.Contains(w.Failure, state) {
+				return result, &FailureStateError{Description: w.Description, State: state}
+			}
+			if len(w.Pending) > 0 && !slices.Contains(w.Pending, state) {
+				return result, &UnexpectedStateError{Description: w.Description, State: state, Expected: w.expected()}
+			}
+		}
+
+		elapsed := clock.Now().Sub(start)
+		if !deadline.IsZero() && !clock.Now().Before(deadline) {
+			return zero, &TimeoutError{Description: w.Description, Elapsed: elapsed, LastState: lastState, LastError: lastErr}
+		}
+
+		wait = interval
+		interval = min(interval*2, maxInterval)
+		tflog.Info(ctx, fmt.Sprintf("Waiting for %s, state %q after %s, next check in %s",
+			w.Description, lastState, elapsed.Round(time.Second), wait))
+	}
+}
+
+func (w *StateWaiter[T]) clock() Clock {
+	if w.Clock != nil {
+		return w.Clock
+	}
+	return realClock{}
+}
+
+func (w *StateWaiter[T]) expected() []string {
+	return append(append([]string{}, w.Pending...), w.Target...)
+}
+
+// contextError reports a context past its deadline as a TimeoutError, as the deadline is the timeout of the operation
+func (w *StateWaiter[T]) contextError(ctx context.Context, elapsed time.Duration, lastState string, lastErr error) error {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return &TimeoutError{Description: w.Description, Elapsed: elapsed, LastState: lastState, LastError: lastErr}
+	}
+	return fmt.Errorf("waiting for %s: %w", w.Description, ctx.Err())
+}
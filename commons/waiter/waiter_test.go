@@ -0,0 +1,248 @@
+package waiter
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	common "terraform-provider-fptcloud/commons"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeClock advances its time by the awaited duration instead of sleeping
+type fakeClock struct {
+	now   time.Time
+	waits []time.Duration
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.waits = append(c.waits, d)
+	c.now = c.now.Add(d)
+	ch := make(chan time.Time, 1)
+	ch <- c.now
+	return ch
+}
+
+type refreshStep struct {
+	state string
+	err   error
+}
+
+// stepsRefresh returns the given steps in order, then repeats the last one
+func stepsRefresh(steps ...refreshStep) (RefreshFunc[string], *int) {
+	calls := 0
+	return func(context.Context) (string, string, error) {
+		step := steps[min(calls, len(steps)-1)]
+		calls++
+		if step.err != nil {
+			return "", "", step.err
+		}
+		return "object", step.state, nil
+	}, &calls
+}
+
+var notFoundErr = common.APIError{StatusCode: 404, Message: "not found"}
+
+func TestWait_ReachesTargetWithExponentialIntervals(t *testing.T) {
+	clock := newFakeClock()
+	refresh, calls := stepsRefresh(
+		refreshStep{state: "PENDING"},
+		refreshStep{state: "PENDING"},
+		refreshStep{state: "UPDATING"},
+		refreshStep{state: "PENDING"},
+		refreshStep{state: "ACTIVE"},
+	)
+
+	w := &StateWaiter[string]{
+		Description: "test object to be created",
+		Pending:     []string{"PENDING", "UPDATING"},
+		Target:      []string{"ACTIVE"},
+		Refresh:     refresh,
+		Delay:       5 * time.Second,
+		MinInterval: time.Second,
+		MaxInterval: 4 * time.Second,
+		Clock:       clock,
+	}
+
+	result, err := w.Wait(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "object", result)
+	assert.Equal(t, 5, *calls)
+	assert.Equal(t, []time.Duration{5 * time.Second, time.Second, 2 * time.Second, 4 * time.Second, 4 * time.Second}, clock.waits)
+}
+
+func TestWait_FailureState(t *testing.T) {
+	refresh, _ := stepsRefresh(refreshStep{state: "CREATING"}, refreshStep{state: "ERROR"})
+
+	w := &StateWaiter[string]{
+		Description: "test object to be created",
+		Target:      []string{"ACTIVE"},
+		Failure:     []string{"ERROR"},
+		Refresh:     refresh,
+		Clock:       newFakeClock(),
+	}
+
+	_, err := w.Wait(context.Background())
+	var failureErr *FailureStateError
+	assert.ErrorAs(t, err, &failureErr)
+	assert.Equal(t, "ERROR", failureErr.State)
+}
+
+func TestWait_UnexpectedState(t *testing.T) {
+	refresh, _ := stepsRefresh(refreshStep{state: "DELETED"})
+
+	w := &StateWaiter[string]{
+		Description: "test object to be created",
+		Pending:     []string{"CREATING"},
+		Target:      []string{"ACTIVE"},
+		Refresh:     refresh,
+		Clock:       newFakeClock(),
+	}
+
+	_, err := w.Wait(context.Background())
+	var unexpectedErr *UnexpectedStateError
+	assert.ErrorAs(t, err, &unexpectedErr)
+	assert.Equal(t, "DELETED", unexpectedErr.State)
+	assert.Equal(t, []string{"CREATING", "ACTIVE"}, unexpectedErr.Expected)
+}
+
+func TestWait_Timeout(t *testing.T) {
+	clock := newFakeClock()
+	refresh, calls := stepsRefresh(refreshStep{state: "PENDING"})
+
+	w := &StateWaiter[string]{
+		Description: "test object to be created",
+		Pending:     []string{"PENDING"},
+		Target:      []string{"ACTIVE"},
+		Refresh:     refresh,
+		Timeout:     10 * time.Second,
+		Clock:       clock,
+	}
+
+	_, err := w.Wait(context.Background())
+	var timeoutErr *TimeoutError
+	assert.ErrorAs(t, err, &timeoutErr)
+	assert.Equal(t, "PENDING", timeoutErr.LastState)
+	assert.Equal(t, 10*time.Second, timeoutErr.Elapsed)
+	assert.Equal(t, 4, *calls)
+	assert.Equal(t, []time.Duration{3 * time.Second, 6 * time.Second, time.Second}, clock.waits)
+}
+
+func TestWait_NotFoundIsTarget(t *testing.T) {
+	refresh, _ := stepsRefresh(refreshStep{state: "DELETING"}, refreshStep{err: notFoundErr})
+
+	w := &StateWaiter[string]{
+		Description:      "test object to be deleted",
+		Pending:          []string{"DELETING"},
+		Refresh:          refresh,
+		NotFoundIsTarget: true,
+		Clock:            newFakeClock(),
+	}
+
+	_, err := w.Wait(context.Background())
+	assert.NoError(t, err)
+}
+
+func TestWait_NotFoundChecks(t *testing.T) {
+	refresh, _ := stepsRefresh(refreshStep{err: notFoundErr}, refreshStep{err: notFoundErr}, refreshStep{state: "ACTIVE"})
+
+	w := &StateWaiter[string]{
+		Description:    "test object to be created",
+		Target:         []string{"ACTIVE"},
+		Refresh:        refresh,
+		NotFoundChecks: 2,
+		Clock:          newFakeClock(),
+	}
+	_, err := w.Wait(context.Background())
+	assert.NoError(t, err)
+
+	refresh, calls := stepsRefresh(refreshStep{err: notFoundErr})
+	w.Refresh = refresh
+	_, err = w.Wait(context.Background())
+	assert.True(t, common.IsNotFound(err))
+	assert.Equal(t, 3, *calls)
+}
+
+func TestWait_ErrorRetries(t *testing.T) {
+	apiErr := common.APIError{StatusCode: 500, Message: "internal error"}
+	refresh, _ := stepsRefresh(refreshStep{err: apiErr}, refreshStep{state: "ACTIVE"})
+
+	w := &StateWaiter[string]{
+		Description: "test object to be created",
+		Target:      []string{"ACTIVE"},
+		Refresh:     refresh,
+		Clock:       newFakeClock(),
+	}
+	_, err := w.Wait(context.Background())
+	assert.ErrorIs(t, err, apiErr)
+
+	refresh, _ = stepsRefresh(refreshStep{err: apiErr}, refreshStep{state: "ACTIVE"})
+	w.Refresh = refresh
+	w.ErrorRetries = 1
+	_, err = w.Wait(context.Background())
+	assert.NoError(t, err)
+
+	refresh, _ = stepsRefresh(refreshStep{err: apiErr})
+	w.Refresh = refresh
+	w.ErrorRetries = UnlimitedRetries
+	w.Timeout = time.Minute
+	_, err = w.Wait(context.Background())
+	var timeoutErr *TimeoutError
+	assert.ErrorAs(t, err, &timeoutErr)
+	assert.ErrorIs(t, err, apiErr)
+}
+
+func TestWait_ContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	refresh := func(context.Context) (string, string, error) {
+		calls++
+		cancel()
+		return "object", "PENDING", nil
+	}
+
+	w := &StateWaiter[string]{
+		Description: "test object to be created",
+		Target:      []string{"ACTIVE"},
+		Refresh:     refresh,
+		Clock:       blockingClock{},
+	}
+
+	_, err := w.Wait(ctx)
+	assert.True(t, errors.Is(err, context.Canceled))
+	assert.Equal(t, 1, calls)
+}
+
+func TestWait_ContextDeadlineIsTimeout(t *testing.T) {
+	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+
+	w := &StateWaiter[string]{
+		Description: "test object to be created",
+		Target:      []string{"ACTIVE"},
+		Refresh: func(ctx context.Context) (string, string, error) {
+			return "", "", ctx.Err()
+		},
+		Clock: blockingClock{},
+	}
+
+	_, err := w.Wait(ctx)
+	var timeoutErr *TimeoutError
+	assert.ErrorAs(t, err, &timeoutErr)
+}
+
+// blockingClock never fires, so that a wait only ends with its context
+type blockingClock struct{}
+
+func (blockingClock) Now() time.Time                       { return time.Time{} }
+func (blockingClock) After(time.Duration) <-chan time.Time { return nil }
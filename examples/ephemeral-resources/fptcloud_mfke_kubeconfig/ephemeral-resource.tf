@@ -0,0 +1,10 @@
+ephemeral "fptcloud_mfke_kubeconfig" "example" {
+  vpc_id     = "your_vpc_id"
+  cluster_id = "your_cluster_id"
+}
+
+provider "kubernetes" {
+  host                   = ephemeral.fptcloud_mfke_kubeconfig.example.endpoint
+  cluster_ca_certificate = base64decode(ephemeral.fptcloud_mfke_kubeconfig.example.certificate_authority_data)
+  token                  = ephemeral.fptcloud_mfke_kubeconfig.example.token
+}
@@ -0,0 +1,23 @@
+# Access key of the tenant
+ephemeral "fptcloud_object_storage_credentials" "example" {
+  vpc_id      = "your_vpc_id"
+  region_name = "HCM-02"
+}
+
+# Access key of a sub user
+ephemeral "fptcloud_object_storage_credentials" "sub_user" {
+  vpc_id      = "your_vpc_id"
+  region_name = "HCM-02"
+  user_id     = "your_sub_user_id"
+}
+
+provider "aws" {
+  region                      = "us-east-1"
+  access_key                  = ephemeral.fptcloud_object_storage_credentials.example.access_key_id
+  secret_key                  = ephemeral.fptcloud_object_storage_credentials.example.secret_access_key
+  skip_credentials_validation = true
+  skip_requesting_account_id  = true
+  endpoints {
+    s3 = "your_s3_endpoint"
+  }
+}
@@ -0,0 +1,28 @@
+variable "cidr" {
+  type    = string
+  default = "10.0.0.0/24"
+}
+
+variable "static_ip_pool" {
+  type    = string
+  default = "10.0.0.2-10.0.0.100"
+}
+
+resource "fptcloud_subnet" "example" {
+  vpc_id         = "your_vpc_id"
+  name           = "subnet_name"
+  type           = "NAT_ROUTED"
+  cidr           = var.cidr
+  gateway_ip     = "10.0.0.1"
+  static_ip_pool = var.static_ip_pool
+
+  lifecycle {
+    precondition {
+      condition = alltrue([
+        for ip in values(provider::fptcloud::subnet_static_pool(var.static_ip_pool)) :
+        provider::fptcloud::ip_range_contains(var.cidr, ip)
+      ])
+      error_message = "The static IP pool must be within the subnet CIDR."
+    }
+  }
+}
@@ -0,0 +1,4 @@
+output "cluster_slug" {
+  # "my-cluster-abc123"
+  value = provider::fptcloud::mfke_cluster_slug("my-cluster", "abc123")
+}
@@ -0,0 +1,4 @@
+locals {
+  # { vpc_id = "your_vpc_id", id_or_name = "my-subnet" }
+  subnet_import = provider::fptcloud::parse_import_id("your_vpc_id/my-subnet")
+}
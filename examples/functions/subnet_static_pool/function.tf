@@ -0,0 +1,4 @@
+locals {
+  # { start = "10.0.0.2", end = "10.0.0.254" }
+  static_pool = provider::fptcloud::subnet_static_pool("10.0.0.2-10.0.0.254")
+}
@@ -27,4 +27,5 @@ resource "fptcloud_database" "example" {
   domain_name = ""
   is_public = "no | yes" # is your database public or not
   vhost_name = "VHostDefault"
+  tag_ids = ["your-tag-id"]
 }
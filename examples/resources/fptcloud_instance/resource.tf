@@ -51,3 +51,17 @@ resource "fptcloud_instance" "example_02" {
   status            = "POWERED_ON"
   instance_group_id = "your_instance_group_id"
 }
+
+# Create instance with a write-only password, which is never stored in the state (Terraform 1.11 or later)
+resource "fptcloud_instance" "example_04" {
+  name                = "example-04"
+  vpc_id              = "your_vpc_id"
+  password_wo         = ephemeral.vault_kv_secret_v2.instance.data["password"]
+  password_wo_version = 1
+  image_name          = "UBUNTU-20.04-04072024"
+  flavor_name         = "2C2G"
+  subnet_id           = "your_subnet_id"
+  storage_size_gb     = 60
+  storage_policy_id   = "your_policy_id"
+  status              = "POWERED_ON"
+}
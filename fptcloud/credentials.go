@@ -0,0 +1,103 @@
+package fptcloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	common "terraform-provider-fptcloud/commons"
+	"terraform-provider-fptcloud/commons/config"
+	fptcloud_dfke "terraform-provider-fptcloud/fptcloud/dfke"
+	fptcloud_vpc "terraform-provider-fptcloud/fptcloud/vpc"
+)
+
+const skipCredentialsValidationHint = "Set skip_credentials_validation to configure the provider without checking the credentials."
+
+// validatedClients holds the credentials validation result of the API clients, as the SDKv2 and the framework
+// provider servers share their client and are both configured on every run
+var validatedClients sync.Map
+
+// validateCredentials checks the credentials of the API client once: the token and the tenant_name with the tenant
+// endpoint, then that the region is enabled for the tenant. The tenant lookup is cached by the client, so the
+// resources resolving the tenant ID later on don't call the API again.
+func validateCredentials(ctx context.Context, client *common.Client) []*config.AttributeError {
+	if errs, ok := validatedClients.Load(client); ok {
+		return errs.([]*config.AttributeError)
+	}
+	errs := checkCredentials(ctx, client)
+	validatedClients.Store(client, errs)
+	return errs
+}
+
+func checkCredentials(ctx context.Context, client *common.Client) []*config.AttributeError {
+	tenant, err := fptcloud_vpc.NewService(client).GetTenant(ctx)
+	if err != nil {
+		return []*config.AttributeError{credentialsError(err, "tenant_name", "Invalid tenant_name",
+			fmt.Sprintf("tenant %q was not found: %s", client.TenantName, err))}
+	}
+	if tenant == nil || tenant.Id == "" {
+		return []*config.AttributeError{{
+			Attribute: "tenant_name",
+			Summary:   "Invalid tenant_name",
+			Detail:    fmt.Sprintf("tenant %q was not found. %s", client.TenantName, skipCredentialsValidationHint),
+		}}
+	}
+
+	regions, err := fptcloud_dfke.NewTenancyApiClient(client).GetRegions(ctx, tenant.Id)
+	if err != nil {
+		return []*config.AttributeError{credentialsError(err, "region", "Unable to list the regions",
+			fmt.Sprintf("the regions of tenant %q could not be listed: %s", client.TenantName, err))}
+	}
+
+	enabled := make([]string, 0, len(regions))
+	for _, region := range regions {
+		if regionMatches(client.Region, region) {
+			return nil
+		}
+		enabled = append(enabled, region.Abbr)
+	}
+	return []*config.AttributeError{{
+		Attribute: "region",
+		Summary:   "Region not enabled",
+		Detail: fmt.Sprintf("region %s is not enabled for tenant %q, the enabled regions are: %s. %s",
+			client.Region, client.TenantName, strings.Join(enabled, ", "), skipCredentialsValidationHint),
+	}}
+}
+
+// credentialsError scopes an API error to the token when it was refused, to the API endpoint when it could not be
+// reached, and to the given attribute otherwise
+func credentialsError(err error, attribute string, summary string, detail string) *config.AttributeError {
+	err = common.DecodeError(err)
+	switch {
+	case errors.Is(err, common.UnauthorizedError):
+		return &config.AttributeError{
+			Attribute: "token",
+			Summary:   "Invalid token",
+			Detail:    fmt.Sprintf("the token was refused by the API, it may have expired: %s. %s", err, skipCredentialsValidationHint),
+		}
+	case errors.Is(err, common.TimeoutError):
+		return &config.AttributeError{
+			Attribute: "api_endpoint",
+			Summary:   "Unable to reach the API",
+			Detail:    fmt.Sprintf("%s. %s", err, skipCredentialsValidationHint),
+		}
+	}
+	return &config.AttributeError{Attribute: attribute, Summary: summary, Detail: detail + ". " + skipCredentialsValidationHint}
+}
+
+// regionMatches reports whether a region of the tenant is the provider region, which the API names either by its
+// code, such as VN/HAN, by the part of the code after the country, or by its fpt-region header
+func regionMatches(code string, region fptcloud_dfke.Region) bool {
+	names := []string{code, code[strings.LastIndex(code, "/")+1:]}
+	if registered, ok := common.LookupRegion(code); ok {
+		names = append(names, registered.Header)
+	}
+	for _, name := range names {
+		if strings.EqualFold(region.Abbr, name) || strings.EqualFold(region.Id, name) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,115 @@
+package fptcloud
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	common "terraform-provider-fptcloud/commons"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/stretchr/testify/assert"
+)
+
+// newCredentialsServer serves the tenant and regions endpoints for the token "token" and the tenant "tenant"
+func newCredentialsServer(t *testing.T, requests *int32) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(requests, 1)
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"message": "token expired"}`))
+			return
+		}
+		switch r.URL.Path {
+		case "/v2/tenant/tenant":
+			_, _ = w.Write([]byte(`{"status": true, "data": {"id": "tenant-id", "name": "tenant"}}`))
+		case "/v1/vmware/org/tenant-id/list/regions":
+			_, _ = w.Write([]byte(`{"data": [{"id": "region-id", "abbreviation_name": "HAN"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message": "not found"}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newCredentialsClient(t *testing.T, server *httptest.Server, token string, tenantName string, region string) *common.Client {
+	client, err := common.NewClientWithURL(token, server.URL, region, tenantName, 1)
+	assert.NoError(t, err)
+	client.MaxRetries = 0
+	return client
+}
+
+func TestValidateCredentials(t *testing.T) {
+	var requests int32
+	server := newCredentialsServer(t, &requests)
+
+	client := newCredentialsClient(t, server, "token", "tenant", "VN/HAN")
+	assert.Empty(t, validateCredentials(context.Background(), client))
+	assert.Empty(t, validateCredentials(context.Background(), client))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+
+	tests := []struct {
+		name       string
+		token      string
+		tenantName string
+		region     string
+		attribute  string
+	}{
+		{name: "expired token", token: "expired", tenantName: "tenant", region: "VN/HAN", attribute: "token"},
+		{name: "unknown tenant", token: "token", tenantName: "unknown", region: "VN/HAN", attribute: "tenant_name"},
+		{name: "region not enabled", token: "token", tenantName: "tenant", region: "VN/SGN", attribute: "region"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			errs := validateCredentials(context.Background(), newCredentialsClient(t, server, test.token, test.tenantName, test.region))
+			if assert.Len(t, errs, 1) {
+				assert.Equal(t, test.attribute, errs[0].Attribute)
+				assert.Contains(t, errs[0].Detail, "skip_credentials_validation")
+			}
+		})
+	}
+}
+
+func TestValidateCredentials_Unreachable(t *testing.T) {
+	var requests int32
+	server := newCredentialsServer(t, &requests)
+	client := newCredentialsClient(t, server, "token", "tenant", "VN/HAN")
+	server.Close()
+
+	errs := validateCredentials(context.Background(), client)
+	if assert.Len(t, errs, 1) {
+		assert.Equal(t, "api_endpoint", errs[0].Attribute)
+	}
+}
+
+// TestConfigCredentialsValidation tests the attribute scoped error of credentials refused at configure time
+func TestConfigCredentialsValidation(t *testing.T) {
+	t.Setenv("FPTCLOUD_SKIP_CREDENTIALS_VALIDATION", "")
+	var requests int32
+	server := newCredentialsServer(t, &requests)
+	raw := map[string]interface{}{
+		"token":        "expired",
+		"tenant_name":  "tenant",
+		"region":       "VN/HAN",
+		"api_endpoint": server.URL,
+		"max_retries":  0,
+	}
+
+	rawProvider := Provider()
+	diags := rawProvider.Configure(context.Background(), testProviderConfig(rawProvider, raw))
+	if assert.Len(t, diags, 1) {
+		assert.Equal(t, "Invalid token", diags[0].Summary)
+		assert.True(t, diags[0].AttributePath.Equals(cty.GetAttrPath("token")))
+	}
+
+	raw["skip_credentials_validation"] = true
+	rawProvider = Provider()
+	requests = 0
+	diags = rawProvider.Configure(context.Background(), testProviderConfig(rawProvider, raw))
+	assert.False(t, diags.HasError(), diagnosticsToString(diags))
+	assert.Zero(t, atomic.LoadInt32(&requests))
+}
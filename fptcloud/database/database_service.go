@@ -2,6 +2,7 @@ package fptcloud_database
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 	"terraform-provider-fptcloud/commons"
@@ -15,29 +16,29 @@ func newDatabaseApiClient(c *commons.Client) *databaseApiClient {
 	return &databaseApiClient{c}
 }
 
-func (m *databaseApiClient) sendGet(requestURL string) ([]byte, error) {
+func (m *databaseApiClient) sendGet(ctx context.Context, requestURL string) ([]byte, error) {
 	u := m.Client.PrepareClientURL(requestURL)
-	req, err := http.NewRequest("GET", u.String(), nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 	return m.sendRequestWithHeader(req)
 }
 
-func (m *databaseApiClient) sendDelete(requestURL string) ([]byte, error) {
+func (m *databaseApiClient) sendDelete(ctx context.Context, requestURL string) ([]byte, error) {
 	u := m.Client.PrepareClientURL(requestURL)
-	req, err := http.NewRequest("DELETE", u.String(), nil)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", u.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 	return m.sendRequestWithHeader(req)
 }
 
-func (m *databaseApiClient) sendPost(requestURL string, params interface{}) ([]byte, error) {
+func (m *databaseApiClient) sendPost(ctx context.Context, requestURL string, params interface{}) ([]byte, error) {
 	u := m.Client.PrepareClientURL(requestURL)
 	// Create a new buffer and encode everything to json to send it in the request
 	jsonValue, _ := json.Marshal(params)
-	req, err := http.NewRequest("POST", u.String(), bytes.NewBuffer(jsonValue))
+	req, err := http.NewRequestWithContext(ctx, "POST", u.String(), bytes.NewBuffer(jsonValue))
 	if err != nil {
 		return nil, err
 	}
@@ -45,17 +46,5 @@ func (m *databaseApiClient) sendPost(requestURL string, params interface{}) ([]b
 }
 
 func (m *databaseApiClient) sendRequestWithHeader(request *http.Request) ([]byte, error) {
-	switch m.Client.Region {
-	case "VN/HAN":
-		request.Header.Set("fpt-region", "hanoi-vn")
-	case "VN/SGN":
-		request.Header.Set("fpt-region", "saigon-vn")
-	case "VN/HAN2":
-		request.Header.Set("fpt-region", "hanoi-2-vn")
-	case "JP/JCSI2":
-		request.Header.Set("fpt-region", "JP/JCSI2")
-	default:
-		request.Header.Set("fpt-region", m.Client.Region)
-	}
-	return m.Client.SendRequest(request)
+	return m.Client.SendRegionalRequest(request, "")
 }
@@ -5,14 +5,18 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
 	common "terraform-provider-fptcloud/commons"
+	"terraform-provider-fptcloud/commons/waiter"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	diag2 "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -23,9 +27,12 @@ import (
 )
 
 var (
-	_ resource.Resource                = &resourceDatabase{}
-	_ resource.ResourceWithConfigure   = &resourceDatabase{}
-	_ resource.ResourceWithImportState = &resourceDatabase{}
+	_ resource.Resource                   = &resourceDatabase{}
+	_ resource.ResourceWithConfigure      = &resourceDatabase{}
+	_ resource.ResourceWithImportState    = &resourceDatabase{}
+	_ resource.ResourceWithModifyPlan     = &resourceDatabase{}
+	_ resource.ResourceWithUpgradeState   = &resourceDatabase{}
+	_ resource.ResourceWithValidateConfig = &resourceDatabase{}
 
 	forceNewPlanModifiersString = []planmodifier.String{
 		stringplanmodifier.RequiresReplace(),
@@ -34,6 +41,12 @@ var (
 	forceNewPlanModifiersInt = []planmodifier.Int64{
 		int64planmodifier.RequiresReplace(),
 	}
+
+	// vpcIdPlanModifiers keep the vpc_id in state when unconfigured, ModifyPlan then plans the provider vpc_id
+	vpcIdPlanModifiers = []planmodifier.String{
+		stringplanmodifier.UseStateForUnknown(),
+		stringplanmodifier.RequiresReplace(),
+	}
 )
 
 const (
@@ -46,43 +59,44 @@ type resourceDatabase struct {
 }
 
 type databaseResourceModel struct {
-	Id                   types.String `tfsdk:"id" json:"id,omitempty"`
-	VpcId                types.String `tfsdk:"vpc_id" json:"vpc_id"`
-	NetworkId            types.String `tfsdk:"network_id" json:"network_id"`
-	VmNetwork            types.String `tfsdk:"vm_network" json:"vm_network"`
-	TypeConfig           types.String `tfsdk:"type_config" json:"type_config"`
-	TypeDb               types.String `tfsdk:"type_db" json:"type_db"`
-	Version              types.String `tfsdk:"version" json:"version"`
-	VdcName              types.String `tfsdk:"vdc_name" json:"vdc_name"`
-	IsCluster            types.String `tfsdk:"is_cluster" json:"is_cluster"`
-	MasterCount          types.Int64  `tfsdk:"master_count" json:"master_count"`
-	WorkerCount          types.Int64  `tfsdk:"worker_count" json:"worker_count"`
-	NodeCpu              types.Int64  `tfsdk:"node_cpu" json:"node_cpu"`
-	NodeCore             types.Int64  `tfsdk:"node_core" json:"node_core"`
-	NodeRam              types.Int64  `tfsdk:"node_ram" json:"node_ram"`
-	DataDiskSize         types.Int64  `tfsdk:"data_disk_size" json:"data_disk_size"`
-	ClusterName          types.String `tfsdk:"cluster_name" json:"cluster_name"`
-	DatabaseName         types.String `tfsdk:"database_name" json:"database_name"`
-	VhostName            types.String `tfsdk:"vhost_name" json:"vhost_name"`
-	IsPublic             types.String `tfsdk:"is_public" json:"is_public"`
-	AdminPassword        types.String `tfsdk:"admin_password" json:"admin_password"`
-	StorageProfile       types.String `tfsdk:"storage_profile" json:"storage_profile"`
-	EdgeId               types.String `tfsdk:"edge_id" json:"edge_id"`
-	Edition              types.String `tfsdk:"edition" json:"edition"`
-	FlavorId             types.String `tfsdk:"flavor_id" json:"flavor_id"`
-	IsOps                types.String `tfsdk:"is_ops" json:"is_ops"`
-	Flavor               types.String `tfsdk:"flavor" json:"flavor"`
-	NumberOfNode         types.Int64  `tfsdk:"number_of_node" json:"number_of_node"`
-	NumberOfShard        types.Int64  `tfsdk:"number_of_shard" json:"number_of_shard"`
-	DomainName           types.String `tfsdk:"domain_name" json:"domain_name"`
-	MaintenanceEmail     types.String `tfsdk:"maintenance_email"`
-	DayOfWeekMaintenance types.Int64  `tfsdk:"day_of_week_maintenance"`
-	TimeMaintenance      types.String `tfsdk:"time_maintenance"`
-	TagIds               types.String `tfsdk:"tag_ids"`
-	Nodes                types.List   `tfsdk:"nodes"`
-}
-
-var timeout = 1800 * time.Second
+	Id                     types.String   `tfsdk:"id" json:"id,omitempty"`
+	VpcId                  types.String   `tfsdk:"vpc_id" json:"vpc_id"`
+	NetworkId              types.String   `tfsdk:"network_id" json:"network_id"`
+	VmNetwork              types.String   `tfsdk:"vm_network" json:"vm_network"`
+	TypeConfig             types.String   `tfsdk:"type_config" json:"type_config"`
+	TypeDb                 types.String   `tfsdk:"type_db" json:"type_db"`
+	Version                types.String   `tfsdk:"version" json:"version"`
+	VdcName                types.String   `tfsdk:"vdc_name" json:"vdc_name"`
+	IsCluster              types.String   `tfsdk:"is_cluster" json:"is_cluster"`
+	MasterCount            types.Int64    `tfsdk:"master_count" json:"master_count"`
+	WorkerCount            types.Int64    `tfsdk:"worker_count" json:"worker_count"`
+	NodeCpu                types.Int64    `tfsdk:"node_cpu" json:"node_cpu"`
+	NodeCore               types.Int64    `tfsdk:"node_core" json:"node_core"`
+	NodeRam                types.Int64    `tfsdk:"node_ram" json:"node_ram"`
+	DataDiskSize           types.Int64    `tfsdk:"data_disk_size" json:"data_disk_size"`
+	ClusterName            types.String   `tfsdk:"cluster_name" json:"cluster_name"`
+	DatabaseName           types.String   `tfsdk:"database_name" json:"database_name"`
+	VhostName              types.String   `tfsdk:"vhost_name" json:"vhost_name"`
+	IsPublic               types.String   `tfsdk:"is_public" json:"is_public"`
+	AdminPassword          types.String   `tfsdk:"admin_password" json:"admin_password"`
+	AdminPasswordWo        types.String   `tfsdk:"admin_password_wo" json:"-"`
+	AdminPasswordWoVersion types.Int64    `tfsdk:"admin_password_wo_version" json:"-"`
+	StorageProfile         types.String   `tfsdk:"storage_profile" json:"storage_profile"`
+	EdgeId                 types.String   `tfsdk:"edge_id" json:"edge_id"`
+	Edition                types.String   `tfsdk:"edition" json:"edition"`
+	FlavorId               types.String   `tfsdk:"flavor_id" json:"flavor_id"`
+	IsOps                  types.String   `tfsdk:"is_ops" json:"is_ops"`
+	Flavor                 types.String   `tfsdk:"flavor" json:"flavor"`
+	NumberOfNode           types.Int64    `tfsdk:"number_of_node" json:"number_of_node"`
+	NumberOfShard          types.Int64    `tfsdk:"number_of_shard" json:"number_of_shard"`
+	DomainName             types.String   `tfsdk:"domain_name" json:"domain_name"`
+	MaintenanceEmail       types.String   `tfsdk:"maintenance_email"`
+	DayOfWeekMaintenance   types.Int64    `tfsdk:"day_of_week_maintenance"`
+	TimeMaintenance        types.String   `tfsdk:"time_maintenance"`
+	TagIds                 types.Set      `tfsdk:"tag_ids"`
+	Nodes                  types.List     `tfsdk:"nodes"`
+	Timeouts               timeouts.Value `tfsdk:"timeouts"`
+}
 
 func NewResourceDatabase() resource.Resource {
 	return &resourceDatabase{}
@@ -92,27 +106,105 @@ func (r *resourceDatabase) Metadata(ctx context.Context, request resource.Metada
 	response.TypeName = request.ProviderTypeName + "_database"
 }
 
-func (m *databaseApiClient) applyTagToCluster(clusterId string, tagIds string) error {
+// applyTagToCluster applies the tag IDs, merged with the provider default tag IDs, to a cluster
+func (m *databaseApiClient) applyTagToCluster(ctx context.Context, clusterId string, configured []string) error {
+	tagIds := strings.Join(m.Tags.MergeTagIds(configured, nil), ",")
+	if tagIds == "" {
+		return nil
+	}
+
 	body := map[string]interface{}{
 		"cluster_id": clusterId,
 		"tag_ids":    tagIds,
 	}
 
 	path := common.ApiPath.DatabaseApplyTags()
-	_, err := m.sendPost(path, body)
+	_, err := m.sendPost(ctx, path, body)
 	return err
 }
 
+// UpgradeState upgrades the state of version 0, where tag_ids was a comma separated string
+func (r *resourceDatabase) UpgradeState(context.Context) map[int64]resource.StateUpgrader {
+	return common.FrameworkStateUpgraders(upgradeDatabaseStateV0)
+}
+
+// upgradeDatabaseStateV0 turns the comma separated tag_ids of a version 0 state into a set
+func upgradeDatabaseStateV0(_ context.Context, rawState map[string]interface{}) (map[string]interface{}, error) {
+	tagIds, _ := rawState["tag_ids"].(string)
+	var upgraded []interface{}
+	for _, tagId := range strings.Split(tagIds, ",") {
+		if tagId = strings.TrimSpace(tagId); tagId != "" && !slices.Contains(upgraded, interface{}(tagId)) {
+			upgraded = append(upgraded, tagId)
+		}
+	}
+	if len(upgraded) == 0 {
+		rawState["tag_ids"] = nil
+	} else {
+		rawState["tag_ids"] = upgraded
+	}
+	return rawState, nil
+}
+
+// ModifyPlan plans the provider vpc_id when vpc_id is not configured
+func (r *resourceDatabase) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.client.PlanDefaultVpcId(ctx, request, response)
+	if request.State.Raw.IsNull() || request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plannedVersion, priorVersion types.Int64
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("admin_password_wo_version"), &plannedVersion)...)
+	response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root("admin_password_wo_version"), &priorVersion)...)
+	if !priorVersion.IsNull() && !plannedVersion.Equal(priorVersion) {
+		response.Diagnostics.AddAttributeWarning(path.Root("admin_password_wo_version"), "Admin password not reset",
+			"The API cannot reset the admin password of an existing database cluster, the new version is only recorded in the state. "+
+				"Change the admin password in the database to match admin_password_wo.")
+	}
+}
+
+func (r *resourceDatabase) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var adminPassword, adminPasswordWo types.String
+	var adminPasswordWoVersion types.Int64
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("admin_password"), &adminPassword)...)
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("admin_password_wo"), &adminPasswordWo)...)
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("admin_password_wo_version"), &adminPasswordWoVersion)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !adminPasswordWo.IsNull() && adminPasswordWoVersion.IsNull() {
+		response.Diagnostics.AddAttributeError(path.Root("admin_password_wo_version"), "Missing admin_password_wo_version",
+			"admin_password_wo_version must be set along with admin_password_wo")
+	}
+	if adminPassword.IsUnknown() || adminPasswordWo.IsUnknown() {
+		return
+	}
+	if adminPassword.IsNull() == adminPasswordWo.IsNull() {
+		response.Diagnostics.AddAttributeError(path.Root("admin_password"), "Invalid admin password",
+			"exactly one of admin_password or admin_password_wo must be set")
+	}
+}
+
 func (r *resourceDatabase) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
 	// Get current state of the resource
 	var currentState databaseResourceModel
 	diags := request.Plan.Get(ctx, &currentState)
 
 	response.Diagnostics.Append(diags...)
+	// Write-only values are only in the configuration
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("admin_password_wo"), &currentState.AdminPasswordWo)...)
 	if response.Diagnostics.HasError() {
 		return
 	}
 
+	createTimeout, diags := currentState.Timeouts.Create(ctx, r.client.DefaultTimeout())
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	if currentState.MaintenanceEmail.IsNull() ||
 		currentState.DayOfWeekMaintenance.IsNull() ||
 		currentState.TimeMaintenance.IsNull() {
@@ -125,6 +217,7 @@ func (r *resourceDatabase) Create(ctx context.Context, request resource.CreateRe
 
 	f := databaseJson{}
 	r.remap(&currentState, &f)
+	currentState.AdminPasswordWo = types.StringNull()
 	_, err := json.Marshal(f)
 	if err != nil {
 		response.Diagnostics.Append(diag2.NewErrorDiagnostic("Error marshalling JSON", err.Error()))
@@ -133,7 +226,7 @@ func (r *resourceDatabase) Create(ctx context.Context, request resource.CreateRe
 
 	path := common.ApiPath.DatabaseCreate()
 	tflog.Debug(ctx, "Calling path "+path)
-	a, err := r.dataBaseClient.sendPost(path, f)
+	a, err := r.dataBaseClient.sendPost(ctx, path, f)
 	if err != nil {
 		response.Diagnostics.Append(diag2.NewErrorDiagnostic(errorCallingApi, fmt.Sprintf("failed calling path %s: %v", path, err)))
 		return
@@ -174,15 +267,15 @@ func (r *resourceDatabase) Create(ctx context.Context, request resource.CreateRe
 			currentState.Id = types.StringValue("temp-" + strconv.FormatInt(time.Now().Unix(), 10))
 		}
 		// ===== APPLY TAG AFTER CREATE =====
-		if !currentState.TagIds.IsNull() && !currentState.TagIds.IsUnknown() {
-			tagIds := strings.TrimSpace(currentState.TagIds.ValueString())
-			response.Diagnostics.Append(diags...)
+		if !currentState.TagIds.IsUnknown() {
+			var tagIds []string
+			response.Diagnostics.Append(currentState.TagIds.ElementsAs(ctx, &tagIds, false)...)
 			if response.Diagnostics.HasError() {
 				return
 			}
 
 			tflog.Info(ctx, "Applying tags to database cluster after create")
-			err = r.dataBaseClient.applyTagToCluster(
+			err = r.dataBaseClient.applyTagToCluster(ctx,
 				currentState.Id.ValueString(),
 				tagIds,
 			)
@@ -221,24 +314,21 @@ func (r *resourceDatabase) Read(ctx context.Context, request resource.ReadReques
 	originalAdminPassword := state.AdminPassword
 	tflog.Info(ctx, "Original FlavorId: "+originalFlavorId.ValueString())
 
-	var timeStart = time.Now()
-	var timeout = 10 * time.Minute
-	var err2 = errors.New("init error (read)")
+	readTimeout, diags := state.Timeouts.Read(ctx, r.client.DefaultTimeout())
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
 
-	for time.Since(timeStart) < timeout && err2 != nil {
-		err2 = r.internalRead(ctx, state.Id.ValueString(), &state)
-		tflog.Info(ctx, "state_id"+state.Id.ValueString())
-		if err2 != nil {
-			tflog.Info(ctx, "err2: "+err2.Error())
-			time.Sleep(10 * time.Second)
-			continue
-		}
+	err := r.internalRead(ctx, state.Id.ValueString(), &state, readTimeout)
+	if common.IsNotFound(err) {
+		tflog.Warn(ctx, "Database "+state.Id.ValueString()+" not found, removing it from state")
+		response.State.RemoveResource(ctx)
+		return
 	}
-	if err2 != nil && err2.Error() != "init error (read)" {
-		tflog.Info(ctx, "Error reading database currentState2")
-		tflog.Info(ctx, err2.Error())
-		response.Diagnostics.Append(diag2.NewErrorDiagnostic("Error reading database currentState", err2.Error()))
-		response.Diagnostics.Append(diag2.NewErrorDiagnostic(errorCallingApi, err2.Error()))
+	if err != nil {
+		response.Diagnostics.Append(diag2.NewErrorDiagnostic("Error reading database currentState", err.Error()))
+		response.Diagnostics.Append(diag2.NewErrorDiagnostic(errorCallingApi, err.Error()))
 	}
 
 	// If the NumberOfNode field is reset or has no value after calling internalRead, restore it from the old state.
@@ -246,13 +336,13 @@ func (r *resourceDatabase) Read(ctx context.Context, request resource.ReadReques
 		state.FlavorId = originalFlavorId
 		tflog.Info(ctx, "Restored original FlavorId from previous state")
 	}
-	if !originalAdminPassword.IsNull() && !originalAdminPassword.IsUnknown() {
-		if state.AdminPassword.IsNull() ||
-			state.AdminPassword.IsUnknown() ||
-			state.AdminPassword.ValueString() == "********" {
+	// Keep the admin password out of the state when it is write-only
+	if originalAdminPassword.IsNull() ||
+		state.AdminPassword.IsNull() ||
+		state.AdminPassword.IsUnknown() ||
+		state.AdminPassword.ValueString() == "********" {
 
-			state.AdminPassword = originalAdminPassword
-		}
+		state.AdminPassword = originalAdminPassword
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("READING: number of node is %d (%d master, %d worker)", state.NumberOfNode, state.MasterCount, state.WorkerCount))
@@ -273,12 +363,24 @@ func (r *resourceDatabase) Update(ctx context.Context, request resource.UpdateRe
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, r.client.DefaultTimeout())
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Only handle tag_ids update
-	if !plan.TagIds.IsNull() && !plan.TagIds.IsUnknown() {
-		tagIds := strings.TrimSpace(plan.TagIds.ValueString())
+	if !plan.TagIds.IsUnknown() {
+		var tagIds []string
+		response.Diagnostics.Append(plan.TagIds.ElementsAs(ctx, &tagIds, false)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
 
 		tflog.Info(ctx, "Applying tags to existing database cluster")
-		err := r.dataBaseClient.applyTagToCluster(
+		err := r.dataBaseClient.applyTagToCluster(ctx,
 			state.Id.ValueString(),
 			tagIds,
 		)
@@ -310,9 +412,17 @@ func (r *resourceDatabase) Delete(ctx context.Context, request resource.DeleteRe
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, r.client.DefaultTimeout())
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	path := common.ApiPath.DatabaseDelete(state.Id.ValueString())
 	tflog.Debug(ctx, "Calling path "+path)
-	_, err := r.dataBaseClient.sendDelete(path)
+	_, err := r.dataBaseClient.sendDelete(ctx, path)
 	if err != nil {
 		response.Diagnostics.Append(diag2.NewErrorDiagnostic(errorCallingApi, fmt.Sprintf("failed calling path %s: %v", path, err)))
 		return
@@ -322,15 +432,17 @@ func (r *resourceDatabase) Delete(ctx context.Context, request resource.DeleteRe
 func (r *resourceDatabase) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
 	response.Schema = schema.Schema{
 		Description: "Provides a Fpt database cluster which can be used to store data.",
+		Version:     1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:    true,
 				Description: "The Id of the database cluster.",
 			},
 			"vpc_id": schema.StringAttribute{
-				Required:      true,
-				PlanModifiers: forceNewPlanModifiersString,
-				Description:   "The VPC Id of the database cluster.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: vpcIdPlanModifiers,
+				Description:   common.InheritedVpcIdDescription("The VPC Id of the database cluster."),
 			},
 			"network_id": schema.StringAttribute{
 				Required:      true,
@@ -418,9 +530,19 @@ func (r *resourceDatabase) Schema(ctx context.Context, request resource.SchemaRe
 				Description:   "Whether the database is public or not.",
 			},
 			"admin_password": schema.StringAttribute{
-				Required:    true,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The admin password of the database cluster. Exactly one of `admin_password` or `admin_password_wo` must be set.",
+			},
+			"admin_password_wo": schema.StringAttribute{
+				Optional:    true,
+				WriteOnly:   true,
 				Sensitive:   true,
-				Description: "The admin password of the database cluster.",
+				Description: "The admin password of the database cluster, which is never stored in the plan or state. Requires `admin_password_wo_version` and Terraform 1.11 or later.",
+			},
+			"admin_password_wo_version": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The version of `admin_password_wo`. The API cannot reset the admin password of an existing cluster, so changing it is only recorded in the state.",
 			},
 			"storage_profile": schema.StringAttribute{
 				Required:      true,
@@ -479,7 +601,8 @@ func (r *resourceDatabase) Schema(ctx context.Context, request resource.SchemaRe
 				Optional:    true,
 				Description: "Maintenance time (HH:mm)",
 			},
-			"tag_ids": schema.StringAttribute{
+			"tag_ids": schema.SetAttribute{
+				ElementType: types.StringType,
 				Optional:    true,
 				Description: "List of tag IDs applied to the database",
 			},
@@ -513,6 +636,14 @@ func (r *resourceDatabase) Schema(ctx context.Context, request resource.SchemaRe
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -520,7 +651,12 @@ func (r *resourceDatabase) ImportState(ctx context.Context, request resource.Imp
 	var state databaseResourceModel
 
 	state.Id = types.StringValue(request.ID)
-	err := r.internalRead(ctx, request.ID, &state)
+	response.Diagnostics.Append(response.State.GetAttribute(ctx, path.Root("timeouts"), &state.Timeouts)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.internalRead(ctx, request.ID, &state, r.client.DefaultTimeout())
 	if err != nil {
 		response.Diagnostics.Append(diag2.NewErrorDiagnostic("Error calling API in Import State Method", err.Error()))
 		return
@@ -553,59 +689,62 @@ func (r *resourceDatabase) Configure(ctx context.Context, request resource.Confi
 	r.dataBaseClient = newDatabaseApiClient(r.client)
 }
 
-// Get resource data from API, then update to terrafrom state
-func (r *resourceDatabase) internalRead(ctx context.Context, databaseId string, state *databaseResourceModel) error {
+// Get resource data from API, then update to terrafrom state, waiting up to timeout for the nodes to be provisioned
+func (r *resourceDatabase) internalRead(ctx context.Context, databaseId string, state *databaseResourceModel, timeout time.Duration) error {
 	vpcId := state.VpcId.ValueString()
 	tflog.Info(ctx, "Reading state of Database Id "+databaseId+", VPC Id "+vpcId)
 
-	var nodeTotal = 0
-	var timeStart = time.Now()
-	var status = "undefined"
-	var node databaseNode
-	var cluster databaseData
-
-	for nodeTotal == 0 && time.Since(timeStart) < timeout && status != "failed" {
-		tflog.Info(ctx, "Getting database detail from API")
-		path := common.ApiPath.DatabaseGet(databaseId)
-		tflog.Debug(ctx, "Calling path "+path)
-		a, err := r.dataBaseClient.sendGet(path)
-		if err != nil {
-			return fmt.Errorf("failed calling path %s: %v", path, err)
-		}
-		// Convert response to Go struct
-		var d databaseReadResponse
-		err = json.Unmarshal(a, &d)
-		if d.Code == "400" {
-			// Syncing VM information when creating database successfully but not yet ready
-			if d.Message == "'node-role.database.node'" {
-				continue
+	nodesWaiter := &waiter.StateWaiter[databaseReadResponse]{
+		Description: "nodes of database " + databaseId + " to be provisioned",
+		Target:      []string{"provisioned"},
+		Failure:     []string{"failed"},
+		Refresh: func(ctx context.Context) (databaseReadResponse, string, error) {
+			tflog.Info(ctx, "Getting database detail from API")
+			path := common.ApiPath.DatabaseGet(databaseId)
+			tflog.Debug(ctx, "Calling path "+path)
+			a, err := r.dataBaseClient.sendGet(ctx, path)
+			if err != nil {
+				return databaseReadResponse{}, "", fmt.Errorf("failed calling path %s: %w", path, err)
 			}
-			// Sometimes the connection pool gets clogged
-			if strings.HasPrefix(d.Message, "HTTPConnectionPool:") {
-				continue
+			// Convert response to Go struct
+			var d databaseReadResponse
+			err = json.Unmarshal(a, &d)
+			if d.Code == "400" {
+				// Syncing VM information when creating database successfully but not yet ready
+				if d.Message == "'node-role.database.node'" {
+					return d, "syncing", nil
+				}
+				// Sometimes the connection pool gets clogged
+				if strings.HasPrefix(d.Message, "HTTPConnectionPool:") {
+					return d, "syncing", nil
+				}
+			}
+			if err != nil {
+				return d, "", err
 			}
-		}
-
-		cluster = d.Data.Cluster
-		node = d.Data.Node
-		status = cluster.Status
-		if err != nil {
-			return err
-		}
 
-		// Update node_total
-		nodeTotal = int(node.Total)
-		if node.Total == 0 {
-			tflog.Info(ctx, "Waiting for nodes to be provisioned. Time waited: "+strconv.Itoa(int(time.Since(timeStart).Seconds()))+" seconds.")
-			time.Sleep(30 * time.Second)
-		}
+			switch {
+			case d.Data.Cluster.Status == "failed":
+				return d, "failed", nil
+			case d.Data.Node.Total == 0:
+				return d, "provisioning", nil
+			}
+			return d, "provisioned", nil
+		},
+		Timeout:      timeout,
+		MinInterval:  10 * time.Second,
+		ErrorRetries: waiter.UnlimitedRetries,
 	}
 
-	if status == "failed" {
+	d, err := nodesWaiter.Wait(ctx)
+	var failureErr *waiter.FailureStateError
+	if errors.As(err, &failureErr) {
 		return fmt.Errorf("failed to provision nodes for database! Server error")
-	} else if nodeTotal == 0 {
-		return fmt.Errorf("Request time out! Can not provision nodes for database")
+	} else if err != nil {
+		return err
 	} else {
+		cluster := d.Data.Cluster
+		node := d.Data.Node
 		tflog.Info(ctx, "Provisioned nodes for database successfully!")
 		// Update resource status to state
 		state.VpcId = types.StringValue(cluster.VpcId)
@@ -748,6 +887,9 @@ func (r *resourceDatabase) remap(from *databaseResourceModel, to *databaseJson)
 	to.VhostName = from.VhostName.ValueString()
 	to.IsPublic = from.IsPublic.ValueString()
 	to.AdminPassword = from.AdminPassword.ValueString()
+	if !from.AdminPasswordWo.IsNull() {
+		to.AdminPassword = from.AdminPasswordWo.ValueString()
+	}
 	to.StorageProfile = from.StorageProfile.ValueString()
 	to.EdgeId = from.EdgeId.ValueString()
 	to.Edition = from.Edition.ValueString()
@@ -3,15 +3,18 @@ package fptcloud_database
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	diag2 "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"strconv"
+	"slices"
+	"strings"
 	common "terraform-provider-fptcloud/commons"
+	"terraform-provider-fptcloud/commons/waiter"
 	"time"
 )
 
@@ -45,39 +48,37 @@ func (r *resourceDatabaseStatus) Create(ctx context.Context, request resource.Cr
 		return
 	}
 
+	createTimeout, diags := currentState.Timeouts.Create(ctx, r.client.DefaultTimeout())
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	// Convert currentState to JSON
 	var database databaseStatusJson
 	r.remap(&currentState, &database)
 
-	// Getting current status of database on the server
-	var timeStart = time.Now()
-	var timeout = 120 * time.Second
-	var err error = errors.New("init error (create)")
-	var status = ""
-
-	for time.Since(timeStart) < timeout && err != nil {
-		tflog.Info(ctx, "Retrying.... ")
-		status, err = r.getDatabaseCurrentStatus(ctx, database.Id)
-	}
+	// Getting current status of database on the server, retrying the errors as the database may have just been created
+	status, err := r.waitForDatabaseStatus(ctx, database.Id, createTimeout, 4, "running", "stopped", "failed")
 
 	if err != nil {
 		response.Diagnostics.Append(diag2.NewErrorDiagnostic("Can't find matching database", err.Error()))
 		return
 	}
 	if status == "failed" {
-		response.Diagnostics.Append(diag2.NewErrorDiagnostic("Database failed", err.Error()))
+		response.Diagnostics.Append(diag2.NewErrorDiagnostic("Database failed", "Database "+database.Id+" is in the failed status"))
 		return
 	}
 
 	// Nếu database đang running và khách hàng cần stopped
 	if status == "running" && database.Status == "stopped" {
-		err = r.stopDatabase(ctx, database.Id)
+		err = r.stopDatabase(ctx, database.Id, createTimeout)
 		if err != nil {
 			response.Diagnostics.Append(diag2.NewErrorDiagnostic("Can't stop database", err.Error()))
 			return
 		}
 	} else if status == "stopped" && database.Status == "running" {
-		err = r.startDatabase(ctx, database.Id)
+		err = r.startDatabase(ctx, database.Id, createTimeout)
 		if err != nil {
 			response.Diagnostics.Append(diag2.NewErrorDiagnostic("Can't start database", err.Error()))
 			return
@@ -104,15 +105,25 @@ func (r *resourceDatabaseStatus) Read(ctx context.Context, request resource.Read
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, r.client.DefaultTimeout())
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	// Get current status of database
 	var err error
-	status, err := r.getDatabaseCurrentStatus(ctx, state.Id.ValueString())
+	status, err := r.getDatabaseCurrentStatus(ctx, state.Id.ValueString(), readTimeout)
 
-	if err != nil {
+	if common.IsNotFound(err) {
+		tflog.Warn(ctx, "Database "+state.Id.ValueString()+" not found, removing its status from state")
+		response.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
 		response.Diagnostics.Append(diag2.NewErrorDiagnostic("Can't find matching database", err.Error()))
 		return
 	} else if status == "failed" {
-		response.Diagnostics.Append(diag2.NewErrorDiagnostic("Database failed", err.Error()))
+		response.Diagnostics.Append(diag2.NewErrorDiagnostic("Database failed", "Database "+state.Id.ValueString()+" is in the failed status"))
 		return
 	}
 
@@ -154,6 +165,12 @@ func (r *resourceDatabaseStatus) Schema(ctx context.Context, request resource.Sc
 				Description:   "The status of the database cluster, must be 'running' or 'stopped'.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+			}),
+		},
 	}
 }
 
@@ -161,7 +178,12 @@ func (r *resourceDatabaseStatus) ImportState(ctx context.Context, request resour
 	var state databaseStatusResourceModel
 
 	state.Id = types.StringValue(request.ID)
-	err := r.internalRead(ctx, request.ID, &state)
+	response.Diagnostics.Append(response.State.GetAttribute(ctx, path.Root("timeouts"), &state.Timeouts)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.internalRead(ctx, request.ID, &state, r.client.DefaultTimeout())
 	if err != nil {
 		response.Diagnostics.Append(diag2.NewErrorDiagnostic("Error calling API in Import State Method", err.Error()))
 		return
@@ -193,167 +215,119 @@ func (r *resourceDatabaseStatus) Configure(ctx context.Context, request resource
 	r.databaseClient = newDatabaseApiClient(r.client)
 }
 
-// Get current status of database (running, stopped, failed)
-func (r *resourceDatabaseStatus) getDatabaseCurrentStatus(ctx context.Context, databaseId string) (string, error) {
-	status := ""
-	var cluster databaseData
-
-	// Get database detail from API by database Id
-	path := common.ApiPath.DatabaseGet(databaseId)
-
-	a, err := r.databaseClient.sendGet(path)
-	if err != nil {
-		return status, err
-	}
-
-	// Convert response to Go struct
-	var d databaseReadResponse
-	err = json.Unmarshal(a, &d)
-	if err != nil {
-		return status, err
-	}
-	if d.Code != "200" {
-		return status, fmt.Errorf("Database not found")
-	}
-	cluster = d.Data.Cluster
-
-	// Wait for database to be provisioned
-	timeStart := time.Now()
-	for cluster.Status != "running" && cluster.Status != "stopped" && cluster.Status != "failed" && time.Since(timeStart) < timeout {
-		path = common.ApiPath.DatabaseGet(databaseId)
-
-		a, err = r.databaseClient.sendGet(path)
-		if err != nil {
-			return status, err
-		}
-		err = json.Unmarshal(a, &d)
-		if d.Code != "200" {
-			return status, fmt.Errorf("Database not found")
-		}
-		if err != nil {
-			return "", err
-		}
-		cluster = d.Data.Cluster
+// Get current status of database (running, stopped, failed), waiting up to timeout for it to be provisioned
+func (r *resourceDatabaseStatus) getDatabaseCurrentStatus(ctx context.Context, databaseId string, timeout time.Duration) (string, error) {
+	return r.waitForDatabaseStatus(ctx, databaseId, timeout, 0, "running", "stopped", "failed")
+}
 
-		tflog.Info(ctx, "Waiting for database to be provisioned. Time waited: "+time.Since(timeStart).String())
-		time.Sleep(30 * time.Second)
+// Wait up to timeout for the database to reach one of the target statuses, retrying errorRetries refresh errors
+func (r *resourceDatabaseStatus) waitForDatabaseStatus(ctx context.Context, databaseId string, timeout time.Duration, errorRetries int, target ...string) (string, error) {
+	failure := []string{"failed"}
+	if slices.Contains(target, "failed") {
+		failure = nil
 	}
 
-	if cluster.Status == "running" || cluster.Status == "stopped" || cluster.Status == "failed" {
-		status = cluster.Status
-	} else {
-		return "not found", fmt.Errorf("Request time out! Can not provision database")
+	statusWaiter := &waiter.StateWaiter[string]{
+		Description: "database " + databaseId + " to be " + strings.Join(target, " or "),
+		Target:      target,
+		Failure:     failure,
+		Refresh: func(ctx context.Context) (string, string, error) {
+			// Get database detail from API by database Id
+			a, err := r.databaseClient.sendGet(ctx, common.ApiPath.DatabaseGet(databaseId))
+			if err != nil {
+				return "", "", err
+			}
+
+			// Convert response to Go struct
+			var d databaseReadResponse
+			if err = json.Unmarshal(a, &d); err != nil {
+				return "", "", err
+			}
+			if d.Code != "200" {
+				return "", "", fmt.Errorf("Database not found")
+			}
+			return d.Data.Cluster.Status, d.Data.Cluster.Status, nil
+		},
+		Timeout:      timeout,
+		MinInterval:  30 * time.Second,
+		MaxInterval:  60 * time.Second,
+		ErrorRetries: errorRetries,
 	}
 
-	return status, nil
+	return statusWaiter.Wait(ctx)
 }
 
 // Stop a running database
-func (r *resourceDatabaseStatus) stopDatabase(ctx context.Context, databaseId string) error {
+func (r *resourceDatabaseStatus) stopDatabase(ctx context.Context, databaseId string, timeout time.Duration) error {
 	body := map[string]string{
 		"cluster_id": databaseId,
 	}
 
 	path := common.ApiPath.DatabaseStop()
-	_, err := r.databaseClient.sendPost(path, body)
+	_, err := r.databaseClient.sendPost(ctx, path, body)
 	if err != nil {
 		tflog.Error(ctx, "Error stopping database: "+err.Error())
 		return err
 	}
 
-	timeStart := time.Now()
-	for time.Since(timeStart) < timeout {
-		status, err := r.getDatabaseCurrentStatus(ctx, databaseId)
-		if err != nil {
-			return err
-		}
-		if status == "stopped" {
-			return nil
-		}
-
-		tflog.Info(ctx, "Waiting for nodes to be stopped. Time waited: "+time.Since(timeStart).String())
-		time.Sleep(60 * time.Second)
-	}
-
-	return fmt.Errorf("Request time out! Can not stop database")
+	_, err = r.waitForDatabaseStatus(ctx, databaseId, timeout, 0, "stopped")
+	return err
 }
 
 // Start a stopped database
-func (r *resourceDatabaseStatus) startDatabase(ctx context.Context, databaseId string) error {
+func (r *resourceDatabaseStatus) startDatabase(ctx context.Context, databaseId string, timeout time.Duration) error {
 	body := map[string]string{
 		"cluster_id": databaseId,
 	}
 
 	path := common.ApiPath.DatabaseStart()
-	_, err := r.databaseClient.sendPost(path, body)
+	_, err := r.databaseClient.sendPost(ctx, path, body)
 	if err != nil {
 		return err
 	}
 
-	timeStart := time.Now()
-	for time.Since(timeStart) < timeout {
-		status, err := r.getDatabaseCurrentStatus(ctx, databaseId)
-		if err != nil {
-			return err
-		}
-		if status == "running" {
-			return nil
-		}
-		tflog.Info(ctx, "Waiting for nodes to be provisioned. Time waited: "+time.Since(timeStart).String())
-		time.Sleep(60 * time.Second)
-	}
-
-	return fmt.Errorf("Request time out! Can not start database")
+	_, err = r.waitForDatabaseStatus(ctx, databaseId, timeout, 0, "running")
+	return err
 }
 
-// Get resource data from API, then update to terraform state
-func (r *resourceDatabaseStatus) internalRead(ctx context.Context, databaseId string, state *databaseStatusResourceModel) error {
+// Get resource data from API, then update to terraform state, waiting up to timeout for the nodes to be provisioned
+func (r *resourceDatabaseStatus) internalRead(ctx context.Context, databaseId string, state *databaseStatusResourceModel, timeout time.Duration) error {
 	tflog.Info(ctx, "Reading state of Database Id "+databaseId+", VPC Id ")
 
-	var nodeTotal = 0
-	var timeStart = time.Now()
-	var node databaseNode
-	var cluster databaseData
-
-	for nodeTotal == 0 && time.Since(timeStart) < timeout {
-		// Get database detail from API by database Id
-		timeout = 120 * time.Second
-		var err = errors.New("init error")
-		var a []byte
-
-		for time.Since(timeStart) < timeout && err != nil {
-			tflog.Info(ctx, "Retrying.... ")
-			a, err = r.client.SendGetRequest(fmt.Sprintf("xplat/database/management/cluster/detail/%s", databaseId))
-		}
-		if err != nil {
-			return err
-		}
-
-		// Convert response to Go struct
-		var d databaseReadResponse
-		err = json.Unmarshal(a, &d)
-		cluster = d.Data.Cluster
-		node = d.Data.Node
-		if err != nil {
-			return err
-		}
-
-		// Update node_total
-		nodeTotal = int(node.Total)
-		if node.Total == 0 {
-			tflog.Info(ctx, "Waiting for nodes to be provisioned. Time waited: "+strconv.Itoa(int(time.Since(timeStart).Seconds()))+" seconds.")
-			time.Sleep(30 * time.Second)
-		}
+	nodesWaiter := &waiter.StateWaiter[databaseData]{
+		Description: "nodes of database " + databaseId + " to be provisioned",
+		Target:      []string{"provisioned"},
+		Refresh: func(ctx context.Context) (databaseData, string, error) {
+			// Get database detail from API by database Id
+			a, err := r.client.SendGetRequestWithContext(ctx, fmt.Sprintf("xplat/database/management/cluster/detail/%s", databaseId))
+			if err != nil {
+				return databaseData{}, "", err
+			}
+
+			// Convert response to Go struct
+			var d databaseReadResponse
+			if err = json.Unmarshal(a, &d); err != nil {
+				return databaseData{}, "", err
+			}
+			if d.Data.Node.Total == 0 {
+				return d.Data.Cluster, "provisioning", nil
+			}
+			return d.Data.Cluster, "provisioned", nil
+		},
+		Timeout:      timeout,
+		MinInterval:  30 * time.Second,
+		ErrorRetries: 4,
 	}
 
-	if nodeTotal == 0 {
-		return fmt.Errorf("Request time out! Can not provision nodes for database")
-	} else {
-		// Update resource status to state
-		state.Id = types.StringValue(cluster.VpcId)
-		state.Status = types.StringValue(cluster.Status)
+	cluster, err := nodesWaiter.Wait(ctx)
+	if err != nil {
+		return err
 	}
 
+	// Update resource status to state
+	state.Id = types.StringValue(cluster.VpcId)
+	state.Status = types.StringValue(cluster.Status)
+
 	return nil
 }
 
@@ -371,6 +345,7 @@ type databaseStatusJson struct {
 
 // The database status managed in terraform
 type databaseStatusResourceModel struct {
-	Id     types.String `tfsdk:"id" json:"id"`
-	Status types.String `tfsdk:"status" json:"status"`
+	Id       types.String   `tfsdk:"id" json:"id"`
+	Status   types.String   `tfsdk:"status" json:"status"`
+	Timeouts timeouts.Value `tfsdk:"timeouts" json:"-"`
 }
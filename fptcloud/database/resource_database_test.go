@@ -0,0 +1,162 @@
+package fptcloud_database
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+// databaseStateV0 is the state of a database written by the provider before tag_ids became a set
+const databaseStateV0 = `{
+	"id": "cluster-id",
+	"vpc_id": "vpc-id",
+	"network_id": "network-id",
+	"vm_network": "vm-network",
+	"type_config": "short-config",
+	"type_db": "postgres",
+	"version": "16",
+	"vdc_name": "vdc",
+	"is_cluster": "no",
+	"master_count": 1,
+	"worker_count": 0,
+	"node_cpu": 2,
+	"node_core": 1,
+	"node_ram": 4,
+	"data_disk_size": 40,
+	"cluster_name": "db",
+	"database_name": "app",
+	"vhost_name": "",
+	"is_public": "no",
+	"admin_password": "password",
+	"storage_profile": "Premium-SSD",
+	"edge_id": "edge-id",
+	"edition": "community",
+	"flavor_id": "flavor-id",
+	"is_ops": "false",
+	"flavor": "2C4G",
+	"number_of_node": 1,
+	"number_of_shard": 0,
+	"domain_name": "",
+	"maintenance_email": "ops@example.com",
+	"day_of_week_maintenance": 0,
+	"time_maintenance": "02:00",
+	"tag_ids": %s,
+	"nodes": [],
+	"timeouts": null
+}`
+
+// upgradeDatabaseState upgrades a version 0 state with the given JSON tag_ids and returns the upgraded tag_ids
+func upgradeDatabaseState(t *testing.T, tagIds string) tftypes.Value {
+	r := &resourceDatabase{}
+	schemaResponse := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResponse)
+	assert.Equal(t, int64(1), schemaResponse.Schema.Version)
+
+	request := resource.UpgradeStateRequest{
+		RawState: &tfprotov6.RawState{JSON: []byte(strings.Replace(databaseStateV0, "%s", tagIds, 1))},
+	}
+	response := &resource.UpgradeStateResponse{}
+	r.UpgradeState(context.Background())[0].StateUpgrader(context.Background(), request, response)
+	if !assert.False(t, response.Diagnostics.HasError(), response.Diagnostics) || !assert.NotNil(t, response.DynamicValue) {
+		return tftypes.Value{}
+	}
+
+	stateType := schemaResponse.Schema.Type().TerraformType(context.Background())
+	upgraded, err := response.DynamicValue.Unmarshal(stateType)
+	if !assert.NoError(t, err) {
+		return tftypes.Value{}
+	}
+	var attributes map[string]tftypes.Value
+	assert.NoError(t, upgraded.As(&attributes))
+	assert.True(t, attributes["master_count"].Equal(tftypes.NewValue(tftypes.Number, 1)))
+	return attributes["tag_ids"]
+}
+
+func TestResourceDatabase_UpgradeStateV0(t *testing.T) {
+	tagIdsType := tftypes.Set{ElementType: tftypes.String}
+
+	tagIds := upgradeDatabaseState(t, `"tag-a, tag-b,,tag-a"`)
+	assert.True(t, tagIds.Equal(tftypes.NewValue(tagIdsType, []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "tag-a"),
+		tftypes.NewValue(tftypes.String, "tag-b"),
+	})), tagIds.String())
+
+	for _, unset := range []string{`null`, `""`, `" , "`} {
+		tagIds = upgradeDatabaseState(t, unset)
+		assert.True(t, tagIds.IsNull(), unset)
+	}
+}
+
+// databaseValue returns a database object of the resource schema with the given attributes, the others being null
+func databaseValue(t *testing.T, attributes map[string]tftypes.Value) (schema.Schema, tftypes.Value) {
+	schemaResponse := &resource.SchemaResponse{}
+	(&resourceDatabase{}).Schema(context.Background(), resource.SchemaRequest{}, schemaResponse)
+	objectType := schemaResponse.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+		if value, ok := attributes[name]; ok {
+			values[name] = value
+		}
+	}
+	return schemaResponse.Schema, tftypes.NewValue(objectType, values)
+}
+
+func TestResourceDatabase_ValidateConfigAdminPassword(t *testing.T) {
+	validate := func(attributes map[string]tftypes.Value) diag.Diagnostics {
+		databaseSchema, config := databaseValue(t, attributes)
+		response := &resource.ValidateConfigResponse{}
+		(&resourceDatabase{}).ValidateConfig(context.Background(), resource.ValidateConfigRequest{
+			Config: tfsdk.Config{Schema: databaseSchema, Raw: config},
+		}, response)
+		return response.Diagnostics
+	}
+	password := tftypes.NewValue(tftypes.String, "password")
+	version := tftypes.NewValue(tftypes.Number, 1)
+
+	assert.False(t, validate(map[string]tftypes.Value{"admin_password": password}).HasError())
+	assert.False(t, validate(map[string]tftypes.Value{"admin_password_wo": password, "admin_password_wo_version": version}).HasError())
+
+	diags := validate(map[string]tftypes.Value{"admin_password_wo": password})
+	assert.Equal(t, "Missing admin_password_wo_version", diags.Errors()[0].Summary())
+	diags = validate(map[string]tftypes.Value{})
+	assert.Equal(t, "Invalid admin password", diags.Errors()[0].Summary())
+	diags = validate(map[string]tftypes.Value{"admin_password": password, "admin_password_wo": password, "admin_password_wo_version": version})
+	assert.Equal(t, "Invalid admin password", diags.Errors()[0].Summary())
+}
+
+func TestResourceDatabase_ModifyPlanWarnsOfAdminPasswordRotation(t *testing.T) {
+	modifyPlan := func(prior tftypes.Value, planned tftypes.Value) diag.Diagnostics {
+		databaseSchema, state := databaseValue(t, map[string]tftypes.Value{
+			"vpc_id":                    tftypes.NewValue(tftypes.String, "vpc-id"),
+			"admin_password_wo_version": prior,
+		})
+		_, plan := databaseValue(t, map[string]tftypes.Value{
+			"vpc_id":                    tftypes.NewValue(tftypes.String, "vpc-id"),
+			"admin_password_wo_version": planned,
+		})
+		response := &resource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: databaseSchema, Raw: plan}}
+		(&resourceDatabase{}).ModifyPlan(context.Background(), resource.ModifyPlanRequest{
+			State:  tfsdk.State{Schema: databaseSchema, Raw: state},
+			Plan:   tfsdk.Plan{Schema: databaseSchema, Raw: plan},
+			Config: tfsdk.Config{Schema: databaseSchema, Raw: plan},
+		}, response)
+		assert.False(t, response.Diagnostics.HasError(), response.Diagnostics)
+		return response.Diagnostics
+	}
+	first := tftypes.NewValue(tftypes.Number, 1)
+	second := tftypes.NewValue(tftypes.Number, 2)
+
+	assert.Equal(t, "Admin password not reset", modifyPlan(first, second).Warnings()[0].Summary())
+	assert.Empty(t, modifyPlan(first, first).Warnings())
+	assert.Empty(t, modifyPlan(tftypes.NewValue(tftypes.Number, nil), first).Warnings())
+}
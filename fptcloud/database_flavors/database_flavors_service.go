@@ -1,6 +1,7 @@
 package fptcloud_database_flavors
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -23,7 +24,7 @@ type DatabaseFlavorResponse struct {
 }
 
 type DatabaseFlavorService interface {
-	ListDatabaseFlavor(vpcId string, isOps string) (*[]DatabaseFlavor, error)
+	ListDatabaseFlavor(ctx context.Context, vpcId string, isOps string) (*[]DatabaseFlavor, error)
 }
 
 type DatabaseFlavorServiceImpl struct {
@@ -40,7 +41,7 @@ func NewDatabaseFlavorService(client *common.Client) DatabaseFlavorService {
 	}
 }
 
-func (s *DatabaseFlavorServiceImpl) ListDatabaseFlavor(vpcId string, isOps string) (*[]DatabaseFlavor, error) {
+func (s *DatabaseFlavorServiceImpl) ListDatabaseFlavor(ctx context.Context, vpcId string, isOps string) (*[]DatabaseFlavor, error) {
 	if s == nil {
 		return nil, fmt.Errorf("database flavor service is nil")
 	}
@@ -55,31 +56,15 @@ func (s *DatabaseFlavorServiceImpl) ListDatabaseFlavor(vpcId string, isOps strin
 		return nil, fmt.Errorf("is_ops cannot be empty")
 	}
 
-	fmt.Printf("[DEBUG] Calling ListDatabaseFlavor with vpc_id: %s, is_ops: %s\n", vpcId, isOps)
-
 	apiPath := common.ApiPath.DatabaseFlavor(vpcId, isOps)
-	fmt.Printf("[DEBUG] API Path: %s\n", apiPath)
 
 	u := s.client.PrepareClientURL(apiPath)
-	req, err := http.NewRequest("GET", u.String(), nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %v", err)
 	}
 
-	switch s.client.Region {
-	case "VN/HAN":
-		req.Header.Set("fpt-region", "hanoi-vn")
-	case "VN/SGN":
-		req.Header.Set("fpt-region", "saigon-vn")
-	case "VN/HAN2":
-		req.Header.Set("fpt-region", "hanoi-2-vn")
-	case "JP/JCSI2":
-		req.Header.Set("fpt-region", "JP/JCSI2")
-	default:
-		req.Header.Set("fpt-region", s.client.Region)
-	}
-
-	resp, err := s.client.SendRequest(req)
+	resp, err := s.client.SendRegionalRequest(req, "")
 	if err != nil {
 		return nil, fmt.Errorf("failed to send request: %v", err)
 	}
@@ -88,8 +73,6 @@ func (s *DatabaseFlavorServiceImpl) ListDatabaseFlavor(vpcId string, isOps strin
 		return nil, fmt.Errorf("empty response from API")
 	}
 
-	fmt.Printf("[DEBUG] Raw API response: %s\n", string(resp))
-
 	var responseModel DatabaseFlavorResponse
 	err = json.Unmarshal(resp, &responseModel)
 	if err != nil {
@@ -100,7 +83,5 @@ func (s *DatabaseFlavorServiceImpl) ListDatabaseFlavor(vpcId string, isOps strin
 		return nil, fmt.Errorf("[ERR] Failed to retrieve database flavors: %s", responseModel.Message)
 	}
 
-	fmt.Printf("[DEBUG] Found %d database flavors\n", len(responseModel.Data))
-
 	return &responseModel.Data, nil
 }
@@ -1,6 +1,7 @@
 package fptcloud_database_flavors
 
 import (
+	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -85,7 +86,7 @@ func flattenDatabaseFlavor(flavor, _ interface{}, _ map[string]interface{}) (map
 	return flattened, nil
 }
 
-func getDatabaseFlavors(m interface{}, extra map[string]interface{}) ([]interface{}, error) {
+func getDatabaseFlavors(ctx context.Context, m interface{}, extra map[string]interface{}) ([]interface{}, error) {
 	if m == nil {
 		return nil, fmt.Errorf("[ERR] provider configuration is nil")
 	}
@@ -109,7 +110,7 @@ func getDatabaseFlavors(m interface{}, extra map[string]interface{}) ([]interfac
 		return nil, fmt.Errorf("[ERR] is_ops parameter is required")
 	}
 
-	flavors, err := databaseFlavorService.ListDatabaseFlavor(vpcId, isOps)
+	flavors, err := databaseFlavorService.ListDatabaseFlavor(ctx, vpcId, isOps)
 	if err != nil {
 		return nil, fmt.Errorf("[ERR] Failed to retrieve database flavors: %s", err)
 	}
@@ -67,8 +67,9 @@ func (d *datasourceDedicatedKubernetesEngine) Schema(ctx context.Context, reques
 				Description: "UUID of the cluster",
 			},
 			"vpc_id": schema.StringAttribute{
-				Required:    true,
-				Description: "VPC ID",
+				Optional:    true,
+				Computed:    true,
+				Description: commons.InheritedVpcIdDescription("VPC ID"),
 			},
 			"cluster_id": schema.StringAttribute{
 				Required:    true,
@@ -167,6 +168,9 @@ func (d *datasourceDedicatedKubernetesEngine) Schema(ctx context.Context, reques
 				Description: "Region ID",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": DataSourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -179,6 +183,19 @@ func (d *datasourceDedicatedKubernetesEngine) Read(ctx context.Context, request
 		return
 	}
 
+	state.VpcId = d.client.DefaultVpcIdValue(state.VpcId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, d.client.DefaultTimeout())
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	clusterId := state.ClusterId.ValueString()
 	uuid, err := d.findClusterUUID(ctx, state.vpcId(), clusterId)
 	if err != nil {
@@ -203,7 +220,7 @@ func (d *datasourceDedicatedKubernetesEngine) internalRead(ctx context.Context,
 	vpcId := state.VpcId.ValueString()
 	tflog.Info(ctx, "Reading state of cluster ID "+clusterId+", VPC ID "+vpcId)
 
-	a, err := d.client.SendGetRequest(commons.ApiPath.DedicatedFKEGet(vpcId, clusterId))
+	a, err := d.client.SendGetRequestWithContext(ctx, commons.ApiPath.DedicatedFKEGet(vpcId, clusterId))
 
 	if err != nil {
 		return nil, err
@@ -267,49 +284,39 @@ func (d *datasourceDedicatedKubernetesEngine) internalRead(ctx context.Context,
 	return &readResponse, nil
 }
 
-func (d *datasourceDedicatedKubernetesEngine) findClusterUUID(_ context.Context, vpcId string, clusterId string) (string, error) {
-	total := 1
-	found := 0
-
-	index := 1
-	for found < total {
-		path := commons.ApiPath.DedicatedFKEList(vpcId, index, 25)
-		data, err := d.client.SendGetRequest(path)
+func (d *datasourceDedicatedKubernetesEngine) findClusterUUID(ctx context.Context, vpcId string, clusterId string) (string, error) {
+	clusters, err := commons.ListAll(ctx, 25, func(ctx context.Context, page int, pageSize int) ([]dedicatedKubernetesEngineListEntry, int, error) {
+		data, err := d.client.SendGetRequestWithContext(ctx, commons.ApiPath.DedicatedFKEList(vpcId, page, pageSize))
 		if err != nil {
-			return "", err
+			return nil, 0, err
 		}
 
 		var list dedicatedKubernetesEngineList
-		err = json.Unmarshal(data, &list)
-		if err != nil {
-			return "", err
-		}
-
-		if list.Total == 0 {
-			return "", errors.New(noSuchClusterId)
-		}
-
-		if len(list.Data) == 0 {
-			return "", errors.New(noSuchClusterId)
+		if err := json.Unmarshal(data, &list); err != nil {
+			return nil, 0, err
 		}
+		return list.Data, list.Total, nil
+	})
+	if err != nil {
+		return "", err
+	}
 
-		total = list.Total
-		index += 1
-		for _, entry := range list.Data {
-			if entry.ClusterId == clusterId {
-				return entry.Id, nil
-			}
+	for _, entry := range clusters {
+		if entry.ClusterId == clusterId {
+			return entry.Id, nil
 		}
 	}
 
-	return "", errors.New("no cluster with such ID found")
+	return "", errors.New(noSuchClusterId)
 }
 
 type dedicatedKubernetesEngineList struct {
-	Data []struct {
-		ClusterName string `json:"cluster_name"`
-		ClusterId   string `json:"cluster_id,omitempty"`
-		Id          string `json:"id,omitempty"`
-	} `json:"data"`
-	Total int `json:"total"`
+	Data  []dedicatedKubernetesEngineListEntry `json:"data"`
+	Total int                                  `json:"total"`
+}
+
+type dedicatedKubernetesEngineListEntry struct {
+	ClusterName string `json:"cluster_name"`
+	ClusterId   string `json:"cluster_id,omitempty"`
+	Id          string `json:"id,omitempty"`
 }
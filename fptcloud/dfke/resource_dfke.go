@@ -5,7 +5,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	diag2 "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -15,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"strings"
 	"terraform-provider-fptcloud/commons"
+	"terraform-provider-fptcloud/commons/waiter"
 	fptcloud_vpc "terraform-provider-fptcloud/fptcloud/vpc"
 	"time"
 )
@@ -23,6 +26,7 @@ var (
 	_ resource.Resource                = &resourceDedicatedKubernetesEngine{}
 	_ resource.ResourceWithConfigure   = &resourceDedicatedKubernetesEngine{}
 	_ resource.ResourceWithImportState = &resourceDedicatedKubernetesEngine{}
+	_ resource.ResourceWithModifyPlan  = &resourceDedicatedKubernetesEngine{}
 
 	forceNewPlanModifiersString = []planmodifier.String{
 		stringplanmodifier.RequiresReplace(),
@@ -31,6 +35,12 @@ var (
 	forceNewPlanModifiersInt = []planmodifier.Int64{
 		int64planmodifier.RequiresReplace(),
 	}
+
+	// vpcIdPlanModifiers keep the vpc_id in state when unconfigured, ModifyPlan then plans the provider vpc_id
+	vpcIdPlanModifiers = []planmodifier.String{
+		stringplanmodifier.UseStateForUnknown(),
+		stringplanmodifier.RequiresReplace(),
+	}
 )
 
 const (
@@ -53,6 +63,14 @@ func (r *resourceDedicatedKubernetesEngine) Create(ctx context.Context, request
 		return
 	}
 
+	createTimeout, diags := state.Timeouts.Create(ctx, r.client.DefaultTimeout())
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	var f dedicatedKubernetesEngineJson
 	r.remap(&state, &f)
 
@@ -62,7 +80,7 @@ func (r *resourceDedicatedKubernetesEngine) Create(ctx context.Context, request
 	f.UpstreamDNS = ""
 
 	client := r.client
-	a, err := client.SendPostRequest(fmt.Sprintf("/v1/xplat/fke/vpc/%s/kubernetes", state.vpcId()), f)
+	a, err := client.SendPostRequestWithContext(ctx, fmt.Sprintf("/v1/xplat/fke/vpc/%s/kubernetes", state.vpcId()), f)
 
 	if err != nil {
 		response.Diagnostics.Append(diag2.NewErrorDiagnostic("Error calling API", err.Error()))
@@ -81,13 +99,11 @@ func (r *resourceDedicatedKubernetesEngine) Create(ctx context.Context, request
 		return
 	}
 
-	tflog.Info(ctx, "create response was "+string(a))
-
 	tflog.Info(ctx, "Created cluster with id "+createResponse.Cluster.ID)
 
 	state.Id = types.StringValue(createResponse.Cluster.ID)
 
-	if err = r.waitForSucceeded(ctx, &state, 30*time.Minute, true); err != nil {
+	if err = r.waitForSucceeded(ctx, &state, createTimeout, true); err != nil {
 		response.Diagnostics.Append(diag2.NewErrorDiagnostic("Error waiting cluster up", err.Error()))
 		return
 	}
@@ -112,8 +128,20 @@ func (r *resourceDedicatedKubernetesEngine) Read(ctx context.Context, request re
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, r.client.DefaultTimeout())
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	_, err := r.internalRead(ctx, state.Id.ValueString(), &state)
-	if err != nil {
+	if commons.IsNotFound(err) {
+		tflog.Warn(ctx, "Cluster "+state.Id.ValueString()+" not found, removing it from state")
+		response.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
 		response.Diagnostics.Append(diag2.NewErrorDiagnostic(errorCallingApi, err.Error()))
 		return
 	}
@@ -149,7 +177,15 @@ func (r *resourceDedicatedKubernetesEngine) Update(ctx context.Context, request
 	//	return
 	//}
 
-	errDiag := r.diff(ctx, &state, &plan)
+	updateTimeout, diags := plan.Timeouts.Update(ctx, r.client.DefaultTimeout())
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	errDiag := r.diff(ctx, &state, &plan, updateTimeout)
 	if errDiag != nil {
 		response.Diagnostics.Append(errDiag)
 		return
@@ -177,13 +213,26 @@ func (r *resourceDedicatedKubernetesEngine) Delete(ctx context.Context, request
 		return
 	}
 
-	_, err := r.client.SendDeleteRequest(fmt.Sprintf("/v1/xplat/fke/vpc/%s/cluster/%s/delete", state.vpcId(), state.Id))
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, r.client.DefaultTimeout())
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	_, err := r.client.SendDeleteRequestWithContext(ctx, fmt.Sprintf("/v1/xplat/fke/vpc/%s/cluster/%s/delete", state.vpcId(), state.Id))
 	if err != nil {
 		response.Diagnostics.Append(diag2.NewErrorDiagnostic(errorCallingApi, err.Error()))
 		return
 	}
 }
 
+// ModifyPlan plans the provider vpc_id when vpc_id is not configured
+func (r *resourceDedicatedKubernetesEngine) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.client.PlanDefaultVpcId(ctx, request, response)
+}
+
 func (r *resourceDedicatedKubernetesEngine) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	tflog.Info(ctx, "Importing DFKE cluster ID "+request.ID)
 
@@ -203,6 +252,11 @@ func (r *resourceDedicatedKubernetesEngine) ImportState(ctx context.Context, req
 	state.VpcId = types.StringValue(vpcId)
 
 	state.Id = types.StringValue(clusterId)
+	response.Diagnostics.Append(response.State.GetAttribute(ctx, path.Root("timeouts"), &state.Timeouts)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	_, err := r.internalRead(ctx, clusterId, &state)
 	if err != nil {
 		response.Diagnostics.Append(diag2.NewErrorDiagnostic(errorCallingApi, err.Error()))
@@ -348,9 +402,10 @@ func (r *resourceDedicatedKubernetesEngine) Schema(ctx context.Context, request
 				Description:   "IP private firewall",
 			},
 			"vpc_id": schema.StringAttribute{
-				Required:      true,
-				PlanModifiers: forceNewPlanModifiersString,
-				Description:   "VPC ID",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: vpcIdPlanModifiers,
+				Description:   commons.InheritedVpcIdDescription("VPC ID"),
 			},
 			"region_id": schema.StringAttribute{
 				Required:      true,
@@ -358,6 +413,14 @@ func (r *resourceDedicatedKubernetesEngine) Schema(ctx context.Context, request
 				Description:   "Region ID",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -387,7 +450,7 @@ func (r *resourceDedicatedKubernetesEngine) internalRead(ctx context.Context, cl
 	vpcId := state.VpcId.ValueString()
 	tflog.Info(ctx, "Reading state of cluster ID "+clusterId+", VPC ID "+vpcId)
 
-	a, err := r.client.SendGetRequest(commons.ApiPath.DedicatedFKEGet(vpcId, clusterId))
+	a, err := r.client.SendGetRequestWithContext(ctx, commons.ApiPath.DedicatedFKEGet(vpcId, clusterId))
 
 	if err != nil {
 		return nil, err
@@ -514,12 +577,12 @@ func (r *resourceDedicatedKubernetesEngine) remap(from *dedicatedKubernetesEngin
 	to.RegionId = from.RegionId.ValueString()
 }
 
-func (r *resourceDedicatedKubernetesEngine) diff(ctx context.Context, from *dedicatedKubernetesEngine, to *dedicatedKubernetesEngine) *diag2.ErrorDiagnostic {
+func (r *resourceDedicatedKubernetesEngine) diff(ctx context.Context, from *dedicatedKubernetesEngine, to *dedicatedKubernetesEngine, timeout time.Duration) *diag2.ErrorDiagnostic {
 	master := from.MasterDiskSize.ValueInt64()
 	master2 := to.MasterDiskSize.ValueInt64()
 	// status: EXTENDING
 
-	if err := r.diskExtend(ctx, from, to, "master", master, master2); err != nil {
+	if err := r.diskExtend(ctx, from, to, "master", master, master2, timeout); err != nil {
 		return err
 	}
 
@@ -527,19 +590,19 @@ func (r *resourceDedicatedKubernetesEngine) diff(ctx context.Context, from *dedi
 	worker2 := to.WorkerDiskSize.ValueInt64()
 	// status: EXTENDING
 
-	if err := r.diskExtend(ctx, from, to, "worker", worker, worker2); err != nil {
+	if err := r.diskExtend(ctx, from, to, "worker", worker, worker2, timeout); err != nil {
 		return err
 	}
 
 	masterType := from.MasterType.ValueString()
 	master2Type := to.MasterType.ValueString()
-	if err := r.changeFlavor(ctx, from, to, "master", masterType, master2Type); err != nil {
+	if err := r.changeFlavor(ctx, from, to, "master", masterType, master2Type, timeout); err != nil {
 		return err
 	}
 
 	workerType := from.WorkerType.ValueString()
 	worker2Type := to.WorkerType.ValueString()
-	if err := r.changeFlavor(ctx, from, to, "worker", workerType, worker2Type); err != nil {
+	if err := r.changeFlavor(ctx, from, to, "worker", workerType, worker2Type, timeout); err != nil {
 		return err
 	}
 
@@ -556,7 +619,7 @@ func (r *resourceDedicatedKubernetesEngine) diff(ctx context.Context, from *dedi
 			ActionScale: "update",
 		}
 
-		if err := r.manage(from, autoScale, true); err != nil {
+		if err := r.manage(ctx, from, autoScale, true); err != nil {
 			return err
 		}
 
@@ -565,21 +628,21 @@ func (r *resourceDedicatedKubernetesEngine) diff(ctx context.Context, from *dedi
 			to.ScaleMin.ValueInt64(), to.ScaleMax.ValueInt64(),
 		))
 
-		err := r.waitForSucceeded(ctx, from, 5*time.Minute, false)
+		err := r.waitForSucceeded(ctx, from, timeout, false)
 		if err != nil {
 			d := diag2.NewErrorDiagnostic("Error waiting for cluster after updating autoscale to return to SUCCEEDED state", err.Error())
 			return &d
 		}
 	}
 
-	if err := r.upgradeVersion(ctx, from, to); err != nil {
+	if err := r.upgradeVersion(ctx, from, to, timeout); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (r *resourceDedicatedKubernetesEngine) diskExtend(ctx context.Context, from *dedicatedKubernetesEngine, to *dedicatedKubernetesEngine, node string, fromCount, toCount int64) *diag2.ErrorDiagnostic {
+func (r *resourceDedicatedKubernetesEngine) diskExtend(ctx context.Context, from *dedicatedKubernetesEngine, to *dedicatedKubernetesEngine, node string, fromCount, toCount int64, timeout time.Duration) *diag2.ErrorDiagnostic {
 	if fromCount == toCount {
 		return nil
 	}
@@ -590,7 +653,10 @@ func (r *resourceDedicatedKubernetesEngine) diskExtend(ctx context.Context, from
 	}
 
 	tflog.Info(ctx, fmt.Sprintf("Resizing %s from %d to %d", node, fromCount, toCount))
-	time.Sleep(5 * time.Second)
+	if err := r.waitForSucceeded(ctx, from, timeout, false); err != nil {
+		d := diag2.NewErrorDiagnostic(fmt.Sprintf("Error waiting for cluster to be SUCCEEDED before resizing %s disk", node), err.Error())
+		return &d
+	}
 
 	management := dedicatedKubernetesEngineManagement{
 		ClusterId:  to.clusterUUID(),
@@ -601,12 +667,12 @@ func (r *resourceDedicatedKubernetesEngine) diskExtend(ctx context.Context, from
 		NodeType:   "",
 	}
 
-	if err := r.manage(from, management, false); err != nil {
+	if err := r.manage(ctx, from, management, false); err != nil {
 		return err
 	}
 	tflog.Info(ctx, fmt.Sprintf("Resized disk %s from %d to %d", node, fromCount, toCount))
 
-	err := r.waitForSucceeded(ctx, from, 5*time.Minute, false)
+	err := r.waitForSucceeded(ctx, from, timeout, false)
 	if err != nil {
 		d := diag2.NewErrorDiagnostic(
 			fmt.Sprintf("Error waiting for cluster after resizing %s disk to return to SUCCEEDED state", node),
@@ -618,7 +684,7 @@ func (r *resourceDedicatedKubernetesEngine) diskExtend(ctx context.Context, from
 	return nil
 }
 
-func (r *resourceDedicatedKubernetesEngine) upgradeVersion(ctx context.Context, from *dedicatedKubernetesEngine, to *dedicatedKubernetesEngine) *diag2.ErrorDiagnostic {
+func (r *resourceDedicatedKubernetesEngine) upgradeVersion(ctx context.Context, from *dedicatedKubernetesEngine, to *dedicatedKubernetesEngine, timeout time.Duration) *diag2.ErrorDiagnostic {
 	if from.Version.ValueString() != to.Version.ValueString() {
 		//	version changed, call bump version
 		path := commons.ApiPath.DedicatedFKEUpgradeVersion(from.vpcId(), from.Id.ValueString())
@@ -634,7 +700,7 @@ func (r *resourceDedicatedKubernetesEngine) upgradeVersion(ctx context.Context,
 
 		tflog.Info(ctx, fmt.Sprintf("Bumping version to %s", to.Version))
 
-		a, err2 := r.client.SendPostRequest(path, change)
+		a, err2 := r.client.SendPostRequestWithContext(ctx, path, change)
 		if err2 != nil {
 			d := diag2.NewErrorDiagnostic("Error calling upgrade version API", err2.Error())
 			return &d
@@ -644,7 +710,7 @@ func (r *resourceDedicatedKubernetesEngine) upgradeVersion(ctx context.Context,
 			return diagErr2
 		}
 
-		err := r.waitForSucceeded(ctx, from, 1*time.Hour, false)
+		err := r.waitForSucceeded(ctx, from, timeout, false)
 		if err != nil {
 			d := diag2.NewErrorDiagnostic("Error waiting for cluster after upgrading to return to SUCCEEDED state", err.Error())
 			return &d
@@ -654,7 +720,7 @@ func (r *resourceDedicatedKubernetesEngine) upgradeVersion(ctx context.Context,
 	return nil
 }
 
-func (r *resourceDedicatedKubernetesEngine) changeFlavor(ctx context.Context, from *dedicatedKubernetesEngine, _ *dedicatedKubernetesEngine, node string, fromFlavor, toFlavor string) *diag2.ErrorDiagnostic {
+func (r *resourceDedicatedKubernetesEngine) changeFlavor(ctx context.Context, from *dedicatedKubernetesEngine, _ *dedicatedKubernetesEngine, node string, fromFlavor, toFlavor string, timeout time.Duration) *diag2.ErrorDiagnostic {
 
 	if fromFlavor != toFlavor {
 		tflog.Info(ctx, fmt.Sprintf("Changing %s from %s to %s", node, fromFlavor, toFlavor))
@@ -668,12 +734,12 @@ func (r *resourceDedicatedKubernetesEngine) changeFlavor(ctx context.Context, fr
 			NodeType:   node,
 		}
 
-		if err := r.manage(from, management, false); err != nil {
+		if err := r.manage(ctx, from, management, false); err != nil {
 			return err
 		}
 		tflog.Info(ctx, fmt.Sprintf("Changed %s from %s to %s", node, fromFlavor, toFlavor))
 
-		err := r.waitForSucceeded(ctx, from, 20*time.Minute, false)
+		err := r.waitForSucceeded(ctx, from, timeout, false)
 		if err != nil {
 			d := diag2.NewErrorDiagnostic(fmt.Sprintf("Error waiting for cluster after changing %s type to return to SUCCEEDED state", node), err.Error())
 			return &d
@@ -683,13 +749,13 @@ func (r *resourceDedicatedKubernetesEngine) changeFlavor(ctx context.Context, fr
 	return nil
 }
 
-func (r *resourceDedicatedKubernetesEngine) manage(state *dedicatedKubernetesEngine, params interface{}, isAutoScale bool) *diag2.ErrorDiagnostic {
+func (r *resourceDedicatedKubernetesEngine) manage(ctx context.Context, state *dedicatedKubernetesEngine, params interface{}, isAutoScale bool) *diag2.ErrorDiagnostic {
 	path := commons.ApiPath.DedicatedFKEManagement(state.vpcId(), state.clusterUUID())
 	if isAutoScale {
 		path = fmt.Sprintf("/v1/xplat/fke/vpc/%s/cluster/%s/auto-scale", state.vpcId(), state.clusterUUID())
 	}
 
-	a, err2 := r.client.SendPostRequest(path, params)
+	a, err2 := r.client.SendPostRequestWithContext(ctx, path, params)
 	if err2 != nil {
 		d := diag2.NewErrorDiagnostic("Error calling autoscale API", err2.Error())
 		return &d
@@ -702,67 +768,39 @@ func (r *resourceDedicatedKubernetesEngine) manage(state *dedicatedKubernetesEng
 	return nil
 }
 
+// waitForSucceeded waits for the cluster to return to the SUCCEEDED state. The errors reading the cluster are retried
+// until the timeout when ignoreError is set, as the cluster is not readable for a while after its creation.
 func (r *resourceDedicatedKubernetesEngine) waitForSucceeded(ctx context.Context, state *dedicatedKubernetesEngine, timeout time.Duration, ignoreError bool) error {
 	clusterId := state.clusterUUID()
-	durationText := fmt.Sprintf("%v", timeout)
-	tflog.Info(ctx, "Waiting for cluster "+clusterId+" to succeed, duration "+durationText)
-
-	ticker := time.NewTicker(5 * time.Second)
-	defer ticker.Stop()
-	to := time.NewTimer(timeout)
-	defer to.Stop()
-
-	for {
-		select {
-		case <-to.C:
-			return errors.New("Timed out waiting for cluster " + clusterId + " to return to success state")
-		case <-ticker.C:
-			{
-				var e error
-				tflog.Info(ctx, "Checking status of cluster "+clusterId)
-
-				localTimeout := 200 * time.Millisecond
-
-				for i := 0; i < 5; i++ {
-					status, err := r.internalRead(ctx, clusterId, &dedicatedKubernetesEngine{
-						ClusterId: state.ClusterId,
-						VpcId:     state.VpcId,
-					})
-					e = err
-
-					if err != nil {
-						time.Sleep(localTimeout)
-						localTimeout *= 2
-						localTimeout = min(localTimeout, 30*time.Second)
-
-						continue
-					}
-
-					state := status.Cluster.Status
-					tflog.Info(ctx, "Status of cluster "+clusterId+" is currently "+state)
-					switch state {
-					case "SUCCEEDED":
-						{
-							return nil
-						}
-					case "ERROR":
-						{
-							return errors.New("cluster in error state")
-						}
-
-					case "STOPPED":
-						{
-							return errors.New("cluster is stopped")
-						}
-					}
-
-				}
-				if e != nil && !ignoreError {
-					return e
-				}
+
+	errorRetries, notFoundChecks := 4, 0
+	if ignoreError {
+		errorRetries, notFoundChecks = waiter.UnlimitedRetries, 20
+	}
+
+	succeededWaiter := &waiter.StateWaiter[*dedicatedKubernetesEngineReadResponse]{
+		Description: "cluster " + clusterId + " to succeed",
+		Target:      []string{"SUCCEEDED"},
+		Failure:     []string{"ERROR", "STOPPED"},
+		Refresh: func(ctx context.Context) (*dedicatedKubernetesEngineReadResponse, string, error) {
+			status, err := r.internalRead(ctx, clusterId, &dedicatedKubernetesEngine{
+				ClusterId: state.ClusterId,
+				VpcId:     state.VpcId,
+			})
+			if err != nil {
+				return nil, "", err
 			}
-		}
+			return status, status.Cluster.Status, nil
+		},
+		Timeout:        timeout,
+		Delay:          5 * time.Second,
+		MinInterval:    5 * time.Second,
+		NotFoundChecks: notFoundChecks,
+		ErrorRetries:   errorRetries,
 	}
+
+	_, err := succeededWaiter.Wait(ctx)
+	return err
 }
 
 func (e *dedicatedKubernetesEngine) vpcId() string {
@@ -843,6 +881,8 @@ type dedicatedKubernetesEngine struct {
 	IPPrivateFirewall types.String `tfsdk:"ip_private_firewall" json:"ip_private_firewall"`
 	VpcId             types.String `tfsdk:"vpc_id" json:"vpc_id"`
 	RegionId          types.String `tfsdk:"region_id"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 type dedicatedKubernetesEngineJson struct {
@@ -19,6 +19,7 @@ var (
 	_ resource.Resource                = &resourceDedicatedKubernetesEngineState{}
 	_ resource.ResourceWithConfigure   = &resourceDedicatedKubernetesEngineState{}
 	_ resource.ResourceWithImportState = &resourceDedicatedKubernetesEngineState{}
+	_ resource.ResourceWithModifyPlan  = &resourceDedicatedKubernetesEngineState{}
 )
 
 type resourceDedicatedKubernetesEngineState struct {
@@ -29,6 +30,11 @@ func NewResourceDedicatedKubernetesEngineState() resource.Resource {
 	return &resourceDedicatedKubernetesEngineState{}
 }
 
+// ModifyPlan plans the provider vpc_id when vpc_id is not configured
+func (r *resourceDedicatedKubernetesEngineState) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.client.PlanDefaultVpcId(ctx, request, response)
+}
+
 func (r *resourceDedicatedKubernetesEngineState) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	tflog.Info(ctx, "Importing state for DFKE cluster ID "+request.ID)
 
@@ -66,8 +72,10 @@ func (r *resourceDedicatedKubernetesEngineState) Schema(ctx context.Context, req
 				PlanModifiers: forceNewPlanModifiersString,
 			},
 			"vpc_id": schema.StringAttribute{
-				Required:      true,
-				PlanModifiers: forceNewPlanModifiersString,
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: vpcIdPlanModifiers,
+				Description:   commons.InheritedVpcIdDescription(""),
 			},
 			"is_running": schema.BoolAttribute{
 				Required: true,
@@ -109,7 +117,11 @@ func (r *resourceDedicatedKubernetesEngineState) Read(ctx context.Context, reque
 		return
 	}
 
-	if err := r.internalRead(ctx, state.Id.ValueString(), &state); err != nil {
+	if err := r.internalRead(ctx, state.Id.ValueString(), &state); commons.IsNotFound(err) {
+		tflog.Warn(ctx, "Cluster "+state.Id.ValueString()+" not found, removing its state from state")
+		response.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
 		response.Diagnostics.Append(diag2.NewErrorDiagnostic("Error reading cluster state", err.Error()))
 		return
 	}
@@ -141,7 +153,7 @@ func (r *resourceDedicatedKubernetesEngineState) Update(ctx context.Context, req
 
 	path := commons.ApiPath.DedicatedFKEManagement(state.VpcId.ValueString(), state.Id.ValueString())
 
-	a, err2 := r.client.SendPostRequest(path, management)
+	a, err2 := r.client.SendPostRequestWithContext(ctx, path, management)
 	if err2 != nil {
 		d := diag2.NewErrorDiagnostic("Error calling management API", err2.Error())
 		response.Diagnostics.Append(d)
@@ -180,7 +192,7 @@ func (r *resourceDedicatedKubernetesEngineState) internalRead(ctx context.Contex
 	vpcId := state.VpcId.ValueString()
 	tflog.Info(ctx, "Reading state of cluster ID "+clusterId+", VPC ID "+vpcId)
 
-	a, err := r.client.SendGetRequest(commons.ApiPath.DedicatedFKEGet(vpcId, clusterId))
+	a, err := r.client.SendGetRequestWithContext(ctx, commons.ApiPath.DedicatedFKEGet(vpcId, clusterId))
 
 	if err != nil {
 		return err
@@ -22,7 +22,7 @@ func (t *TenancyApiClient) GetTenancy(ctx context.Context) (*EnabledTenants, err
 	tflog.Info(ctx, "Getting enabled tenants")
 
 	path := "/v1/vmware/user/tenants/enabled"
-	res, err := t.SendGetRequest(path)
+	res, err := t.SendCachedGetRequestWithContext(ctx, path)
 	if err != nil {
 		return nil, err
 	}
@@ -38,7 +38,7 @@ func (t *TenancyApiClient) GetTenancy(ctx context.Context) (*EnabledTenants, err
 func (t *TenancyApiClient) GetRegions(ctx context.Context, tenantId string) ([]Region, error) {
 	tflog.Info(ctx, "Getting regions under tenant "+tenantId)
 	path := fmt.Sprintf("/v1/vmware/org/%s/list/regions", tenantId)
-	res, err := t.SendGetRequest(path)
+	res, err := t.SendGetRequestWithContext(ctx, path)
 	if err != nil {
 		return nil, err
 	}
@@ -55,7 +55,7 @@ func (t *TenancyApiClient) ListVpcs(ctx context.Context, tenantId string, userId
 	tflog.Info(ctx, "Getting regions under tenant "+tenantId+", user "+userId+", region "+region)
 
 	path := fmt.Sprintf("/v1/vmware/org/%s/user/%s/list/vpc?regionId=%s", tenantId, userId, region)
-	res, err := t.SendGetRequest(path)
+	res, err := t.SendGetRequestWithContext(ctx, path)
 
 	if err != nil {
 		return nil, err
@@ -79,7 +79,7 @@ func (t *TenancyApiClient) GetVpcPlatform(ctx context.Context, vpcId string) (st
 	path := fmt.Sprintf("/v1/vmware/vpc/%s/user/%s/vpc_user", vpcId, tenants.UserId)
 
 	tflog.Info(ctx, "Getting platform for VPC "+vpcId)
-	res, err := t.SendGetRequest(path)
+	res, err := t.SendCachedGetRequestWithContext(ctx, path)
 	if err != nil {
 		return "", err
 	}
@@ -0,0 +1,26 @@
+package fptcloud_dfke
+
+import (
+	"context"
+
+	datasourceTimeouts "github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DataSourceTimeoutsBlock returns the timeouts block with a read timeout of a data source sharing its model with a
+// resource. Its value has the type of the resource timeouts block, so that the timeouts field of the shared model
+// decodes from both schemas.
+func DataSourceTimeoutsBlock(ctx context.Context) schema.Block {
+	block := datasourceTimeouts.Block(ctx).(schema.SingleNestedBlock)
+	block.CustomType = timeouts.Type{
+		ObjectType: types.ObjectType{
+			AttrTypes: map[string]attr.Type{
+				"read": types.StringType,
+			},
+		},
+	}
+	return block
+}
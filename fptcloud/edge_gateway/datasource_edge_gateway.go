@@ -45,8 +45,9 @@ func (d *datasourceEdgeGateway) Schema(ctx context.Context, request datasource.S
 				Description: "Edge gateway id",
 			},
 			"vpc_id": schema.StringAttribute{
-				Required:    true,
-				Description: "VPC id",
+				Optional:    true,
+				Computed:    true,
+				Description: common.InheritedVpcIdDescription("VPC id"),
 			},
 		},
 	}
@@ -60,6 +61,11 @@ func (d *datasourceEdgeGateway) Read(ctx context.Context, request datasource.Rea
 		return
 	}
 
+	state.VpcId = d.client.DefaultVpcIdValue(state.VpcId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	edgeGatewayList, err := d.internalRead(ctx, &state)
 	if err != nil {
 		response.Diagnostics.Append(diag2.NewErrorDiagnostic("Error getting edge_gateway list", err.Error()))
@@ -112,10 +118,10 @@ func (d *datasourceEdgeGateway) Configure(ctx context.Context, request datasourc
 	d.client = client
 }
 
-func (d *datasourceEdgeGateway) internalRead(_ context.Context, state *edge_gateway) (*[]EdgeGatewayData, error) {
+func (d *datasourceEdgeGateway) internalRead(ctx context.Context, state *edge_gateway) (*[]EdgeGatewayData, error) {
 	vpcId := state.VpcId.ValueString()
 
-	res, err := d.client.SendGetRequest(common.ApiPath.EdgeGatewayList(vpcId))
+	res, err := d.client.SendGetRequestWithContext(ctx, common.ApiPath.EdgeGatewayList(vpcId))
 
 	if err != nil {
 		return nil, err
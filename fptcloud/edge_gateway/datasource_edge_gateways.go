@@ -35,8 +35,9 @@ func (d *datasourceEdgeGateways) Schema(ctx context.Context, request datasource.
 		Description: "Retrieves a list of FPT Cloud edge gateways. If name is provided, returns only edge gateways matching that name.",
 		Attributes: map[string]schema.Attribute{
 			"vpc_id": schema.StringAttribute{
-				Required:    true,
-				Description: "VPC id to filter edge gateways",
+				Optional:    true,
+				Computed:    true,
+				Description: common.InheritedVpcIdDescription("VPC id to filter edge gateways"),
 			},
 			"name": schema.StringAttribute{
 				Optional:    true,
@@ -66,6 +67,11 @@ func (d *datasourceEdgeGateways) Read(ctx context.Context, request datasource.Re
 		return
 	}
 
+	state.VpcId = d.client.DefaultVpcIdValue(state.VpcId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	edgeGatewayList, err := d.fetchEdgeGateways(ctx, state.VpcId.ValueString())
 	if err != nil {
 		response.Diagnostics.Append(diag2.NewErrorDiagnostic("Error getting edge gateway list", err.Error()))
@@ -115,8 +121,8 @@ func (d *datasourceEdgeGateways) Configure(ctx context.Context, request datasour
 	d.client = client
 }
 
-func (d *datasourceEdgeGateways) fetchEdgeGateways(_ context.Context, vpcId string) (*[]EdgeGatewayData, error) {
-	res, err := d.client.SendGetRequest(common.ApiPath.EdgeGatewayList(vpcId))
+func (d *datasourceEdgeGateways) fetchEdgeGateways(ctx context.Context, vpcId string) (*[]EdgeGatewayData, error) {
+	res, err := d.client.SendGetRequestWithContext(ctx, common.ApiPath.EdgeGatewayList(vpcId))
 	if err != nil {
 		return nil, err
 	}
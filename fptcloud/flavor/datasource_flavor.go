@@ -1,6 +1,7 @@
 package fptcloud_flavor
 
 import (
+	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -81,7 +82,7 @@ func flattenFlavor(flavor, _ interface{}, _ map[string]interface{}) (map[string]
 	return flattened, nil
 }
 
-func getFlavors(m interface{}, extra map[string]interface{}) ([]interface{}, error) {
+func getFlavors(ctx context.Context, m interface{}, extra map[string]interface{}) ([]interface{}, error) {
 	apiClient := m.(*common.Client)
 	flavorService := NewFlavorService(apiClient)
 
@@ -90,7 +91,7 @@ func getFlavors(m interface{}, extra map[string]interface{}) ([]interface{}, err
 		return nil, fmt.Errorf("[ERR] vpc id is required")
 	}
 
-	flavors, err := flavorService.ListFlavor(vpcId)
+	flavors, err := flavorService.ListFlavor(ctx, vpcId)
 	if err != nil {
 		return nil, fmt.Errorf("[ERR] Failed to retrieve flavors: %s", err)
 	}
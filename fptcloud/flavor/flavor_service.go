@@ -1,6 +1,7 @@
 package fptcloud_flavor
 
 import (
+	"context"
 	"encoding/json"
 	common "terraform-provider-fptcloud/commons"
 )
@@ -30,7 +31,7 @@ type FlavorResponse struct {
 
 // FlavorService defines the interface for flavor service
 type FlavorService interface {
-	ListFlavor(vpcId string) (*[]Flavor, error)
+	ListFlavor(ctx context.Context, vpcId string) (*[]Flavor, error)
 }
 
 // FlavorServiceImpl is the implementation of FlavorService
@@ -44,9 +45,9 @@ func NewFlavorService(client *common.Client) FlavorService {
 }
 
 // ListFlavor get list flavor
-func (s *FlavorServiceImpl) ListFlavor(vpcId string) (*[]Flavor, error) {
+func (s *FlavorServiceImpl) ListFlavor(ctx context.Context, vpcId string) (*[]Flavor, error) {
 	var apiPath = common.ApiPath.Flavor(vpcId)
-	resp, err := s.client.SendGetRequest(apiPath)
+	resp, err := s.client.SendCachedGetRequestWithContext(ctx, apiPath)
 	if err != nil {
 		return nil, common.DecodeError(err)
 	}
@@ -1,6 +1,7 @@
 package fptcloud_flavor_test
 
 import (
+	"context"
 	"terraform-provider-fptcloud/fptcloud/flavor"
 	"testing"
 
@@ -15,7 +16,7 @@ func TestListFlavor_ReturnsFlavors(t *testing.T) {
 	})
 	defer server.Close()
 	service := fptcloud_flavor.NewFlavorService(mockClient)
-	flavors, err := service.ListFlavor("vpc_id")
+	flavors, err := service.ListFlavor(context.Background(), "vpc_id")
 	assert.NoError(t, err)
 	assert.NotNil(t, flavors)
 	assert.Equal(t, 1, len(*flavors))
@@ -34,7 +35,7 @@ func TestListFlavor_ReturnsEmptyListOnEmptyResponse(t *testing.T) {
 	})
 	defer server.Close()
 	service := fptcloud_flavor.NewFlavorService(mockClient)
-	flavors, err := service.ListFlavor("vpc_id")
+	flavors, err := service.ListFlavor(context.Background(), "vpc_id")
 	assert.NoError(t, err)
 	assert.NotNil(t, flavors)
 	assert.Equal(t, 0, len(*flavors))
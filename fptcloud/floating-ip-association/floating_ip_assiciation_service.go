@@ -1,6 +1,7 @@
 package fptcloud_floating_ip_association
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	common "terraform-provider-fptcloud/commons"
@@ -45,9 +46,9 @@ type FloatingIpInstance struct {
 
 // FloatingIpAssociationService defines the interface for floating ip association service
 type FloatingIpAssociationService interface {
-	FindFloatingIp(findDto FindFloatingIpDTO) (*FloatingIp, error)
-	Associate(associateData AssociateFloatingIpDTO) (*bool, error)
-	Disassociate(vpcId string, floatingIpId string) (bool, error)
+	FindFloatingIp(ctx context.Context, findDto FindFloatingIpDTO) (*FloatingIp, error)
+	Associate(ctx context.Context, associateData AssociateFloatingIpDTO) (*bool, error)
+	Disassociate(ctx context.Context, vpcId string, floatingIpId string) (bool, error)
 }
 
 // FloatingIpAssociationServiceImpl is the implementation of FloatingIpAssociationServiceImpl
@@ -60,9 +61,9 @@ func NewFloatingIpAssociationService(client *common.Client) FloatingIpAssociatio
 	return &FloatingIpAssociationServiceImpl{client: client}
 }
 
-func (s *FloatingIpAssociationServiceImpl) FindFloatingIp(findDto FindFloatingIpDTO) (*FloatingIp, error) {
+func (s *FloatingIpAssociationServiceImpl) FindFloatingIp(ctx context.Context, findDto FindFloatingIpDTO) (*FloatingIp, error) {
 	var apiPath = common.ApiPath.FindFloatingIp(findDto.VpcId, findDto.FloatingIpID)
-	resp, err := s.client.SendGetRequest(apiPath)
+	resp, err := s.client.SendGetRequestWithContext(ctx, apiPath)
 	if err != nil {
 		return nil, common.DecodeError(err)
 	}
@@ -80,9 +81,9 @@ func (s *FloatingIpAssociationServiceImpl) FindFloatingIp(findDto FindFloatingIp
 }
 
 // Associate add floating ip to instance
-func (s *FloatingIpAssociationServiceImpl) Associate(associateData AssociateFloatingIpDTO) (*bool, error) {
+func (s *FloatingIpAssociationServiceImpl) Associate(ctx context.Context, associateData AssociateFloatingIpDTO) (*bool, error) {
 	var apiPath = common.ApiPath.AssociateFloatingIp(associateData.VpcId)
-	resp, err := s.client.SendPostRequest(apiPath, associateData)
+	resp, err := s.client.SendPostRequestWithContext(ctx, apiPath, associateData)
 	if err != nil {
 		return nil, common.DecodeError(err)
 	}
@@ -100,9 +101,9 @@ func (s *FloatingIpAssociationServiceImpl) Associate(associateData AssociateFloa
 }
 
 // Disassociate remove instance from floating ip
-func (s *FloatingIpAssociationServiceImpl) Disassociate(vpcId string, floatingIpId string) (bool, error) {
+func (s *FloatingIpAssociationServiceImpl) Disassociate(ctx context.Context, vpcId string, floatingIpId string) (bool, error) {
 	var apiPath = common.ApiPath.DisassociateFloatingIp(vpcId, floatingIpId)
-	_, err := s.client.SendPostRequest(apiPath, nil)
+	_, err := s.client.SendPostRequestWithContext(ctx, apiPath, nil)
 	if err != nil {
 		return false, common.DecodeError(err)
 	}
@@ -2,12 +2,13 @@ package fptcloud_floating_ip_association
 
 import (
 	"context"
+	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
 	common "terraform-provider-fptcloud/commons"
+	"terraform-provider-fptcloud/commons/waiter"
 	"time"
 )
 
@@ -85,7 +86,7 @@ func resourceFloatingIpAssociationCreate(ctx context.Context, d *schema.Resource
 		createModel.InstancePort = instancePort.(int)
 	}
 
-	result, err := service.Associate(createModel)
+	result, err := service.Associate(ctx, createModel)
 	if err != nil || result == nil {
 		return diag.Errorf("[ERR] Failed to associate floating ip: %s", err)
 	}
@@ -105,26 +106,25 @@ func resourceFloatingIpAssociationCreate(ctx context.Context, d *schema.Resource
 	}
 
 	//Waiting for status active
-	createStateConf := &retry.StateChangeConf{
-		Pending: []string{"IN_ACTIVE", "PENDING"},
-		Target:  []string{"ACTIVE"},
-		Refresh: func() (interface{}, string, error) {
+	associateWaiter := &waiter.StateWaiter[*FloatingIp]{
+		Description: fmt.Sprintf("floating ip %s to be associated", createModel.FloatingIpId),
+		Pending:     []string{"IN_ACTIVE", "PENDING"},
+		Target:      []string{"ACTIVE"},
+		Refresh: func(ctx context.Context) (*FloatingIp, string, error) {
 			findModel := FindFloatingIpDTO{
 				FloatingIpID: createModel.FloatingIpId,
 				VpcId:        vpcId.(string),
 			}
-			resp, err := service.FindFloatingIp(findModel)
+			resp, err := service.FindFloatingIp(ctx, findModel)
 			if err != nil {
-				return 0, "", common.DecodeError(err)
+				return nil, "", err
 			}
 			return resp, resp.Status, nil
 		},
-		Timeout:        time.Duration(apiClient.Timeout) * time.Minute,
-		Delay:          3 * time.Second,
-		MinTimeout:     3 * time.Second,
-		NotFoundChecks: 120,
+		Timeout: apiClient.DefaultTimeout(),
+		Delay:   3 * time.Second,
 	}
-	_, err = createStateConf.WaitForStateContext(ctx)
+	_, err = associateWaiter.Wait(ctx)
 	if err != nil {
 		return diag.Errorf("[Error] Waiting for associate floating ip (%s): %s", createModel.FloatingIpId, err)
 	}
@@ -132,7 +132,7 @@ func resourceFloatingIpAssociationCreate(ctx context.Context, d *schema.Resource
 	return resourceFloatingIpAssociationRead(ctx, d, m)
 }
 
-func resourceFloatingIpAssociationRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceFloatingIpAssociationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	apiClient := m.(*common.Client)
 	service := NewFloatingIpAssociationService(apiClient)
 
@@ -144,8 +144,13 @@ func resourceFloatingIpAssociationRead(_ context.Context, d *schema.ResourceData
 		findModel.VpcId = vpcId.(string)
 	}
 
-	result, err := service.FindFloatingIp(findModel)
+	result, err := service.FindFloatingIp(ctx, findModel)
 	if err != nil {
+		if common.IsNotFound(err) {
+			log.Printf("[WARN] Floating ip %s not found, removing the association from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return diag.Errorf("[ERR] Failed retrieving the floating ip association: %s", err)
 	}
 	if result == nil {
@@ -180,32 +185,31 @@ func resourceFloatingIpAssociationDelete(ctx context.Context, d *schema.Resource
 		return diag.Errorf("[ERR] Vpc id is required")
 	}
 
-	_, err := service.Disassociate(vpcId.(string), d.Id())
+	_, err := service.Disassociate(ctx, vpcId.(string), d.Id())
 
 	if err != nil {
 		return diag.Errorf("[ERR] An error occurred while disassociate the floating ip %s", err)
 	}
 
-	createStateConf := &retry.StateChangeConf{
-		Pending: []string{"ACTIVE", "PENDING"},
-		Target:  []string{"IN_ACTIVE"},
-		Refresh: func() (interface{}, string, error) {
+	disassociateWaiter := &waiter.StateWaiter[*FloatingIp]{
+		Description: fmt.Sprintf("floating ip %s to be disassociated", d.Id()),
+		Pending:     []string{"ACTIVE", "PENDING"},
+		Target:      []string{"IN_ACTIVE"},
+		Refresh: func(ctx context.Context) (*FloatingIp, string, error) {
 			findModel := FindFloatingIpDTO{
 				FloatingIpID: d.Id(),
 				VpcId:        vpcId.(string),
 			}
-			resp, err := service.FindFloatingIp(findModel)
+			resp, err := service.FindFloatingIp(ctx, findModel)
 			if err != nil {
-				return 0, "", common.DecodeError(err)
+				return nil, "", err
 			}
 			return resp, resp.Status, nil
 		},
-		Timeout:        time.Duration(apiClient.Timeout) * time.Minute,
-		Delay:          3 * time.Second,
-		MinTimeout:     3 * time.Second,
-		NotFoundChecks: 120,
+		Timeout: apiClient.DefaultTimeout(),
+		Delay:   3 * time.Second,
 	}
-	_, err = createStateConf.WaitForStateContext(ctx)
+	_, err = disassociateWaiter.Wait(ctx)
 	if err != nil {
 		return diag.Errorf("[Error] Waiting for disassociate floating ip (%s): %s", d.Id(), err)
 	}
@@ -1,6 +1,7 @@
 package fptcloud_floating_ip
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	common "terraform-provider-fptcloud/commons"
@@ -90,7 +91,7 @@ func flattenFloatingIp(floatingIp, _ interface{}, _ map[string]interface{}) (map
 	return flattened, nil
 }
 
-func getFloatingIps(m interface{}, extra map[string]interface{}) ([]interface{}, error) {
+func getFloatingIps(ctx context.Context, m interface{}, extra map[string]interface{}) ([]interface{}, error) {
 	apiClient := m.(*common.Client)
 	service := NewFloatingIpService(apiClient)
 
@@ -99,7 +100,7 @@ func getFloatingIps(m interface{}, extra map[string]interface{}) ([]interface{},
 		return nil, fmt.Errorf("[ERR] Vpc id is required")
 	}
 
-	result, err := service.ListFloatingIp(vpcId)
+	result, err := service.ListFloatingIp(ctx, vpcId)
 	if err != nil || len(*result) == 0 {
 		return nil, fmt.Errorf("[ERR] Failed to retrieve floating ip: %s", err)
 	}
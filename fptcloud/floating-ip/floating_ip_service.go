@@ -1,6 +1,7 @@
 package fptcloud_floating_ip
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	common "terraform-provider-fptcloud/commons"
@@ -50,12 +51,12 @@ type FloatingIpInstance struct {
 
 // FloatingIpService defines the interface for floating ip service
 type FloatingIpService interface {
-	FindFloatingIp(findDto FindFloatingIpDTO) (*FloatingIp, error)
-	FindFloatingIpByAddress(findDto FindFloatingIpDTO) (*FloatingIp, error)
-	ListFloatingIp(vpcId string) (*[]FloatingIp, error)
-	CreateFloatingIp(vpcId string, tagIds []string) (*FloatingIp, error)
-	DeleteFloatingIp(vpcId string, floatingIpId string) (bool, error)
-	UpdateTags(vpcId string, floatingIpId string, tagIds []string) (*common.SimpleResponse, error)
+	FindFloatingIp(ctx context.Context, findDto FindFloatingIpDTO) (*FloatingIp, error)
+	FindFloatingIpByAddress(ctx context.Context, findDto FindFloatingIpDTO) (*FloatingIp, error)
+	ListFloatingIp(ctx context.Context, vpcId string) (*[]FloatingIp, error)
+	CreateFloatingIp(ctx context.Context, vpcId string, tagIds []string) (*FloatingIp, error)
+	DeleteFloatingIp(ctx context.Context, vpcId string, floatingIpId string) (bool, error)
+	UpdateTags(ctx context.Context, vpcId string, floatingIpId string, tagIds []string) (*common.SimpleResponse, error)
 }
 
 // FloatingIpServiceImpl is the implementation of FloatingIpServiceImpl
@@ -68,9 +69,9 @@ func NewFloatingIpService(client *common.Client) FloatingIpService {
 	return &FloatingIpServiceImpl{client: client}
 }
 
-func (s *FloatingIpServiceImpl) FindFloatingIp(findDto FindFloatingIpDTO) (*FloatingIp, error) {
+func (s *FloatingIpServiceImpl) FindFloatingIp(ctx context.Context, findDto FindFloatingIpDTO) (*FloatingIp, error) {
 	var apiPath = common.ApiPath.FindFloatingIp(findDto.VpcId, findDto.FloatingIpID)
-	resp, err := s.client.SendGetRequest(apiPath)
+	resp, err := s.client.SendGetRequestWithContext(ctx, apiPath)
 	if err != nil {
 		return nil, common.DecodeError(err)
 	}
@@ -88,9 +89,9 @@ func (s *FloatingIpServiceImpl) FindFloatingIp(findDto FindFloatingIpDTO) (*Floa
 }
 
 // FindFloatingIpByAddress find a floating ip
-func (s *FloatingIpServiceImpl) FindFloatingIpByAddress(findDto FindFloatingIpDTO) (*FloatingIp, error) {
+func (s *FloatingIpServiceImpl) FindFloatingIpByAddress(ctx context.Context, findDto FindFloatingIpDTO) (*FloatingIp, error) {
 	var apiPath = common.ApiPath.FindFloatingIpByAddress(findDto.VpcId) + utils.ToQueryParams(findDto)
-	resp, err := s.client.SendGetRequest(apiPath)
+	resp, err := s.client.SendGetRequestWithContext(ctx, apiPath)
 	if err != nil {
 		return nil, common.DecodeError(err)
 	}
@@ -108,9 +109,9 @@ func (s *FloatingIpServiceImpl) FindFloatingIpByAddress(findDto FindFloatingIpDT
 }
 
 // ListFloatingIp list floating ip
-func (s *FloatingIpServiceImpl) ListFloatingIp(vpcId string) (*[]FloatingIp, error) {
+func (s *FloatingIpServiceImpl) ListFloatingIp(ctx context.Context, vpcId string) (*[]FloatingIp, error) {
 	var apiPath = common.ApiPath.ListFloatingIp(vpcId)
-	resp, err := s.client.SendGetRequest(apiPath)
+	resp, err := s.client.SendGetRequestWithContext(ctx, apiPath)
 	if err != nil {
 		return nil, common.DecodeError(err)
 	}
@@ -131,16 +132,17 @@ func (s *FloatingIpServiceImpl) ListFloatingIp(vpcId string) (*[]FloatingIp, err
 }
 
 // CreateFloatingIp create a floating ip
-func (s *FloatingIpServiceImpl) CreateFloatingIp(vpcId string, tagIds []string) (*FloatingIp, error) {
+func (s *FloatingIpServiceImpl) CreateFloatingIp(ctx context.Context, vpcId string, tagIds []string) (*FloatingIp, error) {
 	body := map[string]interface{}{
 		"vpc_id":         vpcId,
 		"floating_ip_id": "new",
 	}
+	tagIds = s.client.Tags.MergeTagIds(tagIds, nil)
 	if len(tagIds) > 0 {
 		body["tag_ids"] = tagIds
 	}
 	var apiPath = common.ApiPath.CreateFloatingIp(vpcId)
-	resp, err := s.client.SendPostRequest(apiPath, body)
+	resp, err := s.client.SendPostRequestWithContext(ctx, apiPath, body)
 	if err != nil {
 		return nil, common.DecodeError(err)
 	}
@@ -158,9 +160,9 @@ func (s *FloatingIpServiceImpl) CreateFloatingIp(vpcId string, tagIds []string)
 }
 
 // DeleteFloatingIp delete an floating ip
-func (s *FloatingIpServiceImpl) DeleteFloatingIp(vpcId string, floatingIpId string) (bool, error) {
+func (s *FloatingIpServiceImpl) DeleteFloatingIp(ctx context.Context, vpcId string, floatingIpId string) (bool, error) {
 	var apiPath = common.ApiPath.DeleteFloatingIp(vpcId, floatingIpId)
-	resp, err := s.client.SendPostRequest(apiPath, nil)
+	resp, err := s.client.SendPostRequestWithContext(ctx, apiPath, nil)
 	if err != nil {
 		return false, common.DecodeError(err)
 	}
@@ -178,12 +180,23 @@ func (s *FloatingIpServiceImpl) DeleteFloatingIp(vpcId string, floatingIpId stri
 }
 
 // UpdateTags updates the tags associated with a floating IP
-func (s *FloatingIpServiceImpl) UpdateTags(vpcId string, floatingIpId string, tagIds []string) (*common.SimpleResponse, error) {
+func (s *FloatingIpServiceImpl) UpdateTags(ctx context.Context, vpcId string, floatingIpId string, tagIds []string) (*common.SimpleResponse, error) {
+	tagIds, err := s.client.Tags.MergeAppliedTagIds(ctx, tagIds, func(ctx context.Context) ([]string, error) {
+		floatingIp, err := s.FindFloatingIp(ctx, FindFloatingIpDTO{FloatingIpID: floatingIpId, VpcId: vpcId})
+		if err != nil {
+			return nil, err
+		}
+		return floatingIp.TagIds, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	var apiPath = common.ApiPath.UpdateFloatingIpTags(vpcId, floatingIpId)
 	payload := map[string][]string{
 		"tag_ids": tagIds,
 	}
-	_, err := s.client.SendPutRequest(apiPath, payload)
+	_, err = s.client.SendPutRequestWithContext(ctx, apiPath, payload)
 	if err != nil {
 		return nil, common.DecodeError(err)
 	}
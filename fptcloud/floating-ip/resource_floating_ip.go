@@ -2,11 +2,12 @@ package fptcloud_floating_ip
 
 import (
 	"context"
+	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"log"
 	common "terraform-provider-fptcloud/commons"
+	"terraform-provider-fptcloud/commons/waiter"
 	"time"
 )
 
@@ -37,13 +38,16 @@ func ResourceFloatingIp() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Description: "List of tag IDs associated with the floating IP",
 			},
+			"tag_ids_all": common.TagIdsAllSchema(),
 		},
-		CreateContext: resourceFloatingIpCreate,
-		ReadContext:   resourceFloatingIpRead,
-		UpdateContext: resourceFloatingIpUpdate,
-		DeleteContext: resourceFloatingIpDelete,
+		CreateWithoutTimeout: common.WithResourceTimeout(schema.TimeoutCreate, resourceFloatingIpCreate),
+		ReadWithoutTimeout:   common.WithResourceTimeout(schema.TimeoutRead, resourceFloatingIpRead),
+		UpdateWithoutTimeout: common.WithResourceTimeout(schema.TimeoutUpdate, resourceFloatingIpUpdate),
+		DeleteWithoutTimeout: common.WithResourceTimeout(schema.TimeoutDelete, resourceFloatingIpDelete),
+		CustomizeDiff:        common.CustomizeDiffTagIdsAll,
+		Timeouts:             common.ResourceTimeouts(),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: common.ImportVpcResource("<vpc_id>/<id> or <vpc_id>/<ip_address>", resourceFloatingIpImport),
 		},
 	}
 }
@@ -58,9 +62,9 @@ func resourceFloatingIpCreate(ctx context.Context, d *schema.ResourceData, m int
 	}
 	var tagIds []string
 	if tags, ok := d.GetOk("tag_ids"); ok {
-		tagIds = expandTagIDs(tags.(*schema.Set))
+		tagIds = common.ExpandTagIds(tags.(*schema.Set))
 	}
-	result, err := service.CreateFloatingIp(vpcId.(string), tagIds)
+	result, err := service.CreateFloatingIp(ctx, vpcId.(string), tagIds)
 	if err != nil || result == nil {
 		return diag.Errorf("[ERR] Failed to create a new floating ip: %s", err)
 	}
@@ -76,26 +80,25 @@ func resourceFloatingIpCreate(ctx context.Context, d *schema.ResourceData, m int
 	}
 
 	//Waiting for status active
-	createStateConf := &retry.StateChangeConf{
-		Pending: []string{},
-		Target:  []string{"ACTIVE", "IN_ACTIVE"},
-		Refresh: func() (interface{}, string, error) {
+	createWaiter := &waiter.StateWaiter[*FloatingIp]{
+		Description: fmt.Sprintf("floating ip %s to be created", result.IpAddress),
+		Target:      []string{"ACTIVE", "IN_ACTIVE"},
+		Refresh: func(ctx context.Context) (*FloatingIp, string, error) {
 			findModel := FindFloatingIpDTO{
 				FloatingIpID: result.ID,
 				VpcId:        vpcId.(string),
 			}
-			resp, err := service.FindFloatingIp(findModel)
+			resp, err := service.FindFloatingIp(ctx, findModel)
 			if err != nil {
-				return 0, "", common.DecodeError(err)
+				return nil, "", err
 			}
 			return resp, resp.Status, nil
 		},
-		Timeout:        time.Duration(apiClient.Timeout) * time.Minute,
+		Timeout:        apiClient.ResourceTimeout(d, schema.TimeoutCreate),
 		Delay:          3 * time.Second,
-		MinTimeout:     3 * time.Second,
 		NotFoundChecks: 120,
 	}
-	_, err = createStateConf.WaitForStateContext(ctx)
+	_, err = createWaiter.Wait(ctx)
 	if err != nil {
 		return diag.Errorf("[Error] Waiting for floating ip (%s) to be created: %s", result.IpAddress, err)
 	}
@@ -103,7 +106,7 @@ func resourceFloatingIpCreate(ctx context.Context, d *schema.ResourceData, m int
 	return resourceFloatingIpRead(ctx, d, m)
 }
 
-func resourceFloatingIpRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceFloatingIpRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	apiClient := m.(*common.Client)
 	service := NewFloatingIpService(apiClient)
 
@@ -115,8 +118,13 @@ func resourceFloatingIpRead(_ context.Context, d *schema.ResourceData, m interfa
 		findModel.VpcId = vpcId.(string)
 	}
 
-	result, err := service.FindFloatingIp(findModel)
+	result, err := service.FindFloatingIp(ctx, findModel)
 	if err != nil {
+		if common.IsNotFound(err) {
+			log.Printf("[WARN] Floating ip %s not found, removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return diag.Errorf("[ERR] Failed retrieving the floating ip: %s", err)
 	}
 	if result == nil {
@@ -137,24 +145,46 @@ func resourceFloatingIpRead(_ context.Context, d *schema.ResourceData, m interfa
 		return diag.Errorf("[ERR] Failed to set 'created_at': %s", err)
 	}
 
-	if err := d.Set("tag_ids", result.TagIds); err != nil {
+	configuredTagIds := common.ExpandTagIds(d.Get("tag_ids").(*schema.Set))
+	if err := d.Set("tag_ids", apiClient.Tags.FilterTagIds(result.TagIds, configuredTagIds)); err != nil {
 		return diag.Errorf("[ERR] Failed to set 'tag_ids': %s", err)
 	}
+	if err := d.Set("tag_ids_all", apiClient.Tags.AllTagIds(result.TagIds, configuredTagIds)); err != nil {
+		return diag.Errorf("[ERR] Failed to set 'tag_ids_all': %s", err)
+	}
 
 	return nil
 }
 
+// resourceFloatingIpImport imports a floating ip by id or by its ip address, which is unique
+func resourceFloatingIpImport(ctx context.Context, _ *schema.ResourceData, m interface{}, vpcId string, ref string) (string, error) {
+	service := NewFloatingIpService(m.(*common.Client))
+
+	result, err := common.FindByIdOrName(ctx, ref,
+		func(ctx context.Context, id string) (*FloatingIp, error) {
+			return service.FindFloatingIp(ctx, FindFloatingIpDTO{FloatingIpID: id, VpcId: vpcId})
+		},
+		func(ctx context.Context, ipAddress string) (*FloatingIp, error) {
+			return service.FindFloatingIpByAddress(ctx, FindFloatingIpDTO{IpAddress: ipAddress, VpcId: vpcId})
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+	return result.ID, nil
+}
+
 func resourceFloatingIpUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	apiClient := m.(*common.Client)
 	service := NewFloatingIpService(apiClient)
 
-	if !d.HasChange("tag_ids") {
+	if !d.HasChanges("tag_ids", "tag_ids_all") {
 		return resourceFloatingIpRead(ctx, d, m)
 	}
 
 	vpcId := d.Get("vpc_id").(string)
-	tagIds := expandTagIDs(d.Get("tag_ids").(*schema.Set))
-	_, err := service.UpdateTags(vpcId, d.Id(), tagIds)
+	tagIds := common.ExpandTagIds(d.Get("tag_ids").(*schema.Set))
+	_, err := service.UpdateTags(ctx, vpcId, d.Id(), tagIds)
 	if err != nil {
 		return diag.Errorf("[ERR] An error occurred while updating floating ip tags %s", err)
 	}
@@ -162,7 +192,7 @@ func resourceFloatingIpUpdate(ctx context.Context, d *schema.ResourceData, m int
 	return resourceFloatingIpRead(ctx, d, m)
 }
 
-func resourceFloatingIpDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceFloatingIpDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	apiClient := m.(*common.Client)
 	service := NewFloatingIpService(apiClient)
 
@@ -173,18 +203,10 @@ func resourceFloatingIpDelete(_ context.Context, d *schema.ResourceData, m inter
 		return diag.Errorf("[ERR] Vpc id is required")
 	}
 
-	_, err := service.DeleteFloatingIp(vpcId.(string), d.Id())
+	_, err := service.DeleteFloatingIp(ctx, vpcId.(string), d.Id())
 
 	if err != nil {
 		return diag.Errorf("[ERR] An error occurred while trying to delete the floating ip %s", err)
 	}
 	return nil
 }
-
-func expandTagIDs(tagSet *schema.Set) []string {
-	tagIds := make([]string, 0, tagSet.Len())
-	for _, tag := range tagSet.List() {
-		tagIds = append(tagIds, tag.(string))
-	}
-	return tagIds
-}
@@ -1,6 +1,7 @@
 package fptcloud_image
 
 import (
+	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -68,7 +69,7 @@ func flattenImage(image, _ interface{}, _ map[string]interface{}) (map[string]in
 	return flattened, nil
 }
 
-func getImages(m interface{}, extra map[string]interface{}) ([]interface{}, error) {
+func getImages(ctx context.Context, m interface{}, extra map[string]interface{}) ([]interface{}, error) {
 	apiClient := m.(*common.Client)
 	imageService := NewImageService(apiClient)
 
@@ -77,7 +78,7 @@ func getImages(m interface{}, extra map[string]interface{}) ([]interface{}, erro
 		return nil, fmt.Errorf("[ERR] vpc id is required")
 	}
 
-	images, err := imageService.ListImage(vpcId)
+	images, err := imageService.ListImage(ctx, vpcId)
 	if err != nil {
 		return nil, fmt.Errorf("[ERR] Failed to retrieve images: %s", err)
 	}
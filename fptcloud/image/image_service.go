@@ -1,6 +1,7 @@
 package fptcloud_image
 
 import (
+	"context"
 	"encoding/json"
 	common "terraform-provider-fptcloud/commons"
 )
@@ -15,7 +16,7 @@ type Image struct {
 
 // ImageService defines the interface for image service
 type ImageService interface {
-	ListImage(vpcId string) (*[]Image, error)
+	ListImage(ctx context.Context, vpcId string) (*[]Image, error)
 }
 
 // ImageServiceImpl is the implementation of ImageService
@@ -29,9 +30,9 @@ func NewImageService(client *common.Client) ImageService {
 }
 
 // ListImage get list image
-func (s *ImageServiceImpl) ListImage(vpcId string) (*[]Image, error) {
+func (s *ImageServiceImpl) ListImage(ctx context.Context, vpcId string) (*[]Image, error) {
 	var apiPath = common.ApiPath.Image(vpcId)
-	resp, err := s.client.SendGetRequest(apiPath)
+	resp, err := s.client.SendCachedGetRequestWithContext(ctx, apiPath)
 	if err != nil {
 		return nil, err
 	}
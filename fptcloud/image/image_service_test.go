@@ -1,6 +1,7 @@
 package fptcloud_image_test
 
 import (
+	"context"
 	"terraform-provider-fptcloud/fptcloud/image"
 	"testing"
 
@@ -15,7 +16,7 @@ func TestListImage_ReturnsImages(t *testing.T) {
 	})
 	defer server.Close()
 	service := fptcloud_image.NewImageService(mockClient)
-	images, err := service.ListImage("vpc_id")
+	images, err := service.ListImage(context.Background(), "vpc_id")
 	assert.NoError(t, err)
 	assert.NotNil(t, images)
 	assert.Equal(t, 1, len(*images))
@@ -32,7 +33,7 @@ func TestListImage_ReturnsErrorOnInvalidJSON(t *testing.T) {
 	})
 	defer server.Close()
 	service := fptcloud_image.NewImageService(mockClient)
-	images, err := service.ListImage("vpc_id")
+	images, err := service.ListImage(context.Background(), "vpc_id")
 	assert.Error(t, err)
 	assert.Nil(t, images)
 }
@@ -44,7 +45,7 @@ func TestListImage_ReturnsEmptyListOnEmptyResponse(t *testing.T) {
 	})
 	defer server.Close()
 	service := fptcloud_image.NewImageService(mockClient)
-	images, err := service.ListImage("vpc_id")
+	images, err := service.ListImage(context.Background(), "vpc_id")
 	assert.NoError(t, err)
 	assert.NotNil(t, images)
 	assert.Equal(t, 0, len(*images))
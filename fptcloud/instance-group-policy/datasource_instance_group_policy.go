@@ -1,6 +1,7 @@
 package fptcloud_instance_group_policy
 
 import (
+	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -55,7 +56,7 @@ func flattenInstanceGroupPolicy(instanceGroupPolicy, _ interface{}, _ map[string
 	return flattened, nil
 }
 
-func getInstanceGroupPolicy(m interface{}, extra map[string]interface{}) ([]interface{}, error) {
+func getInstanceGroupPolicy(ctx context.Context, m interface{}, extra map[string]interface{}) ([]interface{}, error) {
 	apiClient := m.(*common.Client)
 	instanceGroupPolicyService := NewInstanceGroupPolicyService(apiClient)
 
@@ -64,7 +65,7 @@ func getInstanceGroupPolicy(m interface{}, extra map[string]interface{}) ([]inte
 		return nil, fmt.Errorf("[ERR] VPC id is required")
 	}
 
-	instanceGroupPolicies, err := instanceGroupPolicyService.ListInstanceGroupPolicies(vpcId)
+	instanceGroupPolicies, err := instanceGroupPolicyService.ListInstanceGroupPolicies(ctx, vpcId)
 	if err != nil {
 		return nil, fmt.Errorf("[ERR] Failed to retrieve instance group policies: %s", err)
 	}
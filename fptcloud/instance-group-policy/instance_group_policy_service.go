@@ -1,6 +1,7 @@
 package fptcloud_instance_group_policy
 
 import (
+	"context"
 	"encoding/json"
 	common "terraform-provider-fptcloud/commons"
 )
@@ -13,7 +14,7 @@ type InstanceGroupPolicy struct {
 
 // InstanceGroupPolicyService defines the interface for the instance group policy service
 type InstanceGroupPolicyService interface {
-	ListInstanceGroupPolicies(vpcId string) (*[]InstanceGroupPolicy, error)
+	ListInstanceGroupPolicies(ctx context.Context, vpcId string) (*[]InstanceGroupPolicy, error)
 }
 
 // InstanceGroupPolicyServiceImpl is the implementation of InstanceGroupPolicyService
@@ -27,9 +28,9 @@ func NewInstanceGroupPolicyService(client *common.Client) InstanceGroupPolicySer
 }
 
 // ListInstanceGroupPolicies get list instance group policies
-func (s *InstanceGroupPolicyServiceImpl) ListInstanceGroupPolicies(vpcId string) (*[]InstanceGroupPolicy, error) {
+func (s *InstanceGroupPolicyServiceImpl) ListInstanceGroupPolicies(ctx context.Context, vpcId string) (*[]InstanceGroupPolicy, error) {
 	var apiPath = common.ApiPath.VMGroupPolicies(vpcId)
-	resp, err := s.client.SendGetRequest(apiPath)
+	resp, err := s.client.SendGetRequestWithContext(ctx, apiPath)
 	if err != nil {
 		return nil, err
 	}
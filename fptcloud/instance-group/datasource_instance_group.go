@@ -1,6 +1,7 @@
 package fptcloud_instance_group
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	common "terraform-provider-fptcloud/commons"
@@ -109,7 +110,7 @@ func flattenInstanceGroup(instanceGroup, _ interface{}, _ map[string]interface{}
 	return flattened, nil
 }
 
-func getInstanceGroups(m interface{}, extra map[string]interface{}) ([]interface{}, error) {
+func getInstanceGroups(ctx context.Context, m interface{}, extra map[string]interface{}) ([]interface{}, error) {
 	apiClient := m.(*common.Client)
 	service := NewInstanceGroupService(apiClient)
 
@@ -127,7 +128,7 @@ func getInstanceGroups(m interface{}, extra map[string]interface{}) ([]interface
 		findModel.VpcId = vpcId
 	}
 
-	result, err := service.FindInstanceGroup(findModel)
+	result, err := service.FindInstanceGroup(ctx, findModel)
 	if err != nil || len(*result) == 0 {
 		return nil, fmt.Errorf("[ERR] Failed to retrieve instance group: %s", err)
 	}
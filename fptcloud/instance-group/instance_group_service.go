@@ -1,6 +1,7 @@
 package fptcloud_instance_group
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	common "terraform-provider-fptcloud/commons"
@@ -34,9 +35,9 @@ type InstanceGroup struct {
 
 // InstanceGroupService defines the interface for the instance group service
 type InstanceGroupService interface {
-	FindInstanceGroup(searchModel FindInstanceGroupDTO) (*[]InstanceGroup, error)
-	CreateInstanceGroup(createdModel CreateInstanceGroupDTO) (bool, error)
-	DeleteInstanceGroup(vpcId string, instanceGroupId string) (bool, error)
+	FindInstanceGroup(ctx context.Context, searchModel FindInstanceGroupDTO) (*[]InstanceGroup, error)
+	CreateInstanceGroup(ctx context.Context, createdModel CreateInstanceGroupDTO) (bool, error)
+	DeleteInstanceGroup(ctx context.Context, vpcId string, instanceGroupId string) (bool, error)
 }
 
 // InstanceGroupServiceImpl is the implementation of InstanceGroupServiceImpl
@@ -50,9 +51,9 @@ func NewInstanceGroupService(client *common.Client) InstanceGroupService {
 }
 
 // FindInstanceGroup finds an instance group by either part of the ID or part of the name
-func (s *InstanceGroupServiceImpl) FindInstanceGroup(searchModel FindInstanceGroupDTO) (*[]InstanceGroup, error) {
+func (s *InstanceGroupServiceImpl) FindInstanceGroup(ctx context.Context, searchModel FindInstanceGroupDTO) (*[]InstanceGroup, error) {
 	var apiPath = common.ApiPath.FindInstanceGroup(searchModel.VpcId) + utils.ToQueryParams(searchModel)
-	resp, err := s.client.SendGetRequest(apiPath)
+	resp, err := s.client.SendGetRequestWithContext(ctx, apiPath)
 	if err != nil {
 		return nil, common.DecodeError(err)
 	}
@@ -74,9 +75,9 @@ func (s *InstanceGroupServiceImpl) FindInstanceGroup(searchModel FindInstanceGro
 }
 
 // CreateInstanceGroup create a new instance group
-func (s *InstanceGroupServiceImpl) CreateInstanceGroup(createdModel CreateInstanceGroupDTO) (bool, error) {
+func (s *InstanceGroupServiceImpl) CreateInstanceGroup(ctx context.Context, createdModel CreateInstanceGroupDTO) (bool, error) {
 	var apiPath = common.ApiPath.CreateInstanceGroup(createdModel.VpcId)
-	resp, err := s.client.SendPostRequest(apiPath, createdModel)
+	resp, err := s.client.SendPostRequestWithContext(ctx, apiPath, createdModel)
 	if err != nil {
 		return false, common.DecodeError(err)
 	}
@@ -93,9 +94,9 @@ func (s *InstanceGroupServiceImpl) CreateInstanceGroup(createdModel CreateInstan
 }
 
 // DeleteInstanceGroup delete an instance group
-func (s *InstanceGroupServiceImpl) DeleteInstanceGroup(vpcId string, instanceGroupId string) (bool, error) {
+func (s *InstanceGroupServiceImpl) DeleteInstanceGroup(ctx context.Context, vpcId string, instanceGroupId string) (bool, error) {
 	var apiPath = common.ApiPath.DeleteInstanceGroup(vpcId, instanceGroupId)
-	resp, err := s.client.SendDeleteRequest(apiPath)
+	resp, err := s.client.SendDeleteRequestWithContext(ctx, apiPath)
 	if err != nil {
 		return false, common.DecodeError(err)
 	}
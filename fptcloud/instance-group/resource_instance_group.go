@@ -2,12 +2,13 @@ package fptcloud_instance_group
 
 import (
 	"context"
+	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
 	common "terraform-provider-fptcloud/commons"
+	"terraform-provider-fptcloud/commons/waiter"
 	"time"
 )
 
@@ -103,7 +104,7 @@ func resourceInstanceGroupCreate(ctx context.Context, d *schema.ResourceData, m
 		createModel.VmIds = vmIdsList
 	}
 
-	isSuccess, err := service.CreateInstanceGroup(createModel)
+	isSuccess, err := service.CreateInstanceGroup(ctx, createModel)
 	if err != nil || !isSuccess {
 		return diag.Errorf("[ERR] Failed to create a new instance group: %s", err)
 	}
@@ -119,29 +120,29 @@ func resourceInstanceGroupCreate(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	//Waiting for status active
-	createStateConf := &retry.StateChangeConf{
-		Pending: []string{"PENDING"},
-		Target:  []string{"COMPLETE"},
-		Refresh: func() (interface{}, string, error) {
+	createWaiter := &waiter.StateWaiter[InstanceGroup]{
+		Description: fmt.Sprintf("instance group %s to be created", name.(string)),
+		Target:      []string{"COMPLETE"},
+		Refresh: func(ctx context.Context) (InstanceGroup, string, error) {
 			findModel := FindInstanceGroupDTO{
 				Name:  name.(string),
 				VpcId: vpcId.(string),
 			}
-			resp, err := service.FindInstanceGroup(findModel)
-			if err != nil || len(*resp) == 0 {
-				return nil, "", common.DecodeError(err)
+			resp, err := service.FindInstanceGroup(ctx, findModel)
+			if err != nil {
+				return InstanceGroup{}, "", err
 			}
 
 			rsInstanceGroup := (*resp)[0]
 			d.SetId(rsInstanceGroup.ID)
-			return (*resp)[0], "COMPLETE", nil
+			return rsInstanceGroup, "COMPLETE", nil
 		},
-		Timeout:        time.Duration(apiClient.Timeout) * time.Minute,
+		Timeout:        apiClient.DefaultTimeout(),
 		Delay:          10 * time.Second,
-		MinTimeout:     30 * time.Second,
+		MinInterval:    10 * time.Second,
 		NotFoundChecks: 20,
 	}
-	_, err = createStateConf.WaitForStateContext(ctx)
+	_, err = createWaiter.Wait(ctx)
 	if err != nil {
 		return diag.Errorf("[Error] Waiting for instance group (%s) to be created: %s", d.Id(), err)
 	}
@@ -150,7 +151,7 @@ func resourceInstanceGroupCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 // function to read the instance group
-func resourceInstanceGroupRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceInstanceGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	apiClient := m.(*common.Client)
 	service := NewInstanceGroupService(apiClient)
 
@@ -165,9 +166,10 @@ func resourceInstanceGroupRead(_ context.Context, d *schema.ResourceData, m inte
 		findModel.Name = policyName.(string)
 	}
 
-	result, err := service.FindInstanceGroup(findModel)
+	result, err := service.FindInstanceGroup(ctx, findModel)
 	if err != nil {
-		if result == nil {
+		if common.IsNotFound(err) {
+			log.Printf("[WARN] Instance group %s not found, removing it from state", d.Id())
 			d.SetId("")
 			return nil
 		}
@@ -206,7 +208,7 @@ func resourceInstanceGroupRead(_ context.Context, d *schema.ResourceData, m inte
 }
 
 // function to delete the instance group
-func resourceInstanceGroupDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceInstanceGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	apiClient := m.(*common.Client)
 	service := NewInstanceGroupService(apiClient)
 
@@ -217,7 +219,7 @@ func resourceInstanceGroupDelete(_ context.Context, d *schema.ResourceData, m in
 		return diag.Errorf("[ERR] Vpc id is required")
 	}
 
-	_, err := service.DeleteInstanceGroup(vpcId.(string), d.Id())
+	_, err := service.DeleteInstanceGroup(ctx, vpcId.(string), d.Id())
 
 	if err != nil {
 		return diag.Errorf("[ERR] An error occurred while trying to delete the instance group %s", err)
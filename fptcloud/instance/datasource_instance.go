@@ -21,7 +21,7 @@ func DataSourceInstance() *schema.Resource {
 	}
 }
 
-func dataSourceInstanceRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func dataSourceInstanceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	apiClient := m.(*common.Client)
 	instanceService := NewInstanceService(apiClient)
 
@@ -39,7 +39,7 @@ func dataSourceInstanceRead(_ context.Context, d *schema.ResourceData, m interfa
 		findInstanceModel.VpcId = vpcId.(string)
 	}
 
-	foundInstance, err := instanceService.Find(findInstanceModel)
+	foundInstance, err := instanceService.Find(ctx, findInstanceModel)
 	if err != nil {
 		return diag.Errorf("[ERR] Failed to retrieve instance: %s", err)
 	}
@@ -1,6 +1,7 @@
 package fptcloud_instance
 
 import (
+	"context"
 	"encoding/json"
 	common "terraform-provider-fptcloud/commons"
 	"terraform-provider-fptcloud/commons/utils"
@@ -8,14 +9,14 @@ import (
 
 // InstanceService defines the interface for instance service
 type InstanceService interface {
-	Find(searchModel FindInstanceDTO) (*InstanceModel, error)
-	Create(createdModel CreateInstanceDTO) (string, error)
-	Delete(vpcId string, instanceId string) (*common.SimpleResponse, error)
-	Rename(vpcId string, instanceId string, newName string) (*common.SimpleResponse, error)
-	ChangeStatus(vpcId string, instanceId string, status string) (*common.SimpleResponse, error)
-	Resize(vpcId string, instanceId string, flavorId string) (*common.SimpleResponse, error)
-	GetFlavorByName(vpcId string, flavorName string) (*FlavorDTO, error)
-	UpdateTags(vpcId string, instanceId string, tagIds []string) (*common.SimpleResponse, error)
+	Find(ctx context.Context, searchModel FindInstanceDTO) (*InstanceModel, error)
+	Create(ctx context.Context, createdModel CreateInstanceDTO) (string, error)
+	Delete(ctx context.Context, vpcId string, instanceId string) (*common.SimpleResponse, error)
+	Rename(ctx context.Context, vpcId string, instanceId string, newName string) (*common.SimpleResponse, error)
+	ChangeStatus(ctx context.Context, vpcId string, instanceId string, status string) (*common.SimpleResponse, error)
+	Resize(ctx context.Context, vpcId string, instanceId string, flavorId string) (*common.SimpleResponse, error)
+	GetFlavorByName(ctx context.Context, vpcId string, flavorName string) (*FlavorDTO, error)
+	UpdateTags(ctx context.Context, vpcId string, instanceId string, tagIds []string) (*common.SimpleResponse, error)
 }
 
 // InstanceServiceImpl is the implementation of InstanceService
@@ -29,9 +30,9 @@ func NewInstanceService(client *common.Client) InstanceService {
 }
 
 // Find get instance by id or name
-func (s *InstanceServiceImpl) Find(searchModel FindInstanceDTO) (*InstanceModel, error) {
+func (s *InstanceServiceImpl) Find(ctx context.Context, searchModel FindInstanceDTO) (*InstanceModel, error) {
 	var apiPath = common.ApiPath.Instance(searchModel.VpcId) + utils.ToQueryParams(searchModel)
-	resp, err := s.client.SendGetRequest(apiPath)
+	resp, err := s.client.SendGetRequestWithContext(ctx, apiPath)
 	if err != nil {
 		return nil, common.DecodeError(err)
 	}
@@ -49,9 +50,10 @@ func (s *InstanceServiceImpl) Find(searchModel FindInstanceDTO) (*InstanceModel,
 }
 
 // Create created a new instance
-func (s *InstanceServiceImpl) Create(createdModel CreateInstanceDTO) (string, error) {
+func (s *InstanceServiceImpl) Create(ctx context.Context, createdModel CreateInstanceDTO) (string, error) {
+	createdModel.TagIds = s.client.Tags.MergeTagIds(createdModel.TagIds, nil)
 	var apiPath = common.ApiPath.Instance(createdModel.VpcId)
-	resp, err := s.client.SendPostRequest(apiPath, createdModel)
+	resp, err := s.client.SendPostRequestWithContext(ctx, apiPath, createdModel)
 	if err != nil {
 		return "", common.DecodeError(err)
 	}
@@ -70,9 +72,9 @@ func (s *InstanceServiceImpl) Create(createdModel CreateInstanceDTO) (string, er
 }
 
 // Delete deleted a instance
-func (s *InstanceServiceImpl) Delete(vpcId string, instanceId string) (*common.SimpleResponse, error) {
+func (s *InstanceServiceImpl) Delete(ctx context.Context, vpcId string, instanceId string) (*common.SimpleResponse, error) {
 	var apiPath = common.ApiPath.Instance(vpcId) + "/" + instanceId
-	_, err := s.client.SendDeleteRequest(apiPath)
+	_, err := s.client.SendDeleteRequestWithContext(ctx, apiPath)
 	if err != nil {
 		return nil, common.DecodeError(err)
 	}
@@ -85,9 +87,9 @@ func (s *InstanceServiceImpl) Delete(vpcId string, instanceId string) (*common.S
 }
 
 // Rename update name a instance
-func (s *InstanceServiceImpl) Rename(vpcId string, instanceId string, newName string) (*common.SimpleResponse, error) {
+func (s *InstanceServiceImpl) Rename(ctx context.Context, vpcId string, instanceId string, newName string) (*common.SimpleResponse, error) {
 	var apiPath = common.ApiPath.RenameInstance(vpcId, instanceId)
-	_, err := s.client.SendPutRequest(apiPath, map[string]string{"new_name": newName})
+	_, err := s.client.SendPutRequestWithContext(ctx, apiPath, map[string]string{"new_name": newName})
 	if err != nil {
 		return nil, common.DecodeError(err)
 	}
@@ -100,9 +102,9 @@ func (s *InstanceServiceImpl) Rename(vpcId string, instanceId string, newName st
 }
 
 // ChangeStatus update status an instance
-func (s *InstanceServiceImpl) ChangeStatus(vpcId string, instanceId string, status string) (*common.SimpleResponse, error) {
+func (s *InstanceServiceImpl) ChangeStatus(ctx context.Context, vpcId string, instanceId string, status string) (*common.SimpleResponse, error) {
 	var apiPath = common.ApiPath.ChangeStatusInstance(vpcId, instanceId)
-	_, err := s.client.SendPutRequest(apiPath, map[string]string{"status": status})
+	_, err := s.client.SendPutRequestWithContext(ctx, apiPath, map[string]string{"status": status})
 	if err != nil {
 		return nil, common.DecodeError(err)
 	}
@@ -115,9 +117,9 @@ func (s *InstanceServiceImpl) ChangeStatus(vpcId string, instanceId string, stat
 }
 
 // Resize update flavor an instance
-func (s *InstanceServiceImpl) Resize(vpcId string, instanceId string, flavorId string) (*common.SimpleResponse, error) {
+func (s *InstanceServiceImpl) Resize(ctx context.Context, vpcId string, instanceId string, flavorId string) (*common.SimpleResponse, error) {
 	var apiPath = common.ApiPath.ResizeInstance(vpcId, instanceId)
-	_, err := s.client.SendPostRequest(apiPath, map[string]string{"hw_flavor": flavorId})
+	_, err := s.client.SendPostRequestWithContext(ctx, apiPath, map[string]string{"hw_flavor": flavorId})
 	if err != nil {
 		return nil, common.DecodeError(err)
 	}
@@ -130,9 +132,9 @@ func (s *InstanceServiceImpl) Resize(vpcId string, instanceId string, flavorId s
 }
 
 // GetFlavorByName get flavor by name
-func (s *InstanceServiceImpl) GetFlavorByName(vpcId string, flavorName string) (*FlavorDTO, error) {
+func (s *InstanceServiceImpl) GetFlavorByName(ctx context.Context, vpcId string, flavorName string) (*FlavorDTO, error) {
 	var apiPath = common.ApiPath.GetFlavorByName(vpcId)
-	resp, err := s.client.SendPostRequest(apiPath, map[string]string{"flavor_name": flavorName})
+	resp, err := s.client.SendCachedPostRequestWithContext(ctx, apiPath, map[string]string{"flavor_name": flavorName})
 	if err != nil {
 		return nil, common.DecodeError(err)
 	}
@@ -148,12 +150,23 @@ func (s *InstanceServiceImpl) GetFlavorByName(vpcId string, flavorName string) (
 }
 
 // UpdateTags updates tags associated with an instance
-func (s *InstanceServiceImpl) UpdateTags(vpcId string, instanceId string, tagIds []string) (*common.SimpleResponse, error) {
+func (s *InstanceServiceImpl) UpdateTags(ctx context.Context, vpcId string, instanceId string, tagIds []string) (*common.SimpleResponse, error) {
+	tagIds, err := s.client.Tags.MergeAppliedTagIds(ctx, tagIds, func(ctx context.Context) ([]string, error) {
+		instance, err := s.Find(ctx, FindInstanceDTO{ID: instanceId, VpcId: vpcId})
+		if err != nil {
+			return nil, err
+		}
+		return instance.TagIds, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	var apiPath = common.ApiPath.UpdateInstanceTags(vpcId, instanceId)
 	payload := map[string][]string{
 		"tag_ids": tagIds,
 	}
-	_, err := s.client.SendPutRequest(apiPath, payload)
+	_, err = s.client.SendPutRequestWithContext(ctx, apiPath, payload)
 	if err != nil {
 		return nil, common.DecodeError(err)
 	}
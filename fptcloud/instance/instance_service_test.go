@@ -1,6 +1,7 @@
 package fptcloud_instance_test
 
 import (
+	"context"
 	"terraform-provider-fptcloud/fptcloud/instance"
 	"testing"
 
@@ -38,7 +39,7 @@ func TestFindInstance_ReturnsInstance(t *testing.T) {
 	defer server.Close()
 	service := fptcloud_instance.NewInstanceService(mockClient)
 	searchModel := fptcloud_instance.FindInstanceDTO{VpcId: "vpc_id", Name: "vm-12345678901-xyzxyzxyz"}
-	instance, err := service.Find(searchModel)
+	instance, err := service.Find(context.Background(), searchModel)
 	assert.NoError(t, err)
 	assert.NotNil(t, instance)
 	assert.Equal(t, "11111111-aaaa-1111-bbbb-111111111111", instance.ID)
@@ -54,7 +55,7 @@ func TestFindInstance_ReturnsErrorOnRequestFailure(t *testing.T) {
 	defer server.Close()
 	service := fptcloud_instance.NewInstanceService(mockClient)
 	searchModel := fptcloud_instance.FindInstanceDTO{VpcId: "vpc_id", Name: "instance-name"}
-	instance, err := service.Find(searchModel)
+	instance, err := service.Find(context.Background(), searchModel)
 	assert.Error(t, err)
 	assert.Nil(t, instance)
 }
@@ -67,7 +68,7 @@ func TestCreateInstance_ReturnsInstanceIdWhenSuccess(t *testing.T) {
 	defer server.Close()
 	service := fptcloud_instance.NewInstanceService(mockClient)
 	createModel := fptcloud_instance.CreateInstanceDTO{VpcId: "vpc_id", Name: "instance"}
-	instanceId, err := service.Create(createModel)
+	instanceId, err := service.Create(context.Background(), createModel)
 	assert.NoError(t, err)
 	assert.Equal(t, "instance_id", instanceId)
 }
@@ -78,7 +79,7 @@ func TestDeleteInstance_ReturnsSuccess(t *testing.T) {
 	})
 	defer server.Close()
 	service := fptcloud_instance.NewInstanceService(mockClient)
-	response, err := service.Delete("vpc_id", "instance_id")
+	response, err := service.Delete(context.Background(), "vpc_id", "instance_id")
 	assert.NoError(t, err)
 	assert.NotNil(t, response)
 	assert.Equal(t, "Successfully", response.Data)
@@ -90,7 +91,7 @@ func TestRenameInstance_ReturnsSuccess(t *testing.T) {
 	})
 	defer server.Close()
 	service := fptcloud_instance.NewInstanceService(mockClient)
-	response, err := service.Rename("vpc_id", "instance_id", "new-name")
+	response, err := service.Rename(context.Background(), "vpc_id", "instance_id", "new-name")
 	assert.NoError(t, err)
 	assert.NotNil(t, response)
 	assert.Equal(t, "Successfully", response.Data)
@@ -102,7 +103,7 @@ func TestChangeStatusInstance_ReturnsSuccess(t *testing.T) {
 	})
 	defer server.Close()
 	service := fptcloud_instance.NewInstanceService(mockClient)
-	response, err := service.ChangeStatus("vpc_id", "instance_id", "POWERED_ON")
+	response, err := service.ChangeStatus(context.Background(), "vpc_id", "instance_id", "POWERED_ON")
 	assert.NoError(t, err)
 	assert.NotNil(t, response)
 	assert.Equal(t, "Successfully", response.Data)
@@ -114,7 +115,7 @@ func TestResizeInstance_ReturnsSuccess(t *testing.T) {
 	})
 	defer server.Close()
 	service := fptcloud_instance.NewInstanceService(mockClient)
-	response, err := service.Resize("vpc_id", "instance_id", "flavor_id")
+	response, err := service.Resize(context.Background(), "vpc_id", "instance_id", "flavor_id")
 	assert.NoError(t, err)
 	assert.NotNil(t, response)
 	assert.Equal(t, "Successfully", response.Data)
@@ -2,335 +2,623 @@ package fptcloud_instance
 
 import (
 	"context"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"log"
-	common "terraform-provider-fptcloud/commons"
+	"fmt"
 	"time"
+
+	common "terraform-provider-fptcloud/commons"
+	"terraform-provider-fptcloud/commons/utils"
+	"terraform-provider-fptcloud/commons/waiter"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-// ResourceInstance function returns a schema.Resource that represents an instance.
-// This can be used to create, read, update and delete operations for an instance in the infrastructure.
-func ResourceInstance() *schema.Resource {
-	return &schema.Resource{
-		Description:   "Provides a instance resource. This can be used to create, modify, and delete instances.",
-		Schema:        resourceInstanceSchema,
-		CreateContext: resourceInstanceCreate,
-		UpdateContext: resourceInstanceUpdate,
-		ReadContext:   resourceInstanceRead,
-		DeleteContext: resourceInstanceDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-	}
-}
+var (
+	_ resource.Resource                   = &resourceInstance{}
+	_ resource.ResourceWithConfigure      = &resourceInstance{}
+	_ resource.ResourceWithImportState    = &resourceInstance{}
+	_ resource.ResourceWithModifyPlan     = &resourceInstance{}
+	_ resource.ResourceWithValidateConfig = &resourceInstance{}
+)
 
-// function to create a new instance
-func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*common.Client)
-	instanceService := NewInstanceService(apiClient)
+type resourceInstance struct {
+	client          *common.Client
+	instanceService InstanceService
+}
 
-	createdModel := CreateInstanceDTO{}
-	vpcId, okVpcId := d.GetOk("vpc_id")
+// instanceResourceModel is the state of an instance, which is compatible with the state the SDKv2 resource wrote
+type instanceResourceModel struct {
+	Id                types.String   `tfsdk:"id"`
+	VpcId             types.String   `tfsdk:"vpc_id"`
+	Name              types.String   `tfsdk:"name"`
+	Status            types.String   `tfsdk:"status"`
+	PrivateIp         types.String   `tfsdk:"private_ip"`
+	PublicIp          types.String   `tfsdk:"public_ip"`
+	FlavorName        types.String   `tfsdk:"flavor_name"`
+	ImageName         types.String   `tfsdk:"image_name"`
+	SubnetId          types.String   `tfsdk:"subnet_id"`
+	StorageSizeGb     types.Int64    `tfsdk:"storage_size_gb"`
+	StoragePolicyId   types.String   `tfsdk:"storage_policy_id"`
+	SecurityGroupIds  types.Set      `tfsdk:"security_group_ids"`
+	InstanceGroupId   types.String   `tfsdk:"instance_group_id"`
+	SshKey            types.String   `tfsdk:"ssh_key"`
+	Password          types.String   `tfsdk:"password"`
+	PasswordWo        types.String   `tfsdk:"password_wo"`
+	PasswordWoVersion types.Int64    `tfsdk:"password_wo_version"`
+	CreatedAt         types.String   `tfsdk:"created_at"`
+	TagIds            types.Set      `tfsdk:"tag_ids"`
+	TagIdsAll         types.Set      `tfsdk:"tag_ids_all"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
 
-	if name, ok := d.GetOk("name"); ok {
-		createdModel.Name = name.(string)
-	}
+// NewResourceInstance returns the instance resource, which can be used to create, read, update and delete instances
+func NewResourceInstance() resource.Resource {
+	return &resourceInstance{}
+}
 
-	if privateIp, ok := d.GetOk("private_ip"); ok {
-		PrivateIpValue := privateIp.(string)
-		createdModel.PrivateIp = &PrivateIpValue
-	}
+func (r *resourceInstance) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_instance"
+}
 
-	if publicIp, ok := d.GetOk("public_ip"); ok {
-		publicIpValue := publicIp.(string)
-		createdModel.PublicIp = &publicIpValue
+func (r *resourceInstance) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	forceNew := []planmodifier.String{stringplanmodifier.RequiresReplace()}
+	// The API does not return the image of an imported instance, nor its credentials
+	credentialAttributes := []string{"ssh_key", "password", "password_wo_version"}
+	forceNewUnlessImported := func(attributes ...string) []planmodifier.String {
+		return []planmodifier.String{common.RequiresReplaceUnlessUnsetAfterImport(attributes...)}
+	}
+
+	response.Schema = schema.Schema{
+		Description: "Provides a instance resource. This can be used to create, modify, and delete instances.",
+		Attributes: map[string]schema.Attribute{
+			"vpc_id": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Validators:    []validator.String{utils.NotEmptyValidator()},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
+				Description:   common.InheritedVpcIdDescription("The vpc id of the instance"),
+			},
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The id of the instance",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Validators:  []validator.String{utils.NameValidator()},
+				Description: "The name of the instance",
+			},
+			"status": schema.StringAttribute{
+				Required:    true,
+				Validators:  []validator.String{utils.OneOfValidator("POWERED_ON", "POWERED_OFF")},
+				Description: "The status of the instance (`POWERED_ON` or `POWERED_OFF`)",
+			},
+			"private_ip": schema.StringAttribute{
+				Optional:    true,
+				Description: "The private ip of the instance.",
+			},
+			"public_ip": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The public ip (floating ip) of the instance.",
+			},
+			"flavor_name": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The flavor name of the instance (get from API or data source)",
+			},
+			"image_name": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: forceNewUnlessImported("image_name"),
+				Description:   "The image name of the instance (get from API or data source)",
+			},
+			"subnet_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: forceNew,
+				Description:   "The subnet id of the instance",
+			},
+			"storage_size_gb": schema.Int64Attribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+				Description:   "The root storage size of the instance",
+			},
+			"storage_policy_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: forceNew,
+				Description:   "The root storage policy of the instance",
+			},
+			"security_group_ids": schema.SetAttribute{
+				ElementType:   types.StringType,
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Set{setplanmodifier.UseStateForUnknown()},
+				Description:   "The security group associated with the instance",
+			},
+			"instance_group_id": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The instance group id of the instance",
+			},
+			"ssh_key": schema.StringAttribute{
+				Optional:      true,
+				PlanModifiers: forceNewUnlessImported(credentialAttributes...),
+				Description:   "The ssh key of the instance",
+			},
+			"password": schema.StringAttribute{
+				Optional:      true,
+				PlanModifiers: forceNewUnlessImported(credentialAttributes...),
+				Description:   "The password of the instance",
+			},
+			"password_wo": schema.StringAttribute{
+				Optional:    true,
+				WriteOnly:   true,
+				Sensitive:   true,
+				Description: "The password of the instance, which is never stored in the plan or state. Requires `password_wo_version` and Terraform 1.11 or later.",
+			},
+			"password_wo_version": schema.Int64Attribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.Int64{
+					common.RequiresReplaceInt64UnlessUnsetAfterImport(credentialAttributes...),
+				},
+				Description: "The version of `password_wo`. The API cannot reset the password of an instance, so changing it replaces the instance with one using the current `password_wo`.",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The created at of the security group",
+			},
+			"tag_ids": schema.SetAttribute{
+				ElementType:   types.StringType,
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Set{setplanmodifier.UseStateForUnknown()},
+				Description:   "List of tag IDs to associate with the instance",
+			},
+			"tag_ids_all": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: common.TagIdsAllDescription,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
+}
 
-	if flavorName, ok := d.GetOk("flavor_name"); ok {
-		createdModel.FlavorName = flavorName.(string)
+func (r *resourceInstance) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
 	}
 
-	if imageName, ok := d.GetOk("image_name"); ok {
-		createdModel.ImageName = imageName.(string)
+	client, ok := request.ProviderData.(*common.Client)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *common.Client, got: %T. Please report this issue to the provider developers.", request.ProviderData),
+		)
+		return
 	}
 
-	if subnetId, ok := d.GetOk("subnet_id"); ok {
-		createdModel.SubnetId = subnetId.(string)
-	}
+	r.client = client
+	r.instanceService = NewInstanceService(client)
+}
 
-	if storageSizeGb, ok := d.GetOk("storage_size_gb"); ok {
-		createdModel.StorageSizeGb = storageSizeGb.(int)
+// ValidateConfig requires exactly one of ssh_key, password and password_wo, and the version of password_wo
+func (r *resourceInstance) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var config instanceResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
 	}
 
-	if storagePolicyId, ok := d.GetOk("storage_policy_id"); ok {
-		createdModel.StoragePolicyId = storagePolicyId.(string)
+	if !config.PasswordWo.IsNull() && config.PasswordWoVersion.IsNull() {
+		response.Diagnostics.AddAttributeError(path.Root("password_wo_version"), "Missing password_wo_version",
+			"password_wo_version must be set along with password_wo")
 	}
-
-	if securityGroupIds, ok := d.GetOk("security_group_ids"); ok {
-		securityGroupIdsSet := securityGroupIds.(*schema.Set)
-		securityGroupIdsList := make([]string, 0, len(securityGroupIdsSet.List()))
-		for _, v := range securityGroupIdsSet.List() {
-			securityGroupIdsList = append(securityGroupIdsList, v.(string))
+	credentials := 0
+	for _, credential := range []types.String{config.SshKey, config.Password, config.PasswordWo} {
+		if credential.IsUnknown() {
+			return
 		}
-		createdModel.SecurityGroupIds = securityGroupIdsList
-	}
-
-	if tags, ok := d.GetOk("tag_ids"); ok {
-		tagsSet := tags.(*schema.Set)
-		tagIds := make([]string, 0, tagsSet.Len())
-		for _, tag := range tagsSet.List() {
-			tagIds = append(tagIds, tag.(string))
+		if !credential.IsNull() {
+			credentials++
 		}
-		createdModel.TagIds = tagIds
 	}
-
-	if instanceGroupId, ok := d.GetOk("instance_group_id"); ok {
-		instanceGroupIdValue := instanceGroupId.(string)
-		createdModel.InstanceGroupId = &instanceGroupIdValue
+	if credentials != 1 {
+		response.Diagnostics.AddAttributeError(path.Root("ssh_key"), "Invalid credentials",
+			"exactly one of ssh_key, password or password_wo must be set")
 	}
+}
+
+// ModifyPlan plans the provider vpc_id when vpc_id is not configured, and the tag IDs applied to the instance. It
+// warns that a new password_wo_version replaces the instance, the API being unable to reset its password.
+func (r *resourceInstance) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.client.PlanDefaultVpcId(ctx, request, response)
+	r.client.PlanTagIdsAll(ctx, request, response)
 
-	if sshKey, ok := d.GetOk("ssh_key"); ok {
-		sshKeyValue := sshKey.(string)
-		createdModel.SshKey = &sshKeyValue
+	if request.State.Raw.IsNull() || request.Plan.Raw.IsNull() {
+		return
 	}
 
-	if password, ok := d.GetOk("password"); ok {
-		passwordValue := password.(string)
-		createdModel.Password = &passwordValue
+	var plannedVersion, priorVersion types.Int64
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("password_wo_version"), &plannedVersion)...)
+	response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root("password_wo_version"), &priorVersion)...)
+	if !priorVersion.IsNull() && !plannedVersion.Equal(priorVersion) {
+		response.Diagnostics.AddAttributeWarning(path.Root("password_wo_version"), "Instance will be replaced",
+			"The API cannot reset the password of an existing instance, so changing password_wo_version destroys the instance "+
+				"and creates a new one with the current password_wo. Revert password_wo_version to keep the instance.")
 	}
+}
 
-	if okVpcId {
-		createdModel.VpcId = vpcId.(string)
+func (r *resourceInstance) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan instanceResourceModel
+	var passwordWo types.String
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	// Write-only values are only in the configuration
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("password_wo"), &passwordWo)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, r.client.DefaultTimeout())
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	vpcId := plan.VpcId.ValueString()
+	createdModel := CreateInstanceDTO{
+		VpcId:           vpcId,
+		Name:            plan.Name.ValueString(),
+		PrivateIp:       stringPointer(plan.PrivateIp),
+		PublicIp:        stringPointer(plan.PublicIp),
+		FlavorName:      plan.FlavorName.ValueString(),
+		ImageName:       plan.ImageName.ValueString(),
+		SubnetId:        plan.SubnetId.ValueString(),
+		StorageSizeGb:   int(plan.StorageSizeGb.ValueInt64()),
+		StoragePolicyId: plan.StoragePolicyId.ValueString(),
+		InstanceGroupId: stringPointer(plan.InstanceGroupId),
+		SshKey:          stringPointer(plan.SshKey),
+		Password:        stringPointer(plan.Password),
+	}
+	if password := stringPointer(passwordWo); password != nil {
+		createdModel.Password = password
+	}
+	createdModel.SecurityGroupIds = stringElements(ctx, plan.SecurityGroupIds, &response.Diagnostics)
+	createdModel.TagIds = stringElements(ctx, plan.TagIds, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
 	}
 
 	if createdModel.SubnetId == "" {
-		return diag.Errorf("[ERR] Subnet id is required")
+		response.Diagnostics.AddAttributeError(path.Root("subnet_id"), "Missing subnet_id", "Subnet id is required")
+		return
 	}
 
-	instanceId, err := instanceService.Create(createdModel)
+	instanceId, err := r.instanceService.Create(ctx, createdModel)
 	if err != nil {
-		return diag.Errorf("[ERR] Failed to create instance: %s", err)
-	}
-
-	d.SetId(instanceId)
-	setError := d.Set("vpc_id", vpcId.(string))
-	if setError != nil {
-		return diag.Errorf("[ERR] Failed to create instance")
+		response.Diagnostics.AddError("Error creating instance", fmt.Sprintf("failed to create instance: %s", err))
+		return
 	}
+	plan.Id = types.StringValue(instanceId)
 
 	// Waiting for status active
-	createStateConf := &retry.StateChangeConf{
-		Pending: []string{"CREATING"},
-		Target:  []string{"POWERED_ON", "POWERED_OFF"},
-		Refresh: func() (interface{}, string, error) {
-			findModel := FindInstanceDTO{
-				ID:    instanceId,
-				VpcId: vpcId.(string),
-			}
-			resp, err := instanceService.Find(findModel)
+	createWaiter := &waiter.StateWaiter[*InstanceModel]{
+		Description: fmt.Sprintf("instance %s to be created", instanceId),
+		Pending:     []string{"CREATING"},
+		Target:      []string{"POWERED_ON", "POWERED_OFF"},
+		Refresh: func(ctx context.Context) (*InstanceModel, string, error) {
+			resp, err := r.instanceService.Find(ctx, FindInstanceDTO{ID: instanceId, VpcId: vpcId})
 			if err != nil {
-				return 0, "", common.DecodeError(err)
+				return nil, "", err
 			}
 			return resp, resp.Status, nil
 		},
-		Timeout:        time.Duration(apiClient.Timeout) * time.Minute,
+		Timeout:        createTimeout,
 		Delay:          3 * time.Second,
-		MinTimeout:     3 * time.Second,
 		NotFoundChecks: 120,
 	}
-	_, err = createStateConf.WaitForStateContext(ctx)
-	if err != nil {
-		return diag.Errorf("[Error] Waiting for instance (%s) to be created: %s", d.Id(), err)
+	if _, err = createWaiter.Wait(ctx); err != nil {
+		// Keep the instance in state, where Terraform taints it
+		plan.nullUnknowns()
+		response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+		response.Diagnostics.AddError("Error creating instance", fmt.Sprintf("waiting for instance (%s) to be created: %s", instanceId, err))
+		return
 	}
 
-	return resourceInstanceRead(ctx, d, m)
+	if err = r.read(ctx, &plan, &response.Diagnostics); err != nil {
+		response.Diagnostics.AddError("Error reading instance", fmt.Sprintf("failed to retrieve instance: %s", err))
+	}
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
 }
 
-// function to read an instance
-func resourceInstanceRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*common.Client)
-	instanceService := NewInstanceService(apiClient)
-
-	findInstanceModel := FindInstanceDTO{}
-
-	if id, ok := d.GetOk("id"); ok {
-		findInstanceModel.ID = id.(string)
+func (r *resourceInstance) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state instanceResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
 	}
 
-	if name, ok := d.GetOk("name"); ok {
-		findInstanceModel.Name = name.(string)
+	readTimeout, diags := state.Timeouts.Read(ctx, r.client.DefaultTimeout())
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
 	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
 
-	if vpcId, ok := d.GetOk("vpc_id"); ok {
-		findInstanceModel.VpcId = vpcId.(string)
+	err := r.read(ctx, &state, &response.Diagnostics)
+	if common.IsNotFound(err) {
+		tflog.Warn(ctx, "Instance "+state.Id.ValueString()+" not found, removing it from state")
+		response.State.RemoveResource(ctx)
+		return
 	}
-
-	foundInstance, err := instanceService.Find(findInstanceModel)
 	if err != nil {
-		return diag.Errorf("[ERR] Failed to retrieve instance: %s", err)
+		response.Diagnostics.AddError("Error reading instance", fmt.Sprintf("failed to retrieve instance: %s", err))
 	}
-
-	// Set other attributes
-	d.SetId(foundInstance.ID)
-
-	if err := d.Set("vpc_id", foundInstance.VpcId); err != nil {
-		return diag.FromErr(err)
+	if response.Diagnostics.HasError() {
+		return
 	}
-	if err := d.Set("name", foundInstance.Name); err != nil {
-		return diag.FromErr(err)
-	}
-	if err := d.Set("status", foundInstance.Status); err != nil {
-		return diag.FromErr(err)
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *resourceInstance) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state instanceResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
 	}
-	if err := d.Set("public_ip", foundInstance.PublicIp); err != nil {
-		return diag.FromErr(err)
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, r.client.DefaultTimeout())
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
 	}
-	if err := d.Set("flavor_name", foundInstance.FlavorName); err != nil {
-		return diag.FromErr(err)
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	vpcId := state.VpcId.ValueString()
+	instanceId := state.Id.ValueString()
+
+	if !plan.Name.Equal(state.Name) {
+		if _, err := r.instanceService.Rename(ctx, vpcId, instanceId, plan.Name.ValueString()); err != nil {
+			response.Diagnostics.AddError("Error updating instance", fmt.Sprintf("an error occurred while rename instance %s", err))
+			return
+		}
 	}
-	if err := d.Set("subnet_id", foundInstance.SubnetId); err != nil {
-		return diag.FromErr(err)
+
+	if !plan.Status.Equal(state.Status) {
+		if _, err := r.instanceService.ChangeStatus(ctx, vpcId, instanceId, plan.Status.ValueString()); err != nil {
+			response.Diagnostics.AddError("Error updating instance", fmt.Sprintf("an error occurred while change status instance %s", err))
+			return
+		}
 	}
-	if err := d.Set("security_group_ids", foundInstance.SecurityGroupIds); err != nil {
-		return diag.FromErr(err)
+
+	if !plan.FlavorName.IsNull() && !plan.FlavorName.IsUnknown() && !plan.FlavorName.Equal(state.FlavorName) {
+		flavor, err := r.instanceService.GetFlavorByName(ctx, vpcId, plan.FlavorName.ValueString())
+		if err != nil {
+			response.Diagnostics.AddAttributeError(path.Root("flavor_name"), "Flavor not found", err.Error())
+			return
+		}
+		if _, err = r.instanceService.Resize(ctx, vpcId, instanceId, flavor.ID); err != nil {
+			response.Diagnostics.AddError("Error updating instance", fmt.Sprintf("an error occurred while resize instance %s", err))
+			return
+		}
+
+		updateWaiter := &waiter.StateWaiter[*InstanceModel]{
+			Description: fmt.Sprintf("instance %s to be resized", instanceId),
+			Pending:     []string{"VERIFY_RESIZE"},
+			Target:      []string{"POWERED_ON", "POWERED_OFF"},
+			Refresh: func(ctx context.Context) (*InstanceModel, string, error) {
+				resp, err := r.instanceService.Find(ctx, FindInstanceDTO{ID: instanceId, VpcId: vpcId})
+				if err != nil {
+					return nil, "", err
+				}
+				return resp, resp.Status, nil
+			},
+			Timeout: updateTimeout,
+			Delay:   3 * time.Second,
+		}
+		if _, err = updateWaiter.Wait(ctx); err != nil {
+			response.Diagnostics.AddError("Error updating instance", fmt.Sprintf("waiting for instance (%s) to be resize: %s", instanceId, err))
+			return
+		}
 	}
-	if err := d.Set("instance_group_id", foundInstance.InstanceGroupId); err != nil {
-		return diag.FromErr(err)
+
+	if !plan.TagIds.IsUnknown() && (!plan.TagIds.Equal(state.TagIds) || plan.TagIdsAll.IsUnknown()) {
+		tagIds := stringElements(ctx, plan.TagIds, &response.Diagnostics)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		if _, err := r.instanceService.UpdateTags(ctx, vpcId, instanceId, tagIds); err != nil {
+			response.Diagnostics.AddError("Error updating instance", fmt.Sprintf("an error occurred while updating instance tags %s", err))
+			return
+		}
 	}
-	if err := d.Set("created_at", foundInstance.CreatedAt); err != nil {
-		return diag.FromErr(err)
+
+	plan.Id = state.Id
+	if err := r.read(ctx, &plan, &response.Diagnostics); err != nil {
+		response.Diagnostics.AddError("Error reading instance", fmt.Sprintf("failed to retrieve instance: %s", err))
 	}
-	if err := d.Set("tag_ids", foundInstance.TagIds); err != nil {
-		return diag.FromErr(err)
+	if response.Diagnostics.HasError() {
+		return
 	}
-
-	return nil
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
 }
 
-// function to delete an instance
-func resourceInstanceDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*common.Client)
-	instanceService := NewInstanceService(apiClient)
-
-	log.Printf("[INFO] Deleting the instance %s", d.Id())
+func (r *resourceInstance) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state instanceResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
 
-	vpcId, okVpcId := d.GetOk("vpc_id")
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, r.client.DefaultTimeout())
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
 
-	if !okVpcId {
-		return diag.Errorf("[ERR] Vpc id is required")
+	vpcId := state.VpcId.ValueString()
+	instanceId := state.Id.ValueString()
+	if vpcId == "" {
+		response.Diagnostics.AddAttributeError(path.Root("vpc_id"), "Missing vpc_id", "Vpc id is required")
+		return
 	}
 
-	_, err := instanceService.Delete(vpcId.(string), d.Id())
-	if err != nil {
-		return diag.Errorf("[ERR] An error occurred while trying to delete the instance %s", err)
+	tflog.Info(ctx, "Deleting the instance "+instanceId)
+	if _, err := r.instanceService.Delete(ctx, vpcId, instanceId); err != nil {
+		response.Diagnostics.AddError("Error deleting instance", fmt.Sprintf("an error occurred while trying to delete the instance %s", err))
+		return
 	}
 
-	deleteStateConf := &retry.StateChangeConf{
-		Pending: []string{"DELETING"},
-		Target:  []string{"SUCCESS"},
-		Refresh: func() (interface{}, string, error) {
-			findInstanceModel := FindInstanceDTO{
-				ID:    d.Id(),
-				VpcId: vpcId.(string),
-			}
-			resp, err := instanceService.Find(findInstanceModel)
+	deleteWaiter := &waiter.StateWaiter[*InstanceModel]{
+		Description: fmt.Sprintf("instance %s to be deleted", instanceId),
+		Pending:     []string{"DELETING"},
+		Refresh: func(ctx context.Context) (*InstanceModel, string, error) {
+			resp, err := r.instanceService.Find(ctx, FindInstanceDTO{ID: instanceId, VpcId: vpcId})
 			if err != nil {
-				// If the security group is not found, consider it deleted
-				return 1, "SUCCESS", nil
+				return nil, "", err
 			}
-
 			return resp, resp.Status, nil
 		},
-		Timeout:        time.Duration(apiClient.Timeout) * time.Minute,
-		Delay:          3 * time.Second,
-		MinTimeout:     3 * time.Second,
-		NotFoundChecks: 120,
+		Timeout:          deleteTimeout,
+		Delay:            3 * time.Second,
+		NotFoundIsTarget: true,
 	}
-	_, err = deleteStateConf.WaitForStateContext(context.Background())
-	if err != nil {
-		return diag.Errorf("[Error] Waiting for instance (%s) to be deleted: %s", d.Id(), err)
+	if _, err := deleteWaiter.Wait(ctx); err != nil {
+		response.Diagnostics.AddError("Error deleting instance", fmt.Sprintf("waiting for instance (%s) to be deleted: %s", instanceId, err))
 	}
-
-	return nil
 }
 
-// function to update an instance
-func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*common.Client)
-	instanceService := NewInstanceService(apiClient)
+// ImportState imports an instance by id or name, setting the root storage which the read does not
+func (r *resourceInstance) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	r.client.ImportVpcResourceState(ctx, "<vpc_id>/<id> or <vpc_id>/<name>", request, response,
+		func(ctx context.Context, vpcId string, ref string, response *resource.ImportStateResponse) (string, error) {
+			foundInstance, err := common.FindByIdOrName(ctx, ref,
+				func(ctx context.Context, id string) (*InstanceModel, error) {
+					return r.instanceService.Find(ctx, FindInstanceDTO{ID: id, VpcId: vpcId})
+				},
+				func(ctx context.Context, name string) (*InstanceModel, error) {
+					return r.instanceService.Find(ctx, FindInstanceDTO{Name: name, VpcId: vpcId})
+				},
+			)
+			if err != nil {
+				return "", err
+			}
 
-	vpcId := d.Get("vpc_id").(string)
-	hasChangedName := d.HasChange("name")
-	hasChangeFlavor := d.HasChange("flavor_name")
-	hasChangeStatus := d.HasChange("status")
-	hasChangeTags := d.HasChange("tag_ids")
+			response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("storage_size_gb"), foundInstance.StorageSizeGb)...)
+			response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("storage_policy_id"), foundInstance.StoragePolicyId)...)
+			return foundInstance.ID, nil
+		})
+}
 
-	if hasChangedName {
-		newName := d.Get("name").(string)
-		_, err := instanceService.Rename(vpcId, d.Id(), newName)
-		if err != nil {
-			return diag.Errorf("[ERR] An error occurred while rename instance %s", err)
-		}
-	}
+// read sets the attributes of an instance returned by the API. The optional attributes the API returns empty, or
+// which the SDKv2 resource stored empty, are set to null so that they match an unset configuration.
+func (r *resourceInstance) read(ctx context.Context, state *instanceResourceModel, diags *diag.Diagnostics) error {
+	foundInstance, err := r.instanceService.Find(ctx, FindInstanceDTO{
+		ID:    state.Id.ValueString(),
+		Name:  state.Name.ValueString(),
+		VpcId: state.VpcId.ValueString(),
+	})
+	if err != nil {
+		return err
+	}
+
+	configuredTagIds := stringElements(ctx, state.TagIds, diags)
+	tagIds, d := types.SetValueFrom(ctx, types.StringType, r.client.Tags.FilterTagIds(foundInstance.TagIds, configuredTagIds))
+	diags.Append(d...)
+	tagIdsAll, d := types.SetValueFrom(ctx, types.StringType, r.client.Tags.AllTagIds(foundInstance.TagIds, configuredTagIds))
+	diags.Append(d...)
+	securityGroupIds, d := types.SetValueFrom(ctx, types.StringType, foundInstance.SecurityGroupIds)
+	diags.Append(d...)
+
+	state.Id = types.StringValue(foundInstance.ID)
+	state.VpcId = types.StringValue(foundInstance.VpcId)
+	state.Name = types.StringValue(foundInstance.Name)
+	state.Status = types.StringValue(foundInstance.Status)
+	state.PublicIp = nullIfEmpty(types.StringPointerValue(foundInstance.PublicIp))
+	state.FlavorName = nullIfEmpty(types.StringPointerValue(foundInstance.FlavorName))
+	state.SubnetId = types.StringValue(foundInstance.SubnetId)
+	state.SecurityGroupIds = securityGroupIds
+	state.InstanceGroupId = nullIfEmpty(types.StringPointerValue(foundInstance.InstanceGroupId))
+	state.CreatedAt = types.StringValue(foundInstance.CreatedAt)
+	state.TagIds = tagIds
+	state.TagIdsAll = tagIdsAll
+
+	state.PrivateIp = nullIfEmpty(state.PrivateIp)
+	state.ImageName = nullIfEmpty(state.ImageName)
+	state.SshKey = nullIfEmpty(state.SshKey)
+	state.Password = nullIfEmpty(state.Password)
+	return nil
+}
 
-	if hasChangeStatus {
-		status := d.Get("status").(string)
-		_, err := instanceService.ChangeStatus(vpcId, d.Id(), status)
-		if err != nil {
-			return diag.Errorf("[ERR] An error occurred while change status instance %s", err)
-		}
+// nullUnknowns sets the computed attributes left unknown by a failed create to null
+func (m *instanceResourceModel) nullUnknowns() {
+	if m.SecurityGroupIds.IsUnknown() {
+		m.SecurityGroupIds = types.SetNull(types.StringType)
 	}
-
-	if hasChangeFlavor {
-		newFlavorName := d.Get("flavor_name").(string)
-		flavor, flavorErr := instanceService.GetFlavorByName(vpcId, newFlavorName)
-		if flavorErr != nil {
-			return diag.Errorf("[ERR] Flavor not found %s", flavorErr)
-		}
-		_, err := instanceService.Resize(vpcId, d.Id(), flavor.ID)
-		if err != nil {
-			return diag.Errorf("[ERR] An error occurred while resize instance %s", err)
-		}
-
-		updateStateConf := &retry.StateChangeConf{
-			Pending: []string{"VERIFY_RESIZE"},
-			Target:  []string{"POWERED_ON", "POWERED_OFF"},
-			Refresh: func() (interface{}, string, error) {
-				findModel := FindInstanceDTO{
-					ID:    d.Id(),
-					VpcId: vpcId,
-				}
-				resp, err := instanceService.Find(findModel)
-				if err != nil {
-					return 0, "", common.DecodeError(err)
-				}
-				return resp, resp.Status, nil
-			},
-			Timeout:        time.Duration(apiClient.Timeout) * time.Minute,
-			Delay:          3 * time.Second,
-			MinTimeout:     3 * time.Second,
-			NotFoundChecks: 120,
-		}
-		_, err = updateStateConf.WaitForStateContext(ctx)
-		if err != nil {
-			return diag.Errorf("[Error] Waiting for instance (%s) to be resize: %s", d.Id(), err)
+	if m.TagIds.IsUnknown() {
+		m.TagIds = types.SetNull(types.StringType)
+	}
+	if m.TagIdsAll.IsUnknown() {
+		m.TagIdsAll = types.SetNull(types.StringType)
+	}
+	for _, value := range []*types.String{&m.PublicIp, &m.FlavorName, &m.InstanceGroupId, &m.CreatedAt} {
+		if value.IsUnknown() {
+			*value = types.StringNull()
 		}
 	}
+}
 
-	if hasChangeTags {
-		tagsSet := d.Get("tag_ids").(*schema.Set)
-		tagIds := make([]string, 0, tagsSet.Len())
-		for _, tag := range tagsSet.List() {
-			tagIds = append(tagIds, tag.(string))
-		}
+// stringPointer returns the value of an optional string attribute, nil when unset or empty
+func stringPointer(value types.String) *string {
+	if value.ValueString() == "" {
+		return nil
+	}
+	return value.ValueStringPointer()
+}
 
-		_, err := instanceService.UpdateTags(vpcId, d.Id(), tagIds)
-		if err != nil {
-			return diag.Errorf("[ERR] An error occurred while updating instance tags %s", err)
-		}
+// stringElements returns the elements of a set of strings attribute, nil when unset
+func stringElements(ctx context.Context, value types.Set, diags *diag.Diagnostics) []string {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
 	}
+	var elements []string
+	diags.Append(value.ElementsAs(ctx, &elements, false)...)
+	return elements
+}
 
-	return resourceInstanceRead(ctx, d, m)
+func nullIfEmpty(value types.String) types.String {
+	if value.ValueString() == "" {
+		return types.StringNull()
+	}
+	return value
 }
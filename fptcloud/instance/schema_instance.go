@@ -109,111 +109,3 @@ var dataSourceInstanceSchema = map[string]*schema.Schema{
 		Description: "List of tag IDs associated with the instance",
 	},
 }
-
-var resourceInstanceSchema = map[string]*schema.Schema{
-	"vpc_id": {
-		Type:         schema.TypeString,
-		Required:     true,
-		ValidateFunc: validation.NoZeroValues,
-		Description:  "The vpc id of the instance",
-		ForceNew:     true,
-	},
-	"id": {
-		Type:        schema.TypeString,
-		Computed:    true,
-		Description: "The id of the instance",
-		ForceNew:    true,
-	},
-	"name": {
-		Type:         schema.TypeString,
-		Required:     true,
-		ValidateFunc: utils.ValidateName,
-		Description:  "The name of the instance",
-	},
-	"status": {
-		Type:        schema.TypeString,
-		Required:    true,
-		Description: "The status of the instance (`POWERED_ON` or `POWERED_OFF`)",
-		ValidateFunc: validation.StringInSlice([]string{
-			"POWERED_ON", "POWERED_OFF",
-		}, false),
-	},
-	"private_ip": {
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "The private ip of the instance.",
-	},
-	"public_ip": {
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "The public ip (floating ip) of the instance.",
-	},
-	"flavor_name": {
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "The flavor name of the instance (get from API or data source)",
-	},
-	"image_name": {
-		Type:        schema.TypeString,
-		Required:    true,
-		Description: "The image name of the instance (get from API or data source)",
-		ForceNew:    true,
-	},
-	"subnet_id": {
-		Type:        schema.TypeString,
-		Required:    true,
-		Description: "The subnet id of the instance",
-		ForceNew:    true,
-	},
-	"storage_size_gb": {
-		Type:        schema.TypeInt,
-		Required:    true,
-		Description: "The root storage size of the instance",
-		ForceNew:    true,
-	},
-	"storage_policy_id": {
-		Type:        schema.TypeString,
-		Required:    true,
-		Description: "The root storage policy of the instance",
-		ForceNew:    true,
-	},
-	"security_group_ids": {
-		Type:        schema.TypeSet,
-		Computed:    true,
-		Optional:    true,
-		Elem:        &schema.Schema{Type: schema.TypeString},
-		Description: "The security group associated with the instance",
-	},
-	"instance_group_id": {
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "The instance group id of the instance",
-	},
-	"ssh_key": {
-		Type:         schema.TypeString,
-		Optional:     true,
-		Description:  "The ssh key of the instance",
-		ForceNew:     true,
-		ExactlyOneOf: []string{"ssh_key", "password"},
-	},
-	"password": {
-		Type:         schema.TypeString,
-		Optional:     true,
-		Description:  "The password of the instance",
-		ForceNew:     true,
-		ExactlyOneOf: []string{"ssh_key", "password"},
-	},
-	"created_at": {
-		Type:        schema.TypeString,
-		Computed:    true,
-		Description: "The created at of the security group",
-		ForceNew:    true,
-	},
-	"tag_ids": {
-		Type:        schema.TypeSet,
-		Computed:    true,
-		Optional:    true,
-		Elem:        &schema.Schema{Type: schema.TypeString},
-		Description: "List of tag IDs to associate with the instance",
-	},
-}
@@ -30,11 +30,13 @@ func listCertificates(ctx context.Context, d *schema.ResourceData, m interface{}
 	client := m.(*common.Client)
 	service := NewLoadBalancerV2Service(client)
 	vpcId := d.Get("vpc_id").(string)
-	response, err := service.ListCertificates(vpcId, 1, 1000)
+	certificates, err := common.ListAll(ctx, common.DefaultPageSize, func(ctx context.Context, page int, pageSize int) ([]Certificate, int, error) {
+		response, err := service.ListCertificates(ctx, vpcId, page, pageSize)
+		return response.Certificates, response.Total, err
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	certificates := response.Certificates
 	var formattedData []interface{}
 	for _, certificate := range certificates {
 		formattedData = append(formattedData, map[string]interface{}{
@@ -57,7 +59,7 @@ func getCertificate(ctx context.Context, d *schema.ResourceData, m interface{})
 	service := NewLoadBalancerV2Service(client)
 	vpcId := d.Get("vpc_id").(string)
 	certificateId := d.Get("certificate_id").(string)
-	response, err := service.GetCertificate(vpcId, certificateId)
+	response, err := service.GetCertificate(ctx, vpcId, certificateId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
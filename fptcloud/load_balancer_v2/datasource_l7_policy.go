@@ -28,7 +28,7 @@ func listL7Policies(ctx context.Context, d *schema.ResourceData, m interface{})
 	service := NewLoadBalancerV2Service(client)
 	vpcId := d.Get("vpc_id").(string)
 	listenerId := d.Get("listener_id").(string)
-	response, err := service.ListL7Policies(vpcId, listenerId)
+	response, err := service.ListL7Policies(ctx, vpcId, listenerId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -70,7 +70,7 @@ func getL7Policy(ctx context.Context, d *schema.ResourceData, m interface{}) dia
 	vpcId := d.Get("vpc_id").(string)
 	listenerId := d.Get("listener_id").(string)
 	l7PolicyId := d.Get("l7_policy_id").(string)
-	response, err := service.GetL7Policy(vpcId, listenerId, l7PolicyId)
+	response, err := service.GetL7Policy(ctx, vpcId, listenerId, l7PolicyId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
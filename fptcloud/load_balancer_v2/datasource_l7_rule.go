@@ -29,7 +29,7 @@ func listL7Rules(ctx context.Context, d *schema.ResourceData, m interface{}) dia
 	vpcId := d.Get("vpc_id").(string)
 	listenerId := d.Get("listener_id").(string)
 	l7PolicyId := d.Get("l7_policy_id").(string)
-	response, err := service.ListL7Rules(vpcId, listenerId, l7PolicyId)
+	response, err := service.ListL7Rules(ctx, vpcId, listenerId, l7PolicyId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -62,7 +62,7 @@ func getL7Rule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.
 	listenerId := d.Get("listener_id").(string)
 	l7PolicyId := d.Get("l7_policy_id").(string)
 	l7RuleId := d.Get("l7_rule_id").(string)
-	response, err := service.GetL7Rule(vpcId, listenerId, l7PolicyId, l7RuleId)
+	response, err := service.GetL7Rule(ctx, vpcId, listenerId, l7PolicyId, l7RuleId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
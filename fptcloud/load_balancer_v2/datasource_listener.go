@@ -28,11 +28,13 @@ func listListeners(ctx context.Context, d *schema.ResourceData, m interface{}) d
 	service := NewLoadBalancerV2Service(client)
 	vpcId := d.Get("vpc_id").(string)
 	loadBalancerId := d.Get("load_balancer_id").(string)
-	response, err := service.ListListeners(vpcId, loadBalancerId, 1, 1000)
+	listeners, err := common.ListAll(ctx, common.DefaultPageSize, func(ctx context.Context, page int, pageSize int) ([]Listener, int, error) {
+		response, err := service.ListListeners(ctx, vpcId, loadBalancerId, page, pageSize)
+		return response.Listeners, response.Total, err
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	listeners := response.Listeners
 	var formattedData []interface{}
 	for _, listener := range listeners {
 		insert_headers := []interface{}{
@@ -124,7 +126,7 @@ func getListener(ctx context.Context, d *schema.ResourceData, m interface{}) dia
 	service := NewLoadBalancerV2Service(client)
 	vpcId := d.Get("vpc_id").(string)
 	listenerId := d.Get("listener_id").(string)
-	response, err := service.GetListener(vpcId, listenerId)
+	response, err := service.GetListener(ctx, vpcId, listenerId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
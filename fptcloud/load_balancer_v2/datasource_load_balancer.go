@@ -27,11 +27,13 @@ func listLoadBalancers(ctx context.Context, d *schema.ResourceData, m interface{
 	client := m.(*common.Client)
 	service := NewLoadBalancerV2Service(client)
 	vpcId := d.Get("vpc_id").(string)
-	response, err := service.ListLoadBalancers(vpcId, 1, 1000)
+	loadBalancers, err := common.ListAll(ctx, common.DefaultPageSize, func(ctx context.Context, page int, pageSize int) ([]LoadBalancer, int, error) {
+		response, err := service.ListLoadBalancers(ctx, vpcId, page, pageSize)
+		return response.LoadBalancers, response.Total, err
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	loadBalancers := response.LoadBalancers
 	var formattedData []interface{}
 	for _, lb := range loadBalancers {
 		size := []interface{}{
@@ -88,7 +90,7 @@ func getLoadBalancer(ctx context.Context, d *schema.ResourceData, m interface{})
 	service := NewLoadBalancerV2Service(client)
 	loadBalancerId := d.Get("load_balancer_id").(string)
 	vpcId := d.Get("vpc_id").(string)
-	response, err := service.GetLoadBalancer(vpcId, loadBalancerId)
+	response, err := service.GetLoadBalancer(ctx, vpcId, loadBalancerId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -28,11 +28,13 @@ func listPools(ctx context.Context, d *schema.ResourceData, m interface{}) diag.
 	service := NewLoadBalancerV2Service(client)
 	vpcId := d.Get("vpc_id").(string)
 	loadBalancerId := d.Get("load_balancer_id").(string)
-	response, err := service.ListPools(vpcId, loadBalancerId, 1, 1000)
+	pools, err := common.ListAll(ctx, common.DefaultPageSize, func(ctx context.Context, page int, pageSize int) ([]Pool, int, error) {
+		response, err := service.ListPools(ctx, vpcId, loadBalancerId, page, pageSize)
+		return response.Pools, response.Total, err
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	pools := response.Pools
 	var formattedData []interface{}
 	for _, pool := range pools {
 		health_monitor := []interface{}{
@@ -109,7 +111,7 @@ func getPool(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Di
 	service := NewLoadBalancerV2Service(client)
 	vpcId := d.Get("vpc_id").(string)
 	poolId := d.Get("pool_id").(string)
-	data, err := service.GetPool(vpcId, poolId)
+	data, err := service.GetPool(ctx, vpcId, poolId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -20,7 +20,7 @@ func listSizes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.
 	client := m.(*common.Client)
 	service := NewLoadBalancerV2Service(client)
 	vpcId := d.Get("vpc_id").(string)
-	response, err := service.ListSizes(vpcId)
+	response, err := service.ListSizes(ctx, vpcId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -1,6 +1,7 @@
 package fptcloud_load_balancer_v2
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	common "terraform-provider-fptcloud/commons"
@@ -8,44 +9,44 @@ import (
 
 type LoadBalancerV2Service interface {
 	//Load Balancer
-	ListLoadBalancers(vpcId string, page int, pageSize int) (LoadBalancerListResponse, error)
-	GetLoadBalancer(vpcId string, loadBalancerId string) (LoadBalancerDetailResponse, error)
-	ReadLoadBalancer(vpcId string, loadBalancerId string) (LoadBalancerReadResponse, error)
-	CreateLoadBalancer(vpcId string, req LoadBalancerCreateModel) (LoadBalancerResponse, error)
-	UpdateLoadBalancer(vpcId string, loadBalancerId string, req LoadBalancerUpdateModel) (LoadBalancerResponse, error)
-	ResizeLoadBalancer(vpcId string, loadBalancerId string, req LoadBalancerResizeModel) (LoadBalancerResponse, error)
-	DeleteLoadBalancer(vpcId string, loadBalancerId string) (LoadBalancerResponse, error)
+	ListLoadBalancers(ctx context.Context, vpcId string, page int, pageSize int) (LoadBalancerListResponse, error)
+	GetLoadBalancer(ctx context.Context, vpcId string, loadBalancerId string) (LoadBalancerDetailResponse, error)
+	ReadLoadBalancer(ctx context.Context, vpcId string, loadBalancerId string) (LoadBalancerReadResponse, error)
+	CreateLoadBalancer(ctx context.Context, vpcId string, req LoadBalancerCreateModel) (LoadBalancerResponse, error)
+	UpdateLoadBalancer(ctx context.Context, vpcId string, loadBalancerId string, req LoadBalancerUpdateModel) (LoadBalancerResponse, error)
+	ResizeLoadBalancer(ctx context.Context, vpcId string, loadBalancerId string, req LoadBalancerResizeModel) (LoadBalancerResponse, error)
+	DeleteLoadBalancer(ctx context.Context, vpcId string, loadBalancerId string) (LoadBalancerResponse, error)
 	//Listener
-	ListListeners(vpcId string, loadBalancerId string, page int, pageSize int) (ListenerListResponse, error)
-	GetListener(vpcId string, listenerId string) (ListenerDetailResponse, error)
-	CreateListener(vpcId string, loadBalancerId string, req ListenerCreateModel) (ListenerResponse, error)
-	UpdateListener(vpcId string, listenerId string, req ListenerUpdateModel) (ListenerResponse, error)
-	DeleteListener(vpcId string, listenerId string) (ListenerResponse, error)
+	ListListeners(ctx context.Context, vpcId string, loadBalancerId string, page int, pageSize int) (ListenerListResponse, error)
+	GetListener(ctx context.Context, vpcId string, listenerId string) (ListenerDetailResponse, error)
+	CreateListener(ctx context.Context, vpcId string, loadBalancerId string, req ListenerCreateModel) (ListenerResponse, error)
+	UpdateListener(ctx context.Context, vpcId string, listenerId string, req ListenerUpdateModel) (ListenerResponse, error)
+	DeleteListener(ctx context.Context, vpcId string, listenerId string) (ListenerResponse, error)
 	//Pool
-	ListPools(vpcId string, loadBalancerId string, page int, pageSize int) (PoolListResponse, error)
-	GetPool(vpcId string, poolId string) (PoolDetailResponse, error)
-	CreatePool(vpcId string, loadBalancerId string, req PoolCreateModel) (PoolResponse, error)
-	UpdatePool(vpcId string, poolId string, req PoolUpdateModel) (PoolResponse, error)
-	DeletePool(vpcId string, poolId string) (PoolResponse, error)
+	ListPools(ctx context.Context, vpcId string, loadBalancerId string, page int, pageSize int) (PoolListResponse, error)
+	GetPool(ctx context.Context, vpcId string, poolId string) (PoolDetailResponse, error)
+	CreatePool(ctx context.Context, vpcId string, loadBalancerId string, req PoolCreateModel) (PoolResponse, error)
+	UpdatePool(ctx context.Context, vpcId string, poolId string, req PoolUpdateModel) (PoolResponse, error)
+	DeletePool(ctx context.Context, vpcId string, poolId string) (PoolResponse, error)
 	//Certificate
-	ListCertificates(vpcId string, page int, pageSize int) (CertificateListResponse, error)
-	GetCertificate(vpcId string, certificateId string) (CertificateDetailResponse, error)
-	CreateCertificate(vpcId string, req CertificateCreateModel) (CertificateResponse, error)
-	DeleteCertificate(vpcId string, certificateId string) (CertificateResponse, error)
+	ListCertificates(ctx context.Context, vpcId string, page int, pageSize int) (CertificateListResponse, error)
+	GetCertificate(ctx context.Context, vpcId string, certificateId string) (CertificateDetailResponse, error)
+	CreateCertificate(ctx context.Context, vpcId string, req CertificateCreateModel) (CertificateResponse, error)
+	DeleteCertificate(ctx context.Context, vpcId string, certificateId string) (CertificateResponse, error)
 	//L7 Policy
-	ListL7Policies(vpcId string, listenerId string) (L7PolicyListResponse, error)
-	GetL7Policy(vpcId string, listenerId string, policyId string) (L7PolicyDetailResponse, error)
-	CreateL7Policy(vpcId string, listenerId string, req L7PolicyInput) (L7PolicyResponse, error)
-	UpdateL7Policy(vpcId string, listenerId string, policyId string, req L7PolicyInput) (L7PolicyResponse, error)
-	DeleteL7Policy(vpcId string, listenerId string, policyId string) (L7PolicyResponse, error)
+	ListL7Policies(ctx context.Context, vpcId string, listenerId string) (L7PolicyListResponse, error)
+	GetL7Policy(ctx context.Context, vpcId string, listenerId string, policyId string) (L7PolicyDetailResponse, error)
+	CreateL7Policy(ctx context.Context, vpcId string, listenerId string, req L7PolicyInput) (L7PolicyResponse, error)
+	UpdateL7Policy(ctx context.Context, vpcId string, listenerId string, policyId string, req L7PolicyInput) (L7PolicyResponse, error)
+	DeleteL7Policy(ctx context.Context, vpcId string, listenerId string, policyId string) (L7PolicyResponse, error)
 	//L7 Rule
-	ListL7Rules(vpcId string, listenerId string, policyId string) (L7RuleListResponse, error)
-	GetL7Rule(vpcId string, listenerId string, policyId string, ruleId string) (L7RuleDetailResponse, error)
-	CreateL7Rule(vpcId string, listenerId string, policyId string, req L7RuleInput) (L7RuleResponse, error)
-	UpdateL7Rule(vpcId string, listenerId string, policyId string, ruleId string, req L7RuleInput) (L7RuleResponse, error)
-	DeleteL7Rule(vpcId string, listenerId string, policyId string, ruleId string) (L7RuleResponse, error)
+	ListL7Rules(ctx context.Context, vpcId string, listenerId string, policyId string) (L7RuleListResponse, error)
+	GetL7Rule(ctx context.Context, vpcId string, listenerId string, policyId string, ruleId string) (L7RuleDetailResponse, error)
+	CreateL7Rule(ctx context.Context, vpcId string, listenerId string, policyId string, req L7RuleInput) (L7RuleResponse, error)
+	UpdateL7Rule(ctx context.Context, vpcId string, listenerId string, policyId string, ruleId string, req L7RuleInput) (L7RuleResponse, error)
+	DeleteL7Rule(ctx context.Context, vpcId string, listenerId string, policyId string, ruleId string) (L7RuleResponse, error)
 	//Size
-	ListSizes(vpcId string) (SizeListResponse, error)
+	ListSizes(ctx context.Context, vpcId string) (SizeListResponse, error)
 }
 
 type LoadBalancerV2ServiceImpl struct {
@@ -56,11 +57,11 @@ func NewLoadBalancerV2Service(client *common.Client) LoadBalancerV2Service {
 	return &LoadBalancerV2ServiceImpl{client: client}
 }
 
-func (s *LoadBalancerV2ServiceImpl) ListLoadBalancers(vpcId string, page int, pageSize int) (LoadBalancerListResponse, error) {
+func (s *LoadBalancerV2ServiceImpl) ListLoadBalancers(ctx context.Context, vpcId string, page int, pageSize int) (LoadBalancerListResponse, error) {
 	apiPath := common.ApiPath.ListLoadBalancers(vpcId, page, pageSize)
-	resp, err := s.client.SendGetRequest(apiPath)
+	resp, err := s.client.SendGetRequestWithContext(ctx, apiPath)
 	if err != nil {
-		return LoadBalancerListResponse{Total: 0}, fmt.Errorf("list load balancers request failed: %v", err)
+		return LoadBalancerListResponse{Total: 0}, fmt.Errorf("list load balancers request failed: %w", common.DecodeError(err))
 	}
 	var result LoadBalancerListResponse
 	err = json.Unmarshal(resp, &result)
@@ -70,11 +71,11 @@ func (s *LoadBalancerV2ServiceImpl) ListLoadBalancers(vpcId string, page int, pa
 	return result, nil
 }
 
-func (s *LoadBalancerV2ServiceImpl) GetLoadBalancer(vpcId string, loadBalancerId string) (LoadBalancerDetailResponse, error) {
+func (s *LoadBalancerV2ServiceImpl) GetLoadBalancer(ctx context.Context, vpcId string, loadBalancerId string) (LoadBalancerDetailResponse, error) {
 	apiPath := common.ApiPath.GetLoadBalancer(vpcId, loadBalancerId)
-	resp, err := s.client.SendGetRequest(apiPath)
+	resp, err := s.client.SendGetRequestWithContext(ctx, apiPath)
 	if err != nil {
-		return LoadBalancerDetailResponse{}, fmt.Errorf("get load balancer request failed: %v", err)
+		return LoadBalancerDetailResponse{}, fmt.Errorf("get load balancer request failed: %w", common.DecodeError(err))
 	}
 	var result LoadBalancerDetailResponse
 	err = json.Unmarshal(resp, &result)
@@ -84,11 +85,11 @@ func (s *LoadBalancerV2ServiceImpl) GetLoadBalancer(vpcId string, loadBalancerId
 	return result, nil
 }
 
-func (s *LoadBalancerV2ServiceImpl) ReadLoadBalancer(vpcId string, loadBalancerId string) (LoadBalancerReadResponse, error) {
+func (s *LoadBalancerV2ServiceImpl) ReadLoadBalancer(ctx context.Context, vpcId string, loadBalancerId string) (LoadBalancerReadResponse, error) {
 	apiPath := common.ApiPath.ReadLoadBalancer(vpcId, loadBalancerId)
-	resp, err := s.client.SendGetRequest(apiPath)
+	resp, err := s.client.SendGetRequestWithContext(ctx, apiPath)
 	if err != nil {
-		return LoadBalancerReadResponse{}, fmt.Errorf("read load balancer request failed: %v", err)
+		return LoadBalancerReadResponse{}, fmt.Errorf("read load balancer request failed: %w", common.DecodeError(err))
 	}
 	var result LoadBalancerReadResponse
 	err = json.Unmarshal(resp, &result)
@@ -98,11 +99,11 @@ func (s *LoadBalancerV2ServiceImpl) ReadLoadBalancer(vpcId string, loadBalancerI
 	return result, nil
 }
 
-func (s *LoadBalancerV2ServiceImpl) CreateLoadBalancer(vpcId string, req LoadBalancerCreateModel) (LoadBalancerResponse, error) {
+func (s *LoadBalancerV2ServiceImpl) CreateLoadBalancer(ctx context.Context, vpcId string, req LoadBalancerCreateModel) (LoadBalancerResponse, error) {
 	apiPath := common.ApiPath.CreateLoadBalancer(vpcId)
-	resp, err := s.client.SendPostRequest(apiPath, req)
+	resp, err := s.client.SendPostRequestWithContext(ctx, apiPath, req)
 	if err != nil {
-		return LoadBalancerResponse{}, fmt.Errorf("create load balancer request failed: %v", err)
+		return LoadBalancerResponse{}, fmt.Errorf("create load balancer request failed: %w", common.DecodeError(err))
 	}
 	var result LoadBalancerResponse
 	err = json.Unmarshal(resp, &result)
@@ -112,11 +113,11 @@ func (s *LoadBalancerV2ServiceImpl) CreateLoadBalancer(vpcId string, req LoadBal
 	return result, nil
 }
 
-func (s *LoadBalancerV2ServiceImpl) UpdateLoadBalancer(vpcId string, loadBalancerId string, req LoadBalancerUpdateModel) (LoadBalancerResponse, error) {
+func (s *LoadBalancerV2ServiceImpl) UpdateLoadBalancer(ctx context.Context, vpcId string, loadBalancerId string, req LoadBalancerUpdateModel) (LoadBalancerResponse, error) {
 	apiPath := common.ApiPath.UpdateLoadBalancer(vpcId, loadBalancerId)
-	resp, err := s.client.SendPutRequest(apiPath, req)
+	resp, err := s.client.SendPutRequestWithContext(ctx, apiPath, req)
 	if err != nil {
-		return LoadBalancerResponse{}, fmt.Errorf("update load balancer request failed: %v", err)
+		return LoadBalancerResponse{}, fmt.Errorf("update load balancer request failed: %w", common.DecodeError(err))
 	}
 	var result LoadBalancerResponse
 	err = json.Unmarshal(resp, &result)
@@ -126,11 +127,11 @@ func (s *LoadBalancerV2ServiceImpl) UpdateLoadBalancer(vpcId string, loadBalance
 	return result, nil
 }
 
-func (s *LoadBalancerV2ServiceImpl) ResizeLoadBalancer(vpcId string, loadBalancerId string, req LoadBalancerResizeModel) (LoadBalancerResponse, error) {
+func (s *LoadBalancerV2ServiceImpl) ResizeLoadBalancer(ctx context.Context, vpcId string, loadBalancerId string, req LoadBalancerResizeModel) (LoadBalancerResponse, error) {
 	apiPath := common.ApiPath.ResizeLoadBalancer(vpcId, loadBalancerId)
-	resp, err := s.client.SendPutRequest(apiPath, req)
+	resp, err := s.client.SendPutRequestWithContext(ctx, apiPath, req)
 	if err != nil {
-		return LoadBalancerResponse{}, fmt.Errorf("resize load balancer request failed: %v", err)
+		return LoadBalancerResponse{}, fmt.Errorf("resize load balancer request failed: %w", common.DecodeError(err))
 	}
 	var result LoadBalancerResponse
 	err = json.Unmarshal(resp, &result)
@@ -140,11 +141,11 @@ func (s *LoadBalancerV2ServiceImpl) ResizeLoadBalancer(vpcId string, loadBalance
 	return result, nil
 }
 
-func (s *LoadBalancerV2ServiceImpl) DeleteLoadBalancer(vpcId string, loadBalancerId string) (LoadBalancerResponse, error) {
+func (s *LoadBalancerV2ServiceImpl) DeleteLoadBalancer(ctx context.Context, vpcId string, loadBalancerId string) (LoadBalancerResponse, error) {
 	apiPath := common.ApiPath.DeleteLoadBalancer(vpcId, loadBalancerId)
-	resp, err := s.client.SendDeleteRequest(apiPath)
+	resp, err := s.client.SendDeleteRequestWithContext(ctx, apiPath)
 	if err != nil {
-		return LoadBalancerResponse{}, fmt.Errorf("delete load balancer request failed: %v", err)
+		return LoadBalancerResponse{}, fmt.Errorf("delete load balancer request failed: %w", common.DecodeError(err))
 	}
 	var result LoadBalancerResponse
 	err = json.Unmarshal(resp, &result)
@@ -154,11 +155,11 @@ func (s *LoadBalancerV2ServiceImpl) DeleteLoadBalancer(vpcId string, loadBalance
 	return result, nil
 }
 
-func (s *LoadBalancerV2ServiceImpl) ListListeners(vpcId string, loadBalancerId string, page int, pageSize int) (ListenerListResponse, error) {
+func (s *LoadBalancerV2ServiceImpl) ListListeners(ctx context.Context, vpcId string, loadBalancerId string, page int, pageSize int) (ListenerListResponse, error) {
 	apiPath := common.ApiPath.ListListeners(vpcId, loadBalancerId, page, pageSize)
-	resp, err := s.client.SendGetRequest(apiPath)
+	resp, err := s.client.SendGetRequestWithContext(ctx, apiPath)
 	if err != nil {
-		return ListenerListResponse{Total: 0}, fmt.Errorf("list listeners request failed: %v", err)
+		return ListenerListResponse{Total: 0}, fmt.Errorf("list listeners request failed: %w", common.DecodeError(err))
 	}
 	var result ListenerListResponse
 	err = json.Unmarshal(resp, &result)
@@ -168,11 +169,11 @@ func (s *LoadBalancerV2ServiceImpl) ListListeners(vpcId string, loadBalancerId s
 	return result, nil
 }
 
-func (s *LoadBalancerV2ServiceImpl) GetListener(vpcId string, listenerId string) (ListenerDetailResponse, error) {
+func (s *LoadBalancerV2ServiceImpl) GetListener(ctx context.Context, vpcId string, listenerId string) (ListenerDetailResponse, error) {
 	apiPath := common.ApiPath.GetListener(vpcId, listenerId)
-	resp, err := s.client.SendGetRequest(apiPath)
+	resp, err := s.client.SendGetRequestWithContext(ctx, apiPath)
 	if err != nil {
-		return ListenerDetailResponse{}, fmt.Errorf("get listener request fail: %v", err)
+		return ListenerDetailResponse{}, fmt.Errorf("get listener request fail: %w", common.DecodeError(err))
 	}
 	var result ListenerDetailResponse
 	err = json.Unmarshal(resp, &result)
@@ -182,11 +183,11 @@ func (s *LoadBalancerV2ServiceImpl) GetListener(vpcId string, listenerId string)
 	return result, nil
 }
 
-func (s *LoadBalancerV2ServiceImpl) CreateListener(vpcId string, loadBalancerId string, req ListenerCreateModel) (ListenerResponse, error) {
+func (s *LoadBalancerV2ServiceImpl) CreateListener(ctx context.Context, vpcId string, loadBalancerId string, req ListenerCreateModel) (ListenerResponse, error) {
 	apiPath := common.ApiPath.CreateListener(vpcId, loadBalancerId)
-	resp, err := s.client.SendPostRequest(apiPath, req)
+	resp, err := s.client.SendPostRequestWithContext(ctx, apiPath, req)
 	if err != nil {
-		return ListenerResponse{}, fmt.Errorf("create listener request failed: %v", err)
+		return ListenerResponse{}, fmt.Errorf("create listener request failed: %w", common.DecodeError(err))
 	}
 	var result ListenerResponse
 	err = json.Unmarshal(resp, &result)
@@ -196,11 +197,11 @@ func (s *LoadBalancerV2ServiceImpl) CreateListener(vpcId string, loadBalancerId
 	return result, nil
 }
 
-func (s *LoadBalancerV2ServiceImpl) UpdateListener(vpcId string, listenerId string, req ListenerUpdateModel) (ListenerResponse, error) {
+func (s *LoadBalancerV2ServiceImpl) UpdateListener(ctx context.Context, vpcId string, listenerId string, req ListenerUpdateModel) (ListenerResponse, error) {
 	apiPath := common.ApiPath.UpdateListener(vpcId, listenerId)
-	resp, err := s.client.SendPutRequest(apiPath, req)
+	resp, err := s.client.SendPutRequestWithContext(ctx, apiPath, req)
 	if err != nil {
-		return ListenerResponse{}, fmt.Errorf("update listener request failed: %v", err)
+		return ListenerResponse{}, fmt.Errorf("update listener request failed: %w", common.DecodeError(err))
 	}
 	var result ListenerResponse
 	err = json.Unmarshal(resp, &result)
@@ -210,11 +211,11 @@ func (s *LoadBalancerV2ServiceImpl) UpdateListener(vpcId string, listenerId stri
 	return result, nil
 }
 
-func (s *LoadBalancerV2ServiceImpl) DeleteListener(vpcId string, listenerId string) (ListenerResponse, error) {
+func (s *LoadBalancerV2ServiceImpl) DeleteListener(ctx context.Context, vpcId string, listenerId string) (ListenerResponse, error) {
 	apiPath := common.ApiPath.DeleteListener(vpcId, listenerId)
-	resp, err := s.client.SendDeleteRequest(apiPath)
+	resp, err := s.client.SendDeleteRequestWithContext(ctx, apiPath)
 	if err != nil {
-		return ListenerResponse{}, fmt.Errorf("delete listener request failed: %v", err)
+		return ListenerResponse{}, fmt.Errorf("delete listener request failed: %w", common.DecodeError(err))
 	}
 	var result ListenerResponse
 	err = json.Unmarshal(resp, &result)
@@ -224,11 +225,11 @@ func (s *LoadBalancerV2ServiceImpl) DeleteListener(vpcId string, listenerId stri
 	return result, nil
 }
 
-func (s *LoadBalancerV2ServiceImpl) ListPools(vpcId string, loadBalancerId string, page int, pageSize int) (PoolListResponse, error) {
+func (s *LoadBalancerV2ServiceImpl) ListPools(ctx context.Context, vpcId string, loadBalancerId string, page int, pageSize int) (PoolListResponse, error) {
 	apiPath := common.ApiPath.ListPools(vpcId, loadBalancerId, page, pageSize)
-	resp, err := s.client.SendGetRequest(apiPath)
+	resp, err := s.client.SendGetRequestWithContext(ctx, apiPath)
 	if err != nil {
-		return PoolListResponse{Total: 0}, fmt.Errorf("list pools request fail: %v", err)
+		return PoolListResponse{Total: 0}, fmt.Errorf("list pools request fail: %w", common.DecodeError(err))
 	}
 	var result PoolListResponse
 	err = json.Unmarshal(resp, &result)
@@ -238,11 +239,11 @@ func (s *LoadBalancerV2ServiceImpl) ListPools(vpcId string, loadBalancerId strin
 	return result, nil
 }
 
-func (s *LoadBalancerV2ServiceImpl) GetPool(vpcId string, poolId string) (PoolDetailResponse, error) {
+func (s *LoadBalancerV2ServiceImpl) GetPool(ctx context.Context, vpcId string, poolId string) (PoolDetailResponse, error) {
 	apiPath := common.ApiPath.GetPool(vpcId, poolId)
-	resp, err := s.client.SendGetRequest(apiPath)
+	resp, err := s.client.SendGetRequestWithContext(ctx, apiPath)
 	if err != nil {
-		return PoolDetailResponse{}, fmt.Errorf("get pool request fail: %v", err)
+		return PoolDetailResponse{}, fmt.Errorf("get pool request fail: %w", common.DecodeError(err))
 	}
 	var result PoolDetailResponse
 	err = json.Unmarshal(resp, &result)
@@ -252,11 +253,11 @@ func (s *LoadBalancerV2ServiceImpl) GetPool(vpcId string, poolId string) (PoolDe
 	return result, nil
 }
 
-func (s *LoadBalancerV2ServiceImpl) CreatePool(vpcId string, loadBalancerId string, req PoolCreateModel) (PoolResponse, error) {
+func (s *LoadBalancerV2ServiceImpl) CreatePool(ctx context.Context, vpcId string, loadBalancerId string, req PoolCreateModel) (PoolResponse, error) {
 	apiPath := common.ApiPath.CreatePool(vpcId, loadBalancerId)
-	resp, err := s.client.SendPostRequest(apiPath, req)
+	resp, err := s.client.SendPostRequestWithContext(ctx, apiPath, req)
 	if err != nil {
-		return PoolResponse{}, fmt.Errorf("create pool request fail: %v", err)
+		return PoolResponse{}, fmt.Errorf("create pool request fail: %w", common.DecodeError(err))
 	}
 	var result PoolResponse
 	err = json.Unmarshal(resp, &result)
@@ -266,11 +267,11 @@ func (s *LoadBalancerV2ServiceImpl) CreatePool(vpcId string, loadBalancerId stri
 	return result, nil
 }
 
-func (s *LoadBalancerV2ServiceImpl) UpdatePool(vpcId string, poolId string, req PoolUpdateModel) (PoolResponse, error) {
+func (s *LoadBalancerV2ServiceImpl) UpdatePool(ctx context.Context, vpcId string, poolId string, req PoolUpdateModel) (PoolResponse, error) {
 	apiPath := common.ApiPath.UpdatePool(vpcId, poolId)
-	resp, err := s.client.SendPutRequest(apiPath, req)
+	resp, err := s.client.SendPutRequestWithContext(ctx, apiPath, req)
 	if err != nil {
-		return PoolResponse{}, fmt.Errorf("update pool request fail: %v", err)
+		return PoolResponse{}, fmt.Errorf("update pool request fail: %w", common.DecodeError(err))
 	}
 	var result PoolResponse
 	err = json.Unmarshal(resp, &result)
@@ -280,11 +281,11 @@ func (s *LoadBalancerV2ServiceImpl) UpdatePool(vpcId string, poolId string, req
 	return result, nil
 }
 
-func (s *LoadBalancerV2ServiceImpl) DeletePool(vpcId string, poolId string) (PoolResponse, error) {
+func (s *LoadBalancerV2ServiceImpl) DeletePool(ctx context.Context, vpcId string, poolId string) (PoolResponse, error) {
 	apiPath := common.ApiPath.DeletePool(vpcId, poolId)
-	resp, err := s.client.SendDeleteRequest(apiPath)
+	resp, err := s.client.SendDeleteRequestWithContext(ctx, apiPath)
 	if err != nil {
-		return PoolResponse{}, fmt.Errorf("delete pool request fail: %v", err)
+		return PoolResponse{}, fmt.Errorf("delete pool request fail: %w", common.DecodeError(err))
 	}
 	var result PoolResponse
 	err = json.Unmarshal(resp, &result)
@@ -294,11 +295,11 @@ func (s *LoadBalancerV2ServiceImpl) DeletePool(vpcId string, poolId string) (Poo
 	return result, nil
 }
 
-func (s *LoadBalancerV2ServiceImpl) ListCertificates(vpcId string, page int, pageSize int) (CertificateListResponse, error) {
+func (s *LoadBalancerV2ServiceImpl) ListCertificates(ctx context.Context, vpcId string, page int, pageSize int) (CertificateListResponse, error) {
 	apiPath := common.ApiPath.ListCertificates(vpcId, page, pageSize)
-	resp, err := s.client.SendGetRequest(apiPath)
+	resp, err := s.client.SendGetRequestWithContext(ctx, apiPath)
 	if err != nil {
-		return CertificateListResponse{Total: 0}, fmt.Errorf("list certificates request failed: %v", err)
+		return CertificateListResponse{Total: 0}, fmt.Errorf("list certificates request failed: %w", common.DecodeError(err))
 	}
 	var result CertificateListResponse
 	err = json.Unmarshal(resp, &result)
@@ -308,11 +309,11 @@ func (s *LoadBalancerV2ServiceImpl) ListCertificates(vpcId string, page int, pag
 	return result, nil
 }
 
-func (s *LoadBalancerV2ServiceImpl) GetCertificate(vpcId string, certificateId string) (CertificateDetailResponse, error) {
+func (s *LoadBalancerV2ServiceImpl) GetCertificate(ctx context.Context, vpcId string, certificateId string) (CertificateDetailResponse, error) {
 	apiPath := common.ApiPath.GetCertificate(vpcId, certificateId)
-	resp, err := s.client.SendGetRequest(apiPath)
+	resp, err := s.client.SendGetRequestWithContext(ctx, apiPath)
 	if err != nil {
-		return CertificateDetailResponse{}, fmt.Errorf("get certificate request failed: %v", err)
+		return CertificateDetailResponse{}, fmt.Errorf("get certificate request failed: %w", common.DecodeError(err))
 	}
 	var result CertificateDetailResponse
 	err = json.Unmarshal(resp, &result)
@@ -322,11 +323,11 @@ func (s *LoadBalancerV2ServiceImpl) GetCertificate(vpcId string, certificateId s
 	return result, nil
 }
 
-func (s *LoadBalancerV2ServiceImpl) CreateCertificate(vpcId string, req CertificateCreateModel) (CertificateResponse, error) {
+func (s *LoadBalancerV2ServiceImpl) CreateCertificate(ctx context.Context, vpcId string, req CertificateCreateModel) (CertificateResponse, error) {
 	apiPath := common.ApiPath.CreateCertificate(vpcId)
-	resp, err := s.client.SendPostRequest(apiPath, req)
+	resp, err := s.client.SendPostRequestWithContext(ctx, apiPath, req)
 	if err != nil {
-		return CertificateResponse{}, fmt.Errorf("create certificate request failed: %v", err)
+		return CertificateResponse{}, fmt.Errorf("create certificate request failed: %w", common.DecodeError(err))
 	}
 	var result CertificateResponse
 	err = json.Unmarshal(resp, &result)
@@ -336,11 +337,11 @@ func (s *LoadBalancerV2ServiceImpl) CreateCertificate(vpcId string, req Certific
 	return result, nil
 }
 
-func (s *LoadBalancerV2ServiceImpl) DeleteCertificate(vpcId string, certificateId string) (CertificateResponse, error) {
+func (s *LoadBalancerV2ServiceImpl) DeleteCertificate(ctx context.Context, vpcId string, certificateId string) (CertificateResponse, error) {
 	apiPath := common.ApiPath.DeleteCertificate(vpcId, certificateId)
-	resp, err := s.client.SendDeleteRequest(apiPath)
+	resp, err := s.client.SendDeleteRequestWithContext(ctx, apiPath)
 	if err != nil {
-		return CertificateResponse{}, fmt.Errorf("delete certificate request failed: %v", err)
+		return CertificateResponse{}, fmt.Errorf("delete certificate request failed: %w", common.DecodeError(err))
 	}
 	var result CertificateResponse
 	err = json.Unmarshal(resp, &result)
@@ -350,11 +351,11 @@ func (s *LoadBalancerV2ServiceImpl) DeleteCertificate(vpcId string, certificateI
 	return result, nil
 }
 
-func (s *LoadBalancerV2ServiceImpl) ListL7Policies(vpcId string, listenerId string) (L7PolicyListResponse, error) {
+func (s *LoadBalancerV2ServiceImpl) ListL7Policies(ctx context.Context, vpcId string, listenerId string) (L7PolicyListResponse, error) {
 	apiPath := common.ApiPath.ListL7Policies(vpcId, listenerId)
-	resp, err := s.client.SendGetRequest(apiPath)
+	resp, err := s.client.SendGetRequestWithContext(ctx, apiPath)
 	if err != nil {
-		return L7PolicyListResponse{Total: 0}, fmt.Errorf("list L7 policies request failed: %v", err)
+		return L7PolicyListResponse{Total: 0}, fmt.Errorf("list L7 policies request failed: %w", common.DecodeError(err))
 	}
 	var result L7PolicyListResponse
 	err = json.Unmarshal(resp, &result)
@@ -364,11 +365,11 @@ func (s *LoadBalancerV2ServiceImpl) ListL7Policies(vpcId string, listenerId stri
 	return result, nil
 }
 
-func (s *LoadBalancerV2ServiceImpl) GetL7Policy(vpcId string, listenerId string, policyId string) (L7PolicyDetailResponse, error) {
+func (s *LoadBalancerV2ServiceImpl) GetL7Policy(ctx context.Context, vpcId string, listenerId string, policyId string) (L7PolicyDetailResponse, error) {
 	apiPath := common.ApiPath.GetL7Policy(vpcId, listenerId, policyId)
-	resp, err := s.client.SendGetRequest(apiPath)
+	resp, err := s.client.SendGetRequestWithContext(ctx, apiPath)
 	if err != nil {
-		return L7PolicyDetailResponse{}, fmt.Errorf("get L7 policy request failed: %v", err)
+		return L7PolicyDetailResponse{}, fmt.Errorf("get L7 policy request failed: %w", common.DecodeError(err))
 	}
 	var result L7PolicyDetailResponse
 	err = json.Unmarshal(resp, &result)
@@ -378,11 +379,11 @@ func (s *LoadBalancerV2ServiceImpl) GetL7Policy(vpcId string, listenerId string,
 	return result, nil
 }
 
-func (s *LoadBalancerV2ServiceImpl) CreateL7Policy(vpcId string, listenerId string, req L7PolicyInput) (L7PolicyResponse, error) {
+func (s *LoadBalancerV2ServiceImpl) CreateL7Policy(ctx context.Context, vpcId string, listenerId string, req L7PolicyInput) (L7PolicyResponse, error) {
 	apiPath := common.ApiPath.CreateL7Policy(vpcId, listenerId)
-	resp, err := s.client.SendPostRequest(apiPath, req)
+	resp, err := s.client.SendPostRequestWithContext(ctx, apiPath, req)
 	if err != nil {
-		return L7PolicyResponse{}, fmt.Errorf("create L7 policy request failed: %v", err)
+		return L7PolicyResponse{}, fmt.Errorf("create L7 policy request failed: %w", common.DecodeError(err))
 	}
 	var result L7PolicyResponse
 	err = json.Unmarshal(resp, &result)
@@ -392,11 +393,11 @@ func (s *LoadBalancerV2ServiceImpl) CreateL7Policy(vpcId string, listenerId stri
 	return result, nil
 }
 
-func (s *LoadBalancerV2ServiceImpl) UpdateL7Policy(vpcId string, listenerId string, policyId string, req L7PolicyInput) (L7PolicyResponse, error) {
+func (s *LoadBalancerV2ServiceImpl) UpdateL7Policy(ctx context.Context, vpcId string, listenerId string, policyId string, req L7PolicyInput) (L7PolicyResponse, error) {
 	apiPath := common.ApiPath.UpdateL7Policy(vpcId, listenerId, policyId)
-	resp, err := s.client.SendPutRequest(apiPath, req)
+	resp, err := s.client.SendPutRequestWithContext(ctx, apiPath, req)
 	if err != nil {
-		return L7PolicyResponse{}, fmt.Errorf("update L7 policy request failed: %v", err)
+		return L7PolicyResponse{}, fmt.Errorf("update L7 policy request failed: %w", common.DecodeError(err))
 	}
 	var result L7PolicyResponse
 	err = json.Unmarshal(resp, &result)
@@ -406,11 +407,11 @@ func (s *LoadBalancerV2ServiceImpl) UpdateL7Policy(vpcId string, listenerId stri
 	return result, nil
 }
 
-func (s *LoadBalancerV2ServiceImpl) DeleteL7Policy(vpcId string, listenerId string, policyId string) (L7PolicyResponse, error) {
+func (s *LoadBalancerV2ServiceImpl) DeleteL7Policy(ctx context.Context, vpcId string, listenerId string, policyId string) (L7PolicyResponse, error) {
 	apiPath := common.ApiPath.DeleteL7Policy(vpcId, listenerId, policyId)
-	resp, err := s.client.SendDeleteRequest(apiPath)
+	resp, err := s.client.SendDeleteRequestWithContext(ctx, apiPath)
 	if err != nil {
-		return L7PolicyResponse{}, fmt.Errorf("delete L7 policy request failed: %v", err)
+		return L7PolicyResponse{}, fmt.Errorf("delete L7 policy request failed: %w", common.DecodeError(err))
 	}
 	var result L7PolicyResponse
 	err = json.Unmarshal(resp, &result)
@@ -420,11 +421,11 @@ func (s *LoadBalancerV2ServiceImpl) DeleteL7Policy(vpcId string, listenerId stri
 	return result, nil
 }
 
-func (s *LoadBalancerV2ServiceImpl) ListL7Rules(vpcId string, listenerId string, policyId string) (L7RuleListResponse, error) {
+func (s *LoadBalancerV2ServiceImpl) ListL7Rules(ctx context.Context, vpcId string, listenerId string, policyId string) (L7RuleListResponse, error) {
 	apiPath := common.ApiPath.ListL7Rules(vpcId, listenerId, policyId)
-	resp, err := s.client.SendGetRequest(apiPath)
+	resp, err := s.client.SendGetRequestWithContext(ctx, apiPath)
 	if err != nil {
-		return L7RuleListResponse{Total: 0}, fmt.Errorf("list L7 rules request failed: %v", err)
+		return L7RuleListResponse{Total: 0}, fmt.Errorf("list L7 rules request failed: %w", common.DecodeError(err))
 	}
 	var result L7RuleListResponse
 	err = json.Unmarshal(resp, &result)
@@ -434,11 +435,11 @@ func (s *LoadBalancerV2ServiceImpl) ListL7Rules(vpcId string, listenerId string,
 	return result, nil
 }
 
-func (s *LoadBalancerV2ServiceImpl) GetL7Rule(vpcId string, listenerId string, policyId string, ruleId string) (L7RuleDetailResponse, error) {
+func (s *LoadBalancerV2ServiceImpl) GetL7Rule(ctx context.Context, vpcId string, listenerId string, policyId string, ruleId string) (L7RuleDetailResponse, error) {
 	apiPath := common.ApiPath.GetL7Rule(vpcId, listenerId, policyId, ruleId)
-	resp, err := s.client.SendGetRequest(apiPath)
+	resp, err := s.client.SendGetRequestWithContext(ctx, apiPath)
 	if err != nil {
-		return L7RuleDetailResponse{}, fmt.Errorf("get L7 rule request failed: %v", err)
+		return L7RuleDetailResponse{}, fmt.Errorf("get L7 rule request failed: %w", common.DecodeError(err))
 	}
 	var result L7RuleDetailResponse
 	err = json.Unmarshal(resp, &result)
@@ -448,11 +449,11 @@ func (s *LoadBalancerV2ServiceImpl) GetL7Rule(vpcId string, listenerId string, p
 	return result, nil
 }
 
-func (s *LoadBalancerV2ServiceImpl) CreateL7Rule(vpcId string, listenerId string, policyId string, req L7RuleInput) (L7RuleResponse, error) {
+func (s *LoadBalancerV2ServiceImpl) CreateL7Rule(ctx context.Context, vpcId string, listenerId string, policyId string, req L7RuleInput) (L7RuleResponse, error) {
 	apiPath := common.ApiPath.CreateL7Rule(vpcId, listenerId, policyId)
-	resp, err := s.client.SendPostRequest(apiPath, req)
+	resp, err := s.client.SendPostRequestWithContext(ctx, apiPath, req)
 	if err != nil {
-		return L7RuleResponse{}, fmt.Errorf("create L7 rule request failed: %v", err)
+		return L7RuleResponse{}, fmt.Errorf("create L7 rule request failed: %w", common.DecodeError(err))
 	}
 	var result L7RuleResponse
 	err = json.Unmarshal(resp, &result)
@@ -462,11 +463,11 @@ func (s *LoadBalancerV2ServiceImpl) CreateL7Rule(vpcId string, listenerId string
 	return result, nil
 }
 
-func (s *LoadBalancerV2ServiceImpl) UpdateL7Rule(vpcId string, listenerId string, policyId string, ruleId string, req L7RuleInput) (L7RuleResponse, error) {
+func (s *LoadBalancerV2ServiceImpl) UpdateL7Rule(ctx context.Context, vpcId string, listenerId string, policyId string, ruleId string, req L7RuleInput) (L7RuleResponse, error) {
 	apiPath := common.ApiPath.UpdateL7Rule(vpcId, listenerId, policyId, ruleId)
-	resp, err := s.client.SendPostRequest(apiPath, req)
+	resp, err := s.client.SendPostRequestWithContext(ctx, apiPath, req)
 	if err != nil {
-		return L7RuleResponse{}, fmt.Errorf("update L7 rule request failed: %v", err)
+		return L7RuleResponse{}, fmt.Errorf("update L7 rule request failed: %w", common.DecodeError(err))
 	}
 	var result L7RuleResponse
 	err = json.Unmarshal(resp, &result)
@@ -476,11 +477,11 @@ func (s *LoadBalancerV2ServiceImpl) UpdateL7Rule(vpcId string, listenerId string
 	return result, nil
 }
 
-func (s *LoadBalancerV2ServiceImpl) DeleteL7Rule(vpcId string, listenerId string, policyId string, ruleId string) (L7RuleResponse, error) {
+func (s *LoadBalancerV2ServiceImpl) DeleteL7Rule(ctx context.Context, vpcId string, listenerId string, policyId string, ruleId string) (L7RuleResponse, error) {
 	apiPath := common.ApiPath.DeleteL7Rule(vpcId, listenerId, policyId, ruleId)
-	resp, err := s.client.SendDeleteRequest(apiPath)
+	resp, err := s.client.SendDeleteRequestWithContext(ctx, apiPath)
 	if err != nil {
-		return L7RuleResponse{}, fmt.Errorf("delete L7 rule request failed: %v", err)
+		return L7RuleResponse{}, fmt.Errorf("delete L7 rule request failed: %w", common.DecodeError(err))
 	}
 	var result L7RuleResponse
 	err = json.Unmarshal(resp, &result)
@@ -490,11 +491,11 @@ func (s *LoadBalancerV2ServiceImpl) DeleteL7Rule(vpcId string, listenerId string
 	return result, nil
 }
 
-func (s *LoadBalancerV2ServiceImpl) ListSizes(vpcId string) (SizeListResponse, error) {
+func (s *LoadBalancerV2ServiceImpl) ListSizes(ctx context.Context, vpcId string) (SizeListResponse, error) {
 	apiPath := common.ApiPath.ListSizes(vpcId)
-	resp, err := s.client.SendGetRequest(apiPath)
+	resp, err := s.client.SendCachedGetRequestWithContext(ctx, apiPath)
 	if err != nil {
-		return SizeListResponse{Total: 0}, fmt.Errorf("list sizes request failed: %v", err)
+		return SizeListResponse{Total: 0}, fmt.Errorf("list sizes request failed: %w", common.DecodeError(err))
 	}
 	var result SizeListResponse
 	err = json.Unmarshal(resp, &result)
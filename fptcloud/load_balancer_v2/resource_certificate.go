@@ -3,6 +3,7 @@ package fptcloud_load_balancer_v2
 import (
 	"context"
 	"fmt"
+	"log"
 	"strings"
 	common "terraform-provider-fptcloud/commons"
 
@@ -42,8 +43,13 @@ func readCertificate(ctx context.Context, d *schema.ResourceData, m interface{})
 	service := NewLoadBalancerV2Service(client)
 	vpcId := d.Get("vpc_id").(string)
 	certificateId := d.Id()
-	response, err := service.GetCertificate(vpcId, certificateId)
+	response, err := service.GetCertificate(ctx, vpcId, certificateId)
 	if err != nil {
+		if common.IsNotFound(err) {
+			log.Printf("[WARN] Certificate %s not found, removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return diag.FromErr(err)
 	}
 	certificate := response.Certificate
@@ -64,7 +70,7 @@ func createCertificate(ctx context.Context, d *schema.ResourceData, m interface{
 	payload.PrivateKey = d.Get("private_key").(string)
 	payload.CertChain = d.Get("cert_chain").(string)
 
-	response, err := service.CreateCertificate(vpcId, payload)
+	response, err := service.CreateCertificate(ctx, vpcId, payload)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -78,7 +84,7 @@ func deleteCertificate(ctx context.Context, d *schema.ResourceData, m interface{
 	vpcId := d.Get("vpc_id").(string)
 	certificateId := d.Id()
 
-	_, err := service.DeleteCertificate(vpcId, certificateId)
+	_, err := service.DeleteCertificate(ctx, vpcId, certificateId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -3,6 +3,7 @@ package fptcloud_load_balancer_v2
 import (
 	"context"
 	"fmt"
+	"log"
 	"strconv"
 	"strings"
 	common "terraform-provider-fptcloud/commons"
@@ -45,8 +46,13 @@ func readL7Policy(ctx context.Context, d *schema.ResourceData, m interface{}) di
 	listenerId := d.Get("listener_id").(string)
 	l7PolicyId := d.Id()
 
-	response, err := service.GetL7Policy(vpcId, listenerId, l7PolicyId)
+	response, err := service.GetL7Policy(ctx, vpcId, listenerId, l7PolicyId)
 	if err != nil {
+		if common.IsNotFound(err) {
+			log.Printf("[WARN] L7 policy %s not found, removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return diag.FromErr(err)
 	}
 
@@ -97,7 +103,7 @@ func createL7Policy(ctx context.Context, d *schema.ResourceData, m interface{})
 	payload.RedirectHttpCode = d.Get("redirect_http_code").(int)
 	payload.Position = d.Get("position").(int)
 
-	response, err := service.CreateL7Policy(vpcId, listenerId, payload)
+	response, err := service.CreateL7Policy(ctx, vpcId, listenerId, payload)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -121,7 +127,7 @@ func updateL7Policy(ctx context.Context, d *schema.ResourceData, m interface{})
 	payload.RedirectHttpCode = d.Get("redirect_http_code").(int)
 	payload.Position = d.Get("position").(int)
 
-	_, err := service.UpdateL7Policy(vpcId, listenerId, l7PolicyId, payload)
+	_, err := service.UpdateL7Policy(ctx, vpcId, listenerId, l7PolicyId, payload)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -135,7 +141,7 @@ func deleteL7Policy(ctx context.Context, d *schema.ResourceData, m interface{})
 	listenerId := d.Get("listener_id").(string)
 	l7PolicyId := d.Id()
 
-	_, err := service.DeleteL7Policy(vpcId, listenerId, l7PolicyId)
+	_, err := service.DeleteL7Policy(ctx, vpcId, listenerId, l7PolicyId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -3,6 +3,7 @@ package fptcloud_load_balancer_v2
 import (
 	"context"
 	"fmt"
+	"log"
 	"strings"
 	common "terraform-provider-fptcloud/commons"
 
@@ -44,8 +45,13 @@ func readL7Rule(ctx context.Context, d *schema.ResourceData, m interface{}) diag
 	listenerId := d.Get("listener_id").(string)
 	l7PolicyId := d.Get("l7_policy_id").(string)
 	l7RuleId := d.Id()
-	response, err := service.GetL7Rule(vpcId, listenerId, l7PolicyId, l7RuleId)
+	response, err := service.GetL7Rule(ctx, vpcId, listenerId, l7PolicyId, l7RuleId)
 	if err != nil {
+		if common.IsNotFound(err) {
+			log.Printf("[WARN] L7 rule %s not found, removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return diag.FromErr(err)
 	}
 	rule := response.L7Rule
@@ -81,7 +87,7 @@ func createL7Rule(ctx context.Context, d *schema.ResourceData, m interface{}) di
 	payload.Value = d.Get("value").(string)
 	payload.Invert = d.Get("invert").(bool)
 
-	response, err := service.CreateL7Rule(vpcId, listenerId, l7PolicyId, payload)
+	response, err := service.CreateL7Rule(ctx, vpcId, listenerId, l7PolicyId, payload)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -104,7 +110,7 @@ func updateL7Rule(ctx context.Context, d *schema.ResourceData, m interface{}) di
 	payload.Value = d.Get("value").(string)
 	payload.Invert = d.Get("invert").(bool)
 
-	_, err := service.UpdateL7Rule(vpcId, listenerId, l7PolicyId, l7RuleId, payload)
+	_, err := service.UpdateL7Rule(ctx, vpcId, listenerId, l7PolicyId, l7RuleId, payload)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -119,7 +125,7 @@ func deleteL7Rule(ctx context.Context, d *schema.ResourceData, m interface{}) di
 	l7PolicyId := d.Get("l7_policy_id").(string)
 	l7RuleId := d.Id()
 
-	_, err := service.DeleteL7Rule(vpcId, listenerId, l7PolicyId, l7RuleId)
+	_, err := service.DeleteL7Rule(ctx, vpcId, listenerId, l7PolicyId, l7RuleId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -3,6 +3,7 @@ package fptcloud_load_balancer_v2
 import (
 	"context"
 	"fmt"
+	"log"
 	"strings"
 	common "terraform-provider-fptcloud/commons"
 	"terraform-provider-fptcloud/commons/utils"
@@ -13,11 +14,12 @@ import (
 
 func ResourceListener() *schema.Resource {
 	return &schema.Resource{
-		CreateContext: createListener,
-		ReadContext:   readListener,
-		UpdateContext: updateListener,
-		DeleteContext: deleteListener,
-		Schema:        resourceListener,
+		CreateWithoutTimeout: common.WithResourceTimeout(schema.TimeoutCreate, createListener),
+		ReadWithoutTimeout:   common.WithResourceTimeout(schema.TimeoutRead, readListener),
+		UpdateWithoutTimeout: common.WithResourceTimeout(schema.TimeoutUpdate, updateListener),
+		DeleteWithoutTimeout: common.WithResourceTimeout(schema.TimeoutDelete, deleteListener),
+		Timeouts:             common.ResourceTimeouts(),
+		Schema:               resourceListener,
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				parts := strings.Split(d.Id(), "/")
@@ -44,8 +46,13 @@ func readListener(ctx context.Context, d *schema.ResourceData, m interface{}) di
 	vpcId := d.Get("vpc_id").(string)
 	listenerId := d.Id()
 
-	response, err := service.GetListener(vpcId, listenerId)
+	response, err := service.GetListener(ctx, vpcId, listenerId)
 	if err != nil {
+		if common.IsNotFound(err) {
+			log.Printf("[WARN] Listener %s not found, removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return diag.FromErr(err)
 	}
 	listener := response.Listener
@@ -181,7 +188,7 @@ func createListener(ctx context.Context, d *schema.ResourceData, m interface{})
 	payload.AllowedCidrs = allowedCidrs
 	payload.DeniedCidrs = deniedCidrs
 
-	response, err := service.CreateListener(vpcId, loadBalancerId, payload)
+	response, err := service.CreateListener(ctx, vpcId, loadBalancerId, payload)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -248,7 +255,7 @@ func updateListener(ctx context.Context, d *schema.ResourceData, m interface{})
 	payload.AllowedCidrs = allowedCidrs
 	payload.DeniedCidrs = deniedCidrs
 
-	_, err := service.UpdateListener(vpcId, listenerId, payload)
+	_, err := service.UpdateListener(ctx, vpcId, listenerId, payload)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -262,7 +269,7 @@ func deleteListener(ctx context.Context, d *schema.ResourceData, m interface{})
 	vpcId := d.Get("vpc_id").(string)
 	listenerId := d.Id()
 
-	_, err := service.DeleteListener(vpcId, listenerId)
+	_, err := service.DeleteListener(ctx, vpcId, listenerId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -3,6 +3,7 @@ package fptcloud_load_balancer_v2
 import (
 	"context"
 	"fmt"
+	"log"
 	"strings"
 	common "terraform-provider-fptcloud/commons"
 
@@ -12,11 +13,12 @@ import (
 
 func ResourceLoadBalancer() *schema.Resource {
 	return &schema.Resource{
-		CreateContext: createLoadBalancer,
-		ReadContext:   readLoadBalancer,
-		UpdateContext: updateLoadBalancer,
-		DeleteContext: deleteLoadBalancer,
-		Schema:        resourceLoadBalancer,
+		CreateWithoutTimeout: common.WithResourceTimeout(schema.TimeoutCreate, createLoadBalancer),
+		ReadWithoutTimeout:   common.WithResourceTimeout(schema.TimeoutRead, readLoadBalancer),
+		UpdateWithoutTimeout: common.WithResourceTimeout(schema.TimeoutUpdate, updateLoadBalancer),
+		DeleteWithoutTimeout: common.WithResourceTimeout(schema.TimeoutDelete, deleteLoadBalancer),
+		Timeouts:             common.ResourceTimeouts(),
+		Schema:               resourceLoadBalancer,
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				parts := strings.Split(d.Id(), "/")
@@ -43,8 +45,13 @@ func readLoadBalancer(ctx context.Context, d *schema.ResourceData, m interface{}
 	vpcId := d.Get("vpc_id").(string)
 	loadBalancerId := d.Id()
 
-	response, err := service.ReadLoadBalancer(vpcId, loadBalancerId)
+	response, err := service.ReadLoadBalancer(ctx, vpcId, loadBalancerId)
 	if err != nil {
+		if common.IsNotFound(err) {
+			log.Printf("[WARN] Load balancer %s not found, removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return diag.FromErr(err)
 	}
 	loadBalancer := response.LoadBalancer
@@ -153,7 +160,7 @@ func createLoadBalancer(ctx context.Context, d *schema.ResourceData, m interface
 	poolPayload.PoolMembers = poolMembersPayload
 	payload.Pool = poolPayload
 
-	response, err := service.CreateLoadBalancer(vpcId, payload)
+	response, err := service.CreateLoadBalancer(ctx, vpcId, payload)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -172,7 +179,7 @@ func updateLoadBalancer(ctx context.Context, d *schema.ResourceData, m interface
 		var payload LoadBalancerResizeModel
 		payload.NewSize = new_size
 
-		_, err := service.ResizeLoadBalancer(vpcId, loadBalancerId, payload)
+		_, err := service.ResizeLoadBalancer(ctx, vpcId, loadBalancerId, payload)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -183,7 +190,7 @@ func updateLoadBalancer(ctx context.Context, d *schema.ResourceData, m interface
 		payload.Description = d.Get("description").(string)
 		payload.FloatingIp = d.Get("floating_ip").(string)
 
-		_, err := service.UpdateLoadBalancer(vpcId, loadBalancerId, payload)
+		_, err := service.UpdateLoadBalancer(ctx, vpcId, loadBalancerId, payload)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -198,7 +205,7 @@ func deleteLoadBalancer(ctx context.Context, d *schema.ResourceData, m interface
 	vpcId := d.Get("vpc_id").(string)
 	loadBalancerId := d.Id()
 
-	_, err := service.DeleteLoadBalancer(vpcId, loadBalancerId)
+	_, err := service.DeleteLoadBalancer(ctx, vpcId, loadBalancerId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -3,6 +3,7 @@ package fptcloud_load_balancer_v2
 import (
 	"context"
 	"fmt"
+	"log"
 	"strconv"
 	"strings"
 	common "terraform-provider-fptcloud/commons"
@@ -13,11 +14,12 @@ import (
 
 func ResourcePool() *schema.Resource {
 	return &schema.Resource{
-		CreateContext: createPool,
-		ReadContext:   readPool,
-		UpdateContext: updatePool,
-		DeleteContext: deletePool,
-		Schema:        resourcePool,
+		CreateWithoutTimeout: common.WithResourceTimeout(schema.TimeoutCreate, createPool),
+		ReadWithoutTimeout:   common.WithResourceTimeout(schema.TimeoutRead, readPool),
+		UpdateWithoutTimeout: common.WithResourceTimeout(schema.TimeoutUpdate, updatePool),
+		DeleteWithoutTimeout: common.WithResourceTimeout(schema.TimeoutDelete, deletePool),
+		Timeouts:             common.ResourceTimeouts(),
+		Schema:               resourcePool,
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				parts := strings.Split(d.Id(), "/")
@@ -43,8 +45,13 @@ func readPool(ctx context.Context, d *schema.ResourceData, m interface{}) diag.D
 	service := NewLoadBalancerV2Service(client)
 	vpcId := d.Get("vpc_id").(string)
 	poolId := d.Id()
-	response, err := service.GetPool(vpcId, poolId)
+	response, err := service.GetPool(ctx, vpcId, poolId)
 	if err != nil {
+		if common.IsNotFound(err) {
+			log.Printf("[WARN] Pool %s not found, removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return diag.FromErr(err)
 	}
 	pool := response.Pool
@@ -165,7 +172,7 @@ func createPool(ctx context.Context, d *schema.ResourceData, m interface{}) diag
 	payload.AlpnProtocols = alpnProtocols
 	payload.TlsEnabled = d.Get("tls_enabled").(bool)
 
-	response, err := service.CreatePool(vpcId, loadBalancerId, payload)
+	response, err := service.CreatePool(ctx, vpcId, loadBalancerId, payload)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -223,7 +230,7 @@ func updatePool(ctx context.Context, d *schema.ResourceData, m interface{}) diag
 	payload.AlpnProtocols = alpnProtocols
 	payload.TlsEnabled = d.Get("tls_enabled").(bool)
 
-	_, err := service.UpdatePool(vpcId, poolId, payload)
+	_, err := service.UpdatePool(ctx, vpcId, poolId, payload)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -236,7 +243,7 @@ func deletePool(ctx context.Context, d *schema.ResourceData, m interface{}) diag
 	vpcId := d.Get("vpc_id").(string)
 	poolId := d.Id()
 
-	_, err := service.DeletePool(vpcId, poolId)
+	_, err := service.DeletePool(ctx, vpcId, poolId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -2,9 +2,9 @@ package fptcloud_mfke_kubeconfig
 
 import (
 	"context"
-	"fmt"
 	common "terraform-provider-fptcloud/commons"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -13,7 +13,7 @@ import (
 func DataSourceMfkeKubeconfig() *schema.Resource {
 	return &schema.Resource{
 		Description: "Retrieves the kubeconfig for a Managed FKE cluster, exposing the cluster endpoint, CA certificate, and authentication token.",
-		Read:        dataSourceMfkeKubeconfigRead,
+		ReadContext: dataSourceMfkeKubeconfigRead,
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
 				Type:         schema.TypeString,
@@ -48,16 +48,16 @@ func DataSourceMfkeKubeconfig() *schema.Resource {
 	}
 }
 
-func dataSourceMfkeKubeconfigRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceMfkeKubeconfigRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	apiClient := m.(*common.Client)
 	service := NewMfkeKubeconfigService(apiClient)
 
 	vpcId := d.Get("vpc_id").(string)
 	clusterId := d.Get("cluster_id").(string)
 
-	kc, err := service.GetKubeconfig(context.Background(), vpcId, clusterId)
+	kc, err := service.GetKubeconfig(ctx, vpcId, clusterId)
 	if err != nil {
-		return fmt.Errorf("[ERR] Failed to retrieve MFKE kubeconfig: %s", err)
+		return diag.Errorf("[ERR] Failed to retrieve MFKE kubeconfig: %s", err)
 	}
 
 	d.SetId(clusterId)
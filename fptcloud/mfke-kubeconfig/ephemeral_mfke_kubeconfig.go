@@ -0,0 +1,119 @@
+package fptcloud_mfke_kubeconfig
+
+import (
+	"context"
+	"fmt"
+
+	common "terraform-provider-fptcloud/commons"
+	"terraform-provider-fptcloud/commons/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &ephemeralMfkeKubeconfig{}
+	_ ephemeral.EphemeralResourceWithConfigure = &ephemeralMfkeKubeconfig{}
+)
+
+type ephemeralMfkeKubeconfig struct {
+	client  *common.Client
+	service MfkeKubeconfigService
+}
+
+type mfkeKubeconfigModel struct {
+	VpcId                    types.String `tfsdk:"vpc_id"`
+	ClusterId                types.String `tfsdk:"cluster_id"`
+	Endpoint                 types.String `tfsdk:"endpoint"`
+	CertificateAuthorityData types.String `tfsdk:"certificate_authority_data"`
+	Token                    types.String `tfsdk:"token"`
+}
+
+// NewEphemeralMfkeKubeconfig returns the ephemeral MFKE kubeconfig, which is fetched when Terraform needs it and
+// never persisted in the plan or state
+func NewEphemeralMfkeKubeconfig() ephemeral.EphemeralResource {
+	return &ephemeralMfkeKubeconfig{}
+}
+
+func (e *ephemeralMfkeKubeconfig) Metadata(_ context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_mfke_kubeconfig"
+}
+
+func (e *ephemeralMfkeKubeconfig) Schema(_ context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "Retrieves the kubeconfig for a Managed FKE cluster without storing it in the plan or state, exposing the cluster endpoint, CA certificate, and authentication token. Requires Terraform 1.10 or later.",
+		Attributes: map[string]schema.Attribute{
+			"vpc_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Validators:  []validator.String{utils.NotEmptyValidator()},
+				Description: common.InheritedVpcIdDescription("The VPC ID that the MFKE cluster belongs to."),
+			},
+			"cluster_id": schema.StringAttribute{
+				Required:    true,
+				Validators:  []validator.String{utils.NotEmptyValidator()},
+				Description: "The cluster ID (name) of the MFKE cluster.",
+			},
+			"endpoint": schema.StringAttribute{
+				Computed:    true,
+				Description: "The Kubernetes API server endpoint URL.",
+			},
+			"certificate_authority_data": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Base64-encoded certificate authority data for the cluster.",
+			},
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The bearer token used to authenticate against the Kubernetes API server.",
+			},
+		},
+	}
+}
+
+func (e *ephemeralMfkeKubeconfig) Configure(_ context.Context, request ephemeral.ConfigureRequest, response *ephemeral.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*common.Client)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *common.Client, got: %T. Please report this issue to the provider developers.", request.ProviderData),
+		)
+		return
+	}
+
+	e.client = client
+	e.service = NewMfkeKubeconfigService(client)
+}
+
+func (e *ephemeralMfkeKubeconfig) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var config mfkeKubeconfigModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	config.VpcId = e.client.DefaultVpcIdValue(config.VpcId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Retrieving the kubeconfig of the MFKE cluster "+config.ClusterId.ValueString())
+	kubeconfig, err := e.service.GetKubeconfig(ctx, config.VpcId.ValueString(), config.ClusterId.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("Error retrieving MFKE kubeconfig", fmt.Sprintf("failed to retrieve the kubeconfig: %s", err))
+		return
+	}
+
+	config.Endpoint = types.StringValue(kubeconfig.Endpoint)
+	config.CertificateAuthorityData = types.StringValue(kubeconfig.CertificateAuthorityData)
+	config.Token = types.StringValue(kubeconfig.Token)
+	response.Diagnostics.Append(response.Result.Set(ctx, &config)...)
+}
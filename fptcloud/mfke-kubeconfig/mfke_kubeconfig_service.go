@@ -2,11 +2,13 @@ package fptcloud_mfke_kubeconfig
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
 
 	common "terraform-provider-fptcloud/commons"
+	"terraform-provider-fptcloud/commons/waiter"
 	fptcloud_dfke "terraform-provider-fptcloud/fptcloud/dfke"
 	fptcloud_mfke "terraform-provider-fptcloud/fptcloud/mfke"
 
@@ -53,56 +55,66 @@ func (s *MfkeKubeconfigServiceImpl) GetKubeconfig(ctx context.Context, vpcId, cl
 	platform = strings.ToLower(platform)
 
 	apiPath := common.ApiPath.ManagedFKEKubeconfig(vpcId, platform, clusterId)
-	deadline := time.Now().Add(kubeconfigPollTimeout)
-
-	for {
-		resp, err := s.mfkeClient.SendGetWithInfraType(apiPath, platform)
-		if err != nil {
-			if !isKubeconfigNotReady(err) {
-				return nil, err
-			}
-			if time.Now().After(deadline) {
-				return nil, fmt.Errorf(
-					"kubeconfig for cluster %q is not yet available. "+
-						"The cluster may still be provisioning — please try again later",
-					clusterId,
-				)
+	kubeconfigWaiter := &waiter.StateWaiter[[]byte]{
+		Description: fmt.Sprintf("kubeconfig of cluster %s to be available", clusterId),
+		Target:      []string{"available"},
+		Refresh: func(ctx context.Context) ([]byte, string, error) {
+			resp, err := s.mfkeClient.SendGetWithInfraType(ctx, apiPath, platform)
+			if err != nil {
+				if isKubeconfigNotReady(err) {
+					return nil, "not_ready", nil
+				}
+				return nil, "", err
 			}
-			time.Sleep(kubeconfigPollInterval)
-			continue
-		}
-
-		var raw struct {
-			Clusters []struct {
-				Cluster struct {
-					CertificateAuthorityData string `yaml:"certificate-authority-data"`
-					Server                   string `yaml:"server"`
-				} `yaml:"cluster"`
-			} `yaml:"clusters"`
-			Users []struct {
-				User struct {
-					Token string `yaml:"token"`
-				} `yaml:"user"`
-			} `yaml:"users"`
-		}
-
-		if err := yaml.Unmarshal(resp, &raw); err != nil {
-			return nil, fmt.Errorf("failed to parse kubeconfig: %s", err)
-		}
-
-		if len(raw.Clusters) == 0 {
-			return nil, fmt.Errorf("kubeconfig contains no clusters")
-		}
-		if len(raw.Users) == 0 {
-			return nil, fmt.Errorf("kubeconfig contains no users")
-		}
-
-		return &MfkeKubeconfig{
-			Endpoint:                 raw.Clusters[0].Cluster.Server,
-			CertificateAuthorityData: raw.Clusters[0].Cluster.CertificateAuthorityData,
-			Token:                    raw.Users[0].User.Token,
-		}, nil
+			return resp, "available", nil
+		},
+		Timeout:     kubeconfigPollTimeout,
+		MinInterval: kubeconfigPollInterval,
+		MaxInterval: kubeconfigPollInterval,
+	}
+
+	resp, err := kubeconfigWaiter.Wait(ctx)
+	var timeoutErr *waiter.TimeoutError
+	if errors.As(err, &timeoutErr) {
+		return nil, fmt.Errorf(
+			"kubeconfig for cluster %q is not yet available. "+
+				"The cluster may still be provisioning — please try again later",
+			clusterId,
+		)
+	} else if err != nil {
+		return nil, err
+	}
+
+	var raw struct {
+		Clusters []struct {
+			Cluster struct {
+				CertificateAuthorityData string `yaml:"certificate-authority-data"`
+				Server                   string `yaml:"server"`
+			} `yaml:"cluster"`
+		} `yaml:"clusters"`
+		Users []struct {
+			User struct {
+				Token string `yaml:"token"`
+			} `yaml:"user"`
+		} `yaml:"users"`
 	}
+
+	if err := yaml.Unmarshal(resp, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse kubeconfig: %s", err)
+	}
+
+	if len(raw.Clusters) == 0 {
+		return nil, fmt.Errorf("kubeconfig contains no clusters")
+	}
+	if len(raw.Users) == 0 {
+		return nil, fmt.Errorf("kubeconfig contains no users")
+	}
+
+	return &MfkeKubeconfig{
+		Endpoint:                 raw.Clusters[0].Cluster.Server,
+		CertificateAuthorityData: raw.Clusters[0].Cluster.CertificateAuthorityData,
+		Token:                    raw.Users[0].User.Token,
+	}, nil
 }
 
 // isKubeconfigNotReady returns true when the API signals the kubeconfig does not exist yet (cluster still provisioning).
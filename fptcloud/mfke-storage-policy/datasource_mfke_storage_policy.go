@@ -1,6 +1,7 @@
 package fptcloud_mfke_storage_policy
 
 import (
+	"context"
 	"fmt"
 	common "terraform-provider-fptcloud/commons"
 	data_list "terraform-provider-fptcloud/commons/data-list"
@@ -74,7 +75,7 @@ func flattenMfkeStoragePolicy(storagePolicy, _ interface{}, _ map[string]interfa
 	return flattened, nil
 }
 
-func getMfkeStoragePolicies(m interface{}, extra map[string]interface{}) ([]interface{}, error) {
+func getMfkeStoragePolicies(ctx context.Context, m interface{}, extra map[string]interface{}) ([]interface{}, error) {
 	apiClient := m.(*common.Client)
 	storageService := NewMfkeStoragePolicyService(apiClient)
 
@@ -83,7 +84,7 @@ func getMfkeStoragePolicies(m interface{}, extra map[string]interface{}) ([]inte
 		return nil, fmt.Errorf("[ERR] vpc id is required")
 	}
 
-	storagePolicies, err := storageService.ListMfkeStoragePolicy(vpcId)
+	storagePolicies, err := storageService.ListMfkeStoragePolicy(ctx, vpcId)
 	if err != nil {
 		return nil, fmt.Errorf("[ERR] Failed to retrieve MFKE storage policy: %s", err)
 	}
@@ -1,6 +1,7 @@
 package fptcloud_mfke_storage_policy
 
 import (
+	"context"
 	"encoding/json"
 	common "terraform-provider-fptcloud/commons"
 )
@@ -16,7 +17,7 @@ type MfkeStoragePolicy struct {
 
 // MfkeStoragePolicyService defines the interface for MFKE storage policy service
 type MfkeStoragePolicyService interface {
-	ListMfkeStoragePolicy(vpcId string) (*[]MfkeStoragePolicy, error)
+	ListMfkeStoragePolicy(ctx context.Context, vpcId string) (*[]MfkeStoragePolicy, error)
 }
 
 // MfkeStoragePolicyServiceImpl is the implementation of MfkeStoragePolicyService
@@ -30,9 +31,9 @@ func NewMfkeStoragePolicyService(client *common.Client) MfkeStoragePolicyService
 }
 
 // ListMfkeStoragePolicy get list MFKE storage policy
-func (s *MfkeStoragePolicyServiceImpl) ListMfkeStoragePolicy(vpcId string) (*[]MfkeStoragePolicy, error) {
+func (s *MfkeStoragePolicyServiceImpl) ListMfkeStoragePolicy(ctx context.Context, vpcId string) (*[]MfkeStoragePolicy, error) {
 	var apiPath = common.ApiPath.ManagedFKEStoragePolicy(vpcId)
-	resp, err := s.client.SendGetRequest(apiPath)
+	resp, err := s.client.SendGetRequestWithContext(ctx, apiPath)
 	if err != nil {
 		return nil, err
 	}
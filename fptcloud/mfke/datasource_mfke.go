@@ -66,7 +66,9 @@ func (d *datasourceManagedKubernetesEngine) Schema(ctx context.Context, request
 		Required: true,
 	}
 	topLevelAttributes["vpc_id"] = schema.StringAttribute{
-		Required: true,
+		Optional:    true,
+		Computed:    true,
+		Description: commons.InheritedVpcIdDescription(descriptions["vpc_id"]),
 	}
 
 	response.Schema = schema.Schema{
@@ -80,6 +82,7 @@ func (d *datasourceManagedKubernetesEngine) Schema(ctx context.Context, request
 				Attributes: poolAttributes,
 			},
 		},
+		"timeouts": fptcloud_dfke.DataSourceTimeoutsBlock(ctx),
 	}
 }
 
@@ -92,6 +95,19 @@ func (d *datasourceManagedKubernetesEngine) Read(ctx context.Context, request da
 		return
 	}
 
+	state.VpcId = d.client.DefaultVpcIdValue(state.VpcId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, d.client.DefaultTimeout())
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	_, err := d.internalRead(ctx, state.ClusterName.ValueString(), &state)
 	if err != nil {
 		response.Diagnostics.Append(diag2.NewErrorDiagnostic("Error calling API", err.Error()))
@@ -121,7 +137,7 @@ func (d *datasourceManagedKubernetesEngine) internalRead(ctx context.Context, id
 	platform = strings.ToLower(platform)
 
 	path := commons.ApiPath.ManagedFKEGet(vpcId, platform, id)
-	a, err := d.mfkeClient.sendGet(path, platform)
+	a, err := d.mfkeClient.sendGet(ctx, path, platform)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,47 @@
+package fptcloud_mfke
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &functionMfkeClusterSlug{}
+
+type functionMfkeClusterSlug struct{}
+
+// NewFunctionMfkeClusterSlug returns the mfke_cluster_slug function, building the slug of a cluster like its resource
+func NewFunctionMfkeClusterSlug() function.Function {
+	return &functionMfkeClusterSlug{}
+}
+
+func (f *functionMfkeClusterSlug) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "mfke_cluster_slug"
+}
+
+func (f *functionMfkeClusterSlug) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:     "Build the slug of a managed FKE cluster",
+		Description: "Returns the slug identifying a managed FKE cluster in the API, the cluster name followed by the cluster ID, unless the cluster name already ends with it.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "cluster_name",
+				Description: "The name of the cluster.",
+			},
+			function.StringParameter{
+				Name:        "cluster_id",
+				Description: "The ID of the cluster.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *functionMfkeClusterSlug) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var clusterName, clusterId string
+	response.Error = request.Arguments.Get(ctx, &clusterName, &clusterId)
+	if response.Error != nil {
+		return
+	}
+	response.Error = response.Result.Set(ctx, ClusterSlug(clusterName, clusterId))
+}
@@ -5,10 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
-	"strings"
 	"terraform-provider-fptcloud/commons"
-
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type MfkeApiClient struct {
@@ -25,13 +22,13 @@ func NewMfkeApiClient(c *commons.Client) *MfkeApiClient {
 }
 
 // SendGetWithInfraType sends a GET request with the infra-type and fpt-region headers set
-func (m *MfkeApiClient) SendGetWithInfraType(requestURL string, infraType string) ([]byte, error) {
-	return m.sendGet(requestURL, infraType)
+func (m *MfkeApiClient) SendGetWithInfraType(ctx context.Context, requestURL string, infraType string) ([]byte, error) {
+	return m.sendGet(ctx, requestURL, infraType)
 }
 
-func (m *MfkeApiClient) sendGet(requestURL string, infraType string) ([]byte, error) {
+func (m *MfkeApiClient) sendGet(ctx context.Context, requestURL string, infraType string) ([]byte, error) {
 	u := m.Client.PrepareClientURL(requestURL)
-	req, err := http.NewRequest("GET", u.String(), nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -45,13 +42,11 @@ func (m *MfkeApiClient) sendPost(ctx context.Context, requestURL string, infraTy
 	// we create a new buffer and encode everything to json to send it in the request
 	jsonValue, _ := json.Marshal(params)
 
-	req, err := http.NewRequest("POST", u.String(), bytes.NewBuffer(jsonValue))
+	req, err := http.NewRequestWithContext(ctx, "POST", u.String(), bytes.NewBuffer(jsonValue))
 	if err != nil {
 		return nil, err
 	}
 
-	tflog.Info(ctx, "sendPost Body JSON: "+string(jsonValue))
-
 	return m.sendRequestWithHeader(req, infraType)
 }
 
@@ -61,19 +56,17 @@ func (m *MfkeApiClient) sendPatch(ctx context.Context, requestURL string, infraT
 	// we create a new buffer and encode everything to json to send it in the request
 	jsonValue, _ := json.Marshal(params)
 
-	req, err := http.NewRequest("PATCH", u.String(), bytes.NewBuffer(jsonValue))
+	req, err := http.NewRequestWithContext(ctx, "PATCH", u.String(), bytes.NewBuffer(jsonValue))
 	if err != nil {
 		return nil, err
 	}
 
-	tflog.Info(ctx, "sendPatch Body JSON: "+string(jsonValue))
-
 	return m.sendRequestWithHeader(req, infraType)
 }
 
-func (m *MfkeApiClient) sendDelete(requestURL string, infraType string) ([]byte, error) {
+func (m *MfkeApiClient) sendDelete(ctx context.Context, requestURL string, infraType string) ([]byte, error) {
 	u := m.Client.PrepareClientURL(requestURL)
-	req, err := http.NewRequest("DELETE", u.String(), nil)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", u.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -82,21 +75,5 @@ func (m *MfkeApiClient) sendDelete(requestURL string, infraType string) ([]byte,
 }
 
 func (m *MfkeApiClient) sendRequestWithHeader(request *http.Request, infraType string) ([]byte, error) {
-	switch m.Client.Region {
-	case "VN/HAN":
-		request.Header.Set("fpt-region", "hanoi-vn")
-	case "VN/SGN":
-		request.Header.Set("fpt-region", "saigon-vn")
-	case "VN/HAN2":
-		request.Header.Set("fpt-region", "hanoi-2-vn")
-	case "VN/SGN2":
-		request.Header.Set("fpt-region", "saigon-02-vn")
-	case "JP/JCSI2":
-		request.Header.Set("fpt-region", "JP/JCSI2")
-	default:
-		request.Header.Set("fpt-region", m.Client.Region)
-	}
-	request.Header.Set("infra-type", strings.ToUpper(infraType))
-
-	return m.Client.SendRequest(request)
+	return m.Client.SendRegionalRequest(request, infraType)
 }
@@ -9,7 +9,9 @@ import (
 	fptcloud_dfke "terraform-provider-fptcloud/fptcloud/dfke"
 	fptcloud_subnet "terraform-provider-fptcloud/fptcloud/subnet"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	diag2 "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -23,6 +25,7 @@ var (
 	_ resource.Resource                = &resourceManagedKubernetesEngine{}
 	_ resource.ResourceWithConfigure   = &resourceManagedKubernetesEngine{}
 	_ resource.ResourceWithImportState = &resourceManagedKubernetesEngine{}
+	_ resource.ResourceWithModifyPlan  = &resourceManagedKubernetesEngine{}
 
 	forceNewPlanModifiersString = []planmodifier.String{
 		stringplanmodifier.RequiresReplace(),
@@ -31,6 +34,12 @@ var (
 	forceNewPlanModifiersInt = []planmodifier.Int64{
 		int64planmodifier.RequiresReplace(),
 	}
+
+	// vpcIdPlanModifiers keep the vpc_id in state when unconfigured, ModifyPlan then plans the provider vpc_id
+	vpcIdPlanModifiers = []planmodifier.String{
+		stringplanmodifier.UseStateForUnknown(),
+		stringplanmodifier.RequiresReplace(),
+	}
 )
 
 const (
@@ -45,7 +54,7 @@ func (r *resourceManagedKubernetesEngine) Metadata(_ context.Context, request re
 	response.TypeName = request.ProviderTypeName + "_managed_kubernetes_engine_v1"
 }
 
-func (r *resourceManagedKubernetesEngine) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+func (r *resourceManagedKubernetesEngine) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
 	topLevelAttributes := TopFields()
 	poolAttributes := PoolFields()
 
@@ -62,10 +71,21 @@ func (r *resourceManagedKubernetesEngine) Schema(_ context.Context, _ resource.S
 					Attributes: poolAttributes,
 				},
 			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
 
+// ModifyPlan plans the provider vpc_id when vpc_id is not configured
+func (r *resourceManagedKubernetesEngine) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.client.PlanDefaultVpcId(ctx, request, response)
+}
+
 func (r *resourceManagedKubernetesEngine) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
 	var state managedKubernetesEngine
 	diags := request.Plan.Get(ctx, &state)
@@ -75,6 +95,14 @@ func (r *resourceManagedKubernetesEngine) Create(ctx context.Context, request re
 		return
 	}
 
+	createTimeout, diags := state.Timeouts.Create(ctx, r.client.DefaultTimeout())
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Get platform first to set appropriate defaults
 	platform, err := r.tenancyClient.GetVpcPlatform(ctx, state.VpcId.ValueString())
 	if err != nil {
@@ -153,20 +181,12 @@ func (r *resourceManagedKubernetesEngine) Create(ctx context.Context, request re
 		return
 	}
 
-	// Build slug: if cluster_name already ends with cluster_id, use cluster_name directly
-	// Otherwise, append cluster_id to cluster_name
-	var slug string
-	suffix := "-" + createResponse.Kpi.ClusterId
-	if strings.HasSuffix(createResponse.Kpi.ClusterName, suffix) {
-		slug = createResponse.Kpi.ClusterName
-	} else {
-		slug = fmt.Sprintf("%s-%s", createResponse.Kpi.ClusterName, createResponse.Kpi.ClusterId)
-	}
+	slug := ClusterSlug(createResponse.Kpi.ClusterName, createResponse.Kpi.ClusterId)
 
 	tflog.Info(ctx, "Created cluster with id "+slug)
 
-	if _, err = r.InternalRead(ctx, slug, &state); err != nil {
-		response.Diagnostics.Append(diag2.NewErrorDiagnostic("Error reading cluster state", err.Error()))
+	if err = r.waitForReady(ctx, slug, &state, createTimeout); err != nil {
+		response.Diagnostics.Append(diag2.NewErrorDiagnostic("Error waiting for cluster to be ready", err.Error()))
 		return
 	}
 
@@ -187,8 +207,20 @@ func (r *resourceManagedKubernetesEngine) Read(ctx context.Context, request reso
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, r.client.DefaultTimeout())
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	_, err := r.InternalRead(ctx, state.Id.ValueString(), &state)
-	if err != nil {
+	if commons.IsNotFound(err) {
+		tflog.Warn(ctx, "Cluster "+state.Id.ValueString()+" not found, removing it from state")
+		response.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
 		response.Diagnostics.Append(diag2.NewErrorDiagnostic(errorCallingApi("internalRead"), err.Error()))
 		return
 	}
@@ -229,6 +261,14 @@ func (r *resourceManagedKubernetesEngine) Update(ctx context.Context, request re
 	tflog.Info(ctx, "[DEBUG] State in Update: "+fmt.Sprintf("%#v", state))
 	tflog.Info(ctx, "[DEBUG] Plan in Update: "+fmt.Sprintf("%#v", plan))
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, r.client.DefaultTimeout())
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	errDiag := r.Diff(ctx, &state, &plan)
 	if errDiag != nil {
 		response.Diagnostics.Append(errDiag)
@@ -256,6 +296,14 @@ func (r *resourceManagedKubernetesEngine) Delete(ctx context.Context, request re
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, r.client.DefaultTimeout())
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	vpcId := state.VpcId.ValueString()
 	cluster := state.ClusterName.ValueString()
 	clusterId := state.Id.ValueString()
@@ -270,7 +318,7 @@ func (r *resourceManagedKubernetesEngine) Delete(ctx context.Context, request re
 
 	tflog.Info(ctx, "Attempting to delete cluster "+cluster+", DELETE "+path)
 
-	_, err = r.mfkeClient.sendDelete(path, strings.ToLower(platform))
+	_, err = r.mfkeClient.sendDelete(ctx, path, strings.ToLower(platform))
 	if err != nil {
 		tflog.Error(ctx, "Error deleting cluster "+cluster+": "+err.Error())
 		response.Diagnostics.Append(diag2.NewErrorDiagnostic(errorCallingApi(path), err.Error()))
@@ -298,6 +346,10 @@ func (r *resourceManagedKubernetesEngine) ImportState(ctx context.Context, reque
 	state.VpcId = types.StringValue(vpcId)
 
 	state.Id = types.StringValue(clusterId)
+	response.Diagnostics.Append(response.State.GetAttribute(ctx, path.Root("timeouts"), &state.Timeouts)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
 
 	_, err := r.InternalRead(ctx, clusterId, &state)
 	if err != nil {
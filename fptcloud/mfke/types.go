@@ -5,6 +5,7 @@ import (
 	fptcloud_dfke "terraform-provider-fptcloud/fptcloud/dfke"
 	fptcloud_subnet "terraform-provider-fptcloud/fptcloud/subnet"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -34,6 +35,8 @@ type managedKubernetesEngine struct {
 	EdgeGatewayName       types.String `tfsdk:"edge_gateway_name"`
 	IsRunning             types.Bool   `tfsdk:"is_running"`
 	HibernationSchedules  types.List   `tfsdk:"hibernation_schedules"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 type ClusterAutoscaler struct {
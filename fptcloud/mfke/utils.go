@@ -12,6 +12,7 @@ import (
 	"strconv"
 	"strings"
 	"terraform-provider-fptcloud/commons"
+	"terraform-provider-fptcloud/commons/waiter"
 	fptcloud_edge_gateway "terraform-provider-fptcloud/fptcloud/edge_gateway"
 	fptcloud_subnet "terraform-provider-fptcloud/fptcloud/subnet"
 	"time"
@@ -55,7 +56,7 @@ func getNetworkInfoByPlatform(ctx context.Context, client fptcloud_subnet.Subnet
 		tflog.Info(ctx, fmt.Sprintf("DEBUG: Worker %s - networkName from ProviderConfig: '%s'", w.Name, networkName))
 		if networkName != "" {
 			// Use FindSubnetByName to get both network ID and name
-			subnet, err := client.FindSubnetByName(fptcloud_subnet.FindSubnetDTO{
+			subnet, err := client.FindSubnetByName(ctx, fptcloud_subnet.FindSubnetDTO{
 				NetworkName: networkName,
 				VpcId:       vpcId,
 			})
@@ -68,7 +69,7 @@ func getNetworkInfoByPlatform(ctx context.Context, client fptcloud_subnet.Subnet
 		clusterNetworkID := data.Spec.Networking.Nodes
 		if clusterNetworkID != "" {
 			// Try to find the network name for this network ID
-			networks, err := client.ListSubnet(vpcId)
+			networks, err := client.ListSubnet(ctx, vpcId)
 			if err == nil {
 				for _, n := range *networks {
 					if n.NetworkID == clusterNetworkID {
@@ -96,7 +97,7 @@ func getNetworkByIdOrName(ctx context.Context, client fptcloud_subnet.SubnetServ
 	if networkName != "" {
 		tflog.Info(ctx, "Resolving network ID for VPC "+vpcId+", network "+networkName)
 
-		networks, err := client.FindSubnetByName(fptcloud_subnet.FindSubnetDTO{
+		networks, err := client.FindSubnetByName(ctx, fptcloud_subnet.FindSubnetDTO{
 			NetworkName: networkName,
 			NetworkID:   networkId,
 			VpcId:       vpcId,
@@ -109,7 +110,7 @@ func getNetworkByIdOrName(ctx context.Context, client fptcloud_subnet.SubnetServ
 	} else {
 		tflog.Info(ctx, "Resolving network ID for VPC "+vpcId+", network_id "+networkId)
 
-		networks, err := client.ListSubnet(vpcId)
+		networks, err := client.ListSubnet(ctx, vpcId)
 		if err != nil {
 			return "", "", err
 		}
@@ -128,7 +129,7 @@ func TopFields() map[string]schema.Attribute {
 	topLevelAttributes := map[string]schema.Attribute{}
 	// Required string fields
 	requiredStrings := []string{
-		"vpc_id", "cluster_name", "network_id",
+		"cluster_name", "network_id",
 	}
 	// Optional string fields
 	optionalStrings := []string{
@@ -188,6 +189,14 @@ func TopFields() map[string]schema.Attribute {
 		}
 	}
 
+	// vpc_id falls back to the provider vpc_id, see ModifyPlan
+	topLevelAttributes["vpc_id"] = schema.StringAttribute{
+		Optional:      true,
+		Computed:      true,
+		PlanModifiers: vpcIdPlanModifiers,
+		Description:   commons.InheritedVpcIdDescription(descriptions["vpc_id"]),
+	}
+
 	// Special handling for is_running - not computed, with default value
 	topLevelAttributes["is_running"] = schema.BoolAttribute{
 		Optional:    true,
@@ -890,7 +899,7 @@ func (r *resourceManagedKubernetesEngine) InternalRead(ctx context.Context, id s
 	}
 	platform = strings.ToLower(platform)
 	path := commons.ApiPath.ManagedFKEGet(vpcId, platform, id)
-	a, err := r.mfkeClient.sendGet(path, platform)
+	a, err := r.mfkeClient.sendGet(ctx, path, platform)
 	if err != nil {
 		return nil, err
 	}
@@ -1216,10 +1225,36 @@ func (r *resourceManagedKubernetesEngine) InternalRead(ctx context.Context, id s
 	return &d, nil
 }
 
+// waitForReady reads the cluster into state until its last operation succeeded, as the cluster keeps provisioning
+// for a while after its creation
+func (r *resourceManagedKubernetesEngine) waitForReady(ctx context.Context, id string, state *managedKubernetesEngine, timeout time.Duration) error {
+	readyWaiter := &waiter.StateWaiter[*managedKubernetesEngineReadResponse]{
+		Description: "cluster " + id + " to be ready",
+		Pending:     []string{"", "Pending", "Processing", "Error"},
+		Target:      []string{"Succeeded"},
+		Failure:     []string{"Failed", "Aborted"},
+		Refresh: func(ctx context.Context) (*managedKubernetesEngineReadResponse, string, error) {
+			d, err := r.InternalRead(ctx, id, state)
+			if err != nil {
+				return nil, "", err
+			}
+			return d, d.Data.Status.LastOperation.State, nil
+		},
+		Timeout:        timeout,
+		Delay:          10 * time.Second,
+		MinInterval:    10 * time.Second,
+		MaxInterval:    60 * time.Second,
+		NotFoundChecks: 10,
+	}
+
+	_, err := readyWaiter.Wait(ctx)
+	return err
+}
+
 // getEdgeGateway
-func (r *resourceManagedKubernetesEngine) GetEdgeGateway(_ context.Context, edgeId string, vpcId string) (*fptcloud_edge_gateway.EdgeGatewayData, *diag2.ErrorDiagnostic) {
+func (r *resourceManagedKubernetesEngine) GetEdgeGateway(ctx context.Context, edgeId string, vpcId string) (*fptcloud_edge_gateway.EdgeGatewayData, *diag2.ErrorDiagnostic) {
 	path := commons.ApiPath.EdgeGatewayList(vpcId)
-	res, err := r.client.SendGetRequest(path)
+	res, err := r.client.SendGetRequestWithContext(ctx, path)
 	if err != nil {
 		d := diag2.NewErrorDiagnostic(errorCallingApi(path), err.Error())
 		return nil, &d
@@ -1238,6 +1273,15 @@ func (r *resourceManagedKubernetesEngine) GetEdgeGateway(_ context.Context, edge
 	return nil, &diag
 }
 
+// ClusterSlug returns the slug identifying a cluster, the cluster name followed by the cluster ID unless the name
+// already ends with it
+func ClusterSlug(clusterName string, clusterId string) string {
+	if strings.HasSuffix(clusterName, "-"+clusterId) {
+		return clusterName
+	}
+	return fmt.Sprintf("%s-%s", clusterName, clusterId)
+}
+
 // getClusterName
 func getClusterName(name string) string {
 	var indices []int
@@ -1535,7 +1579,6 @@ func (r *resourceManagedKubernetesEngine) updateClusterEndpointCIDR(ctx context.
 		return &d
 	}
 
-	tflog.Info(ctx, "Cluster endpoint CIDR API response: "+string(resp))
 	tflog.Info(ctx, "Successfully updated cluster endpoint CIDR.")
 
 	if diagErr := r.CheckForError(resp); diagErr != nil {
@@ -1575,8 +1618,6 @@ func (r *resourceManagedKubernetesEngine) updateClusterAutoscaler(ctx context.Co
 		return &d
 	}
 
-	tflog.Info(ctx, "Cluster autoscaler API response: "+string(resp))
-
 	// Check for API errors in response
 	if diagErr := r.CheckForError(resp); diagErr != nil {
 		return diagErr
@@ -1608,7 +1649,7 @@ func (r *resourceManagedKubernetesEngine) updateWorkerPools(ctx context.Context,
 	clusterNetworkName := ""
 	if strings.ToLower(platform) == "vmw" {
 		// For VMW platform, try to get network name from subnet service
-		subnets, err := r.subnetClient.ListSubnet(vpcId)
+		subnets, err := r.subnetClient.ListSubnet(ctx, vpcId)
 		if err == nil {
 			for _, subnet := range *subnets {
 				if subnet.NetworkID == from.NetworkID.ValueString() {
@@ -1848,43 +1889,42 @@ func internalReadClusterEndpointAccess(data managedKubernetesEngineData) (types.
 
 func (m *MfkeApiClient) checkServiceAccount(ctx context.Context, vpcId string, platform string) (bool, error) {
 	path := commons.ApiPath.ManagedFKECheckEnableServiceAccount(vpcId, strings.ToLower(platform))
+	tflog.Info(ctx, "Checking service account: "+path)
 
-	maxRetries := 10
-	var lastErr error
-
-	for attempt := 1; attempt <= maxRetries; attempt++ {
-		tflog.Info(ctx, fmt.Sprintf("Checking service account (attempt %d/%d): %s", attempt, maxRetries, path))
+	serviceAccountWaiter := &waiter.StateWaiter[bool]{
+		Description: "service account of VPC " + vpcId + " to be enabled",
+		Target:      []string{"enabled"},
+		Refresh: func(ctx context.Context) (bool, string, error) {
+			_, err := m.sendGet(ctx, path, strings.ToUpper(platform))
+			if err == nil {
+				// No error means status code is 200, service account is enabled
+				return true, "enabled", nil
+			}
 
-		_, err := m.sendGet(path, strings.ToUpper(platform))
-		if err == nil {
-			// No error means status code is 200, service account is enabled
-			tflog.Info(ctx, "Service account check passed")
-			return true, nil
-		}
-
-		// Check if it's an HTTPError to get status code
-		var httpErr commons.HTTPError
-		if errors.As(err, &httpErr) {
-			// If status code is 200 (shouldn't happen, but just in case)
-			if httpErr.Code == 200 {
-				tflog.Info(ctx, "Service account check passed")
-				return true, nil
+			// Check if it's an HTTPError to get status code
+			var httpErr commons.HTTPError
+			if errors.As(err, &httpErr) {
+				// If status code is 200 (shouldn't happen, but just in case)
+				if httpErr.Code == 200 {
+					return true, "enabled", nil
+				}
+				// Non-200 status code
+				return false, "", fmt.Errorf("service account check returned status code %d", httpErr.Code)
 			}
-			// Non-200 status code
-			lastErr = fmt.Errorf("service account check returned status code %d", httpErr.Code)
-		} else {
 			// Network or other error
-			lastErr = err
-		}
-
-		if attempt < maxRetries {
-			tflog.Info(ctx, "Service account check failed, retrying in 1 second...")
-			time.Sleep(1 * time.Second)
-			continue
-		}
+			return false, "", err
+		},
+		MinInterval:  1 * time.Second,
+		MaxInterval:  1 * time.Second,
+		ErrorRetries: 9,
 	}
 
-	return false, lastErr
+	enabled, err := serviceAccountWaiter.Wait(ctx)
+	if err != nil {
+		return false, err
+	}
+	tflog.Info(ctx, "Service account check passed")
+	return enabled, nil
 }
 
 // func (m *MfkeApiClient) checkQuotaResource(ctx context.Context, vpcId string, platform string) (bool, error) {
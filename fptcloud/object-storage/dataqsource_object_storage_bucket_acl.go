@@ -108,11 +108,11 @@ func dataSourceBucketAclRead(ctx context.Context, d *schema.ResourceData, m inte
 	bucketName := d.Get("bucket_name").(string)
 	vpcId := d.Get("vpc_id").(string)
 	regionName := d.Get("region_name").(string)
-	s3ServiceDetail := getServiceEnableRegion(service, vpcId, regionName)
+	s3ServiceDetail := getServiceEnableRegion(ctx, service, vpcId, regionName)
 	if s3ServiceDetail.S3ServiceId == "" {
 		return diag.FromErr(fmt.Errorf(regionError, regionName))
 	}
-	r := service.GetBucketAcl(vpcId, s3ServiceDetail.S3ServiceId, bucketName)
+	r := service.GetBucketAcl(ctx, vpcId, s3ServiceDetail.S3ServiceId, bucketName)
 	if !r.Status {
 		return diag.Errorf("failed to get bucket ACL for bucket %s", bucketName)
 	}
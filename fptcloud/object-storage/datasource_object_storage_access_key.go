@@ -48,11 +48,11 @@ func dataSourceAccessKeyRead(ctx context.Context, d *schema.ResourceData, m inte
 	service := NewObjectStorageService(client)
 	vpcId := d.Get("vpc_id").(string)
 	regionName := d.Get("region_name").(string)
-	s3ServiceDetail := getServiceEnableRegion(service, vpcId, regionName)
+	s3ServiceDetail := getServiceEnableRegion(ctx, service, vpcId, regionName)
 	if s3ServiceDetail.S3ServiceId == "" {
 		return diag.FromErr(fmt.Errorf(regionError, regionName))
 	}
-	keys, err := service.ListAccessKeys(vpcId, s3ServiceDetail.S3ServiceId)
+	keys, err := service.ListAccessKeys(ctx, vpcId, s3ServiceDetail.S3ServiceId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -26,8 +26,7 @@ func DataSourceBucket() *schema.Resource {
 			"page": {
 				Type:        schema.TypeInt,
 				Optional:    true,
-				Description: "Page number",
-				Default:     1,
+				Description: "Page number, every page is listed when unset",
 			},
 			"page_size": {
 				Type:        schema.TypeInt,
@@ -77,27 +76,20 @@ func dataSourceBucketRead(ctx context.Context, d *schema.ResourceData, m interfa
 	client := m.(*common.Client)
 	service := NewObjectStorageService(client)
 	vpcId := d.Get("vpc_id").(string)
-	page := 1
-	val, ok := d.GetOk("page")
-	if ok {
-		page = val.(int)
-	}
-	pageSize := 25
-	valSize, ok := d.GetOk("page_size")
-	if ok {
-		pageSize = valSize.(int)
-	}
 	regionName := d.Get("region_name").(string)
-	s3ServiceDetail := getServiceEnableRegion(service, vpcId, regionName)
+	s3ServiceDetail := getServiceEnableRegion(ctx, service, vpcId, regionName)
 	if s3ServiceDetail.S3ServiceId == "" {
 		return diag.FromErr(fmt.Errorf(regionError, regionName))
 	}
-	buckets := service.ListBuckets(vpcId, s3ServiceDetail.S3ServiceId, page, pageSize)
-	if buckets.Total == 0 {
+	buckets, err := listPageOrAll(ctx, d, 25, bucketPages(service, vpcId, s3ServiceDetail.S3ServiceId))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(buckets) == 0 {
 		return diag.Errorf("no buckets found")
 	}
 	var formattedData []interface{}
-	for _, bucket := range buckets.Buckets {
+	for _, bucket := range buckets {
 		formattedData = append(formattedData, map[string]interface{}{
 			"endpoint":           bucket.Endpoint,
 			"is_enabled_logging": bucket.IsEnabledLogging,
@@ -114,3 +106,17 @@ func dataSourceBucketRead(ctx context.Context, d *schema.ResourceData, m interfa
 
 	return nil
 }
+
+// listPageOrAll returns the page requested by the page and page_size attributes of a data source,
+// or every page when page is unset
+func listPageOrAll[T any](ctx context.Context, d *schema.ResourceData, defaultPageSize int, fetch common.PageFetcher[T]) ([]T, error) {
+	pageSize := defaultPageSize
+	if v, ok := d.GetOk("page_size"); ok {
+		pageSize = v.(int)
+	}
+	if v, ok := d.GetOk("page"); ok {
+		items, _, err := fetch(ctx, v.(int), pageSize)
+		return items, err
+	}
+	return common.ListAll(ctx, pageSize, fetch)
+}
@@ -37,7 +37,7 @@ func DataSourceBucketCors() *schema.Resource {
 			"page": {
 				Type:        schema.TypeInt,
 				Optional:    true,
-				Description: "The page number",
+				Description: "The page number, every page is listed when unset",
 			},
 			"cors_rule": {
 				Type:     schema.TypeList,
@@ -92,29 +92,21 @@ func dataSourceBucketCorsRead(ctx context.Context, d *schema.ResourceData, m int
 	client := m.(*common.Client)
 	service := NewObjectStorageService(client)
 	vpcId := d.Get("vpc_id").(string)
-	s3ServiceDetail := getServiceEnableRegion(service, vpcId, d.Get("region_name").(string))
+	s3ServiceDetail := getServiceEnableRegion(ctx, service, vpcId, d.Get("region_name").(string))
 	if s3ServiceDetail.S3ServiceId == "" {
 		return diag.FromErr(fmt.Errorf(regionError, d.Get("region_name").(string)))
 	}
 	bucketName := d.Get("bucket_name").(string)
-	page := 1
-	if d.Get("page").(int) > 0 {
-		page = d.Get("page").(int)
-	}
-	pageSize := 25
-	if d.Get("page_size").(int) > 0 {
-		pageSize = d.Get("page_size").(int)
-	}
-	corsRule, err := service.GetBucketCors(vpcId, s3ServiceDetail.S3ServiceId, bucketName, page, pageSize)
+	corsRules, err := listPageOrAll(ctx, d, 25, bucketCorsRulePages(service, vpcId, s3ServiceDetail.S3ServiceId, bucketName))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	if corsRule.Total == 0 {
+	if len(corsRules) == 0 {
 		return diag.Errorf("bucket %s does not have cors rule", bucketName)
 	}
 	var formattedData []interface{}
-	for _, rule := range corsRule.CorsRules {
+	for _, rule := range corsRules {
 		formattedData = append(formattedData, map[string]interface{}{
 			"id":              rule.ID,
 			"allowed_headers": rule.AllowedHeaders,
@@ -36,7 +36,7 @@ func DataSourceBucketLifecycle() *schema.Resource {
 			"page": {
 				Type:        schema.TypeInt,
 				Optional:    true,
-				Description: "The page number",
+				Description: "The page number, every page is listed when unset",
 			},
 			"life_cycle_rules": {
 				Type:     schema.TypeList,
@@ -113,10 +113,10 @@ func DataSourceBucketLifecycle() *schema.Resource {
 		},
 	}
 }
-func parseData(lifeCycleResponse BucketLifecycleResponse) []interface{} {
+func parseData(lifecycleRules []BucketLifecycleRule) []interface{} {
 	var formattedData []interface{}
 
-	for _, lifecycleRule := range lifeCycleResponse.Rules {
+	for _, lifecycleRule := range lifecycleRules {
 		data := map[string]interface{}{
 			"id":     lifecycleRule.ID,
 			"status": lifecycleRule.Status,
@@ -168,33 +168,22 @@ func dataSourceBucketLifecycleRead(ctx context.Context, d *schema.ResourceData,
 	bucketName := d.Get("bucket_name").(string)
 	vpcId := d.Get("vpc_id").(string)
 	regionName := d.Get("region_name").(string)
-	s3ServiceDetail := getServiceEnableRegion(service, vpcId, regionName)
+	s3ServiceDetail := getServiceEnableRegion(ctx, service, vpcId, regionName)
 	if s3ServiceDetail.S3ServiceId == "" {
 		return diag.FromErr(fmt.Errorf(regionError, regionName))
 	}
-	page := 1
-	v, ok := d.GetOk("page")
-	if ok {
-		page = v.(int)
-	}
-	pageSize := 25
-	v, ok = d.GetOk("page_size")
-	if ok {
-		pageSize = v.(int)
-	}
-
-	lifeCycleResponse := service.GetBucketLifecycle(vpcId, s3ServiceDetail.S3ServiceId, bucketName, page, pageSize)
-	if !lifeCycleResponse.Status {
-		return diag.FromErr(fmt.Errorf("failed to fetch life cycle rules for bucket %s", bucketName))
+	lifecycleRules, err := listPageOrAll(ctx, d, 25, bucketLifecycleRulePages(service, vpcId, s3ServiceDetail.S3ServiceId, bucketName))
+	if err != nil {
+		return diag.FromErr(err)
 	}
-	if lifeCycleResponse.Total == 0 {
+	if len(lifecycleRules) == 0 {
 		if err := d.Set("life_cycle_rules", make([]interface{}, 0)); err != nil {
 			d.SetId("")
 			return diag.FromErr(err)
 		}
 	}
 	d.SetId(bucketName)
-	formattedData := parseData(lifeCycleResponse)
+	formattedData := parseData(lifecycleRules)
 	if err := d.Set("life_cycle_rules", formattedData); err != nil {
 		d.SetId("")
 		return diag.FromErr(err)
@@ -43,11 +43,11 @@ func dataSourceBucketPolicyRead(ctx context.Context, d *schema.ResourceData, m i
 
 	bucketName := d.Get("bucket_name").(string)
 	vpcId := d.Get("vpc_id").(string)
-	s3ServiceDetail := getServiceEnableRegion(service, vpcId, d.Get("region_name").(string))
+	s3ServiceDetail := getServiceEnableRegion(ctx, service, vpcId, d.Get("region_name").(string))
 	if s3ServiceDetail.S3ServiceId == "" {
 		return diag.FromErr(fmt.Errorf(regionError, d.Get("region_name").(string)))
 	}
-	policyResponse := service.GetBucketPolicy(vpcId, s3ServiceDetail.S3ServiceId, bucketName)
+	policyResponse := service.GetBucketPolicy(ctx, vpcId, s3ServiceDetail.S3ServiceId, bucketName)
 	if !policyResponse.Status {
 		return diag.Errorf("failed to get bucket policy for bucket %s", bucketName)
 	}
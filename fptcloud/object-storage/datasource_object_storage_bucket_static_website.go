@@ -46,12 +46,12 @@ func dataSourceBucketStaticWebsite(ctx context.Context, d *schema.ResourceData,
 
 	bucketName := d.Get("bucket_name").(string)
 	vpcId := d.Get("vpc_id").(string)
-	s3ServiceDetail := getServiceEnableRegion(service, vpcId, d.Get("region_name").(string))
+	s3ServiceDetail := getServiceEnableRegion(ctx, service, vpcId, d.Get("region_name").(string))
 	if s3ServiceDetail.S3ServiceId == "" {
 		return diag.FromErr(fmt.Errorf(regionError, d.Get("region_name").(string)))
 	}
 
-	staticWebsiteResponse := service.GetBucketWebsite(vpcId, s3ServiceDetail.S3ServiceId, bucketName)
+	staticWebsiteResponse := service.GetBucketWebsite(ctx, vpcId, s3ServiceDetail.S3ServiceId, bucketName)
 	if !staticWebsiteResponse.Status {
 		return diag.Errorf("failed to get bucket static website config for bucket %s", bucketName)
 	}
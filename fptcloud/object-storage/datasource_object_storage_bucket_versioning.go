@@ -45,13 +45,13 @@ func dataSourceBucketVersioningRead(ctx context.Context, d *schema.ResourceData,
 	service := NewObjectStorageService(client)
 
 	vpcId := d.Get("vpc_id").(string)
-	s3ServiceDetail := getServiceEnableRegion(service, vpcId, d.Get("region_name").(string))
+	s3ServiceDetail := getServiceEnableRegion(ctx, service, vpcId, d.Get("region_name").(string))
 	if s3ServiceDetail.S3ServiceId == "" {
 		return diag.FromErr(fmt.Errorf(regionError, d.Get("region_name").(string)))
 	}
 	bucketName := d.Get("bucket_name").(string)
 
-	versioning := service.GetBucketVersioning(vpcId, s3ServiceDetail.S3ServiceId, bucketName)
+	versioning := service.GetBucketVersioning(ctx, vpcId, s3ServiceDetail.S3ServiceId, bucketName)
 	if !versioning.Status {
 		return diag.Errorf("Could not get versioning status for bucket %s", bucketName)
 	}
@@ -42,11 +42,11 @@ func DataSourceS3ServiceEnableResponse() *schema.Resource {
 	}
 }
 
-func resourceS3ServiceEnableResponseRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceS3ServiceEnableResponseRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*common.Client)
 	service := NewObjectStorageService(client)
 	vpcId := d.Get("vpc_id").(string)
-	listRegion := service.CheckServiceEnable(vpcId)
+	listRegion := service.CheckServiceEnable(ctx, vpcId)
 	if listRegion.Total == 0 {
 		return diag.FromErr(fmt.Errorf("no region is enabled"))
 	}
@@ -27,7 +27,7 @@ func DataSourceSubUser() *schema.Resource {
 			"page": {
 				Optional:    true,
 				Type:        schema.TypeInt,
-				Description: "Page number",
+				Description: "Page number, every page is listed when unset",
 			},
 			"page_size": {
 				Optional:    true,
@@ -68,28 +68,19 @@ func dataSourceSubUserRead(ctx context.Context, d *schema.ResourceData, m interf
 	service := NewObjectStorageService(client)
 	vpcId := d.Get("vpc_id").(string)
 	regionName := d.Get("region_name").(string)
-	s3ServiceDetail := getServiceEnableRegion(service, vpcId, regionName)
+	s3ServiceDetail := getServiceEnableRegion(ctx, service, vpcId, regionName)
 	if s3ServiceDetail.S3ServiceId == "" {
 		return diag.FromErr(fmt.Errorf(regionError, regionName))
 	}
-	page := 1
-	pageSize := 100
-	if d.Get("page").(int) > 0 {
-		page = d.Get("page").(int)
-	}
-	if d.Get("page_size").(int) > 0 {
-		pageSize = d.Get("page_size").(int)
-	}
-
-	subUsers, err := service.ListSubUsers(vpcId, s3ServiceDetail.S3ServiceId, page, pageSize)
+	subUsers, err := listPageOrAll(ctx, d, 100, subUserPages(service, vpcId, s3ServiceDetail.S3ServiceId))
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	if subUsers.Total == 0 {
+	if len(subUsers) == 0 {
 		return diag.FromErr(fmt.Errorf("no sub-user found"))
 	}
 	var formattedData []interface{}
-	for _, subUser := range subUsers.SubUsers {
+	for _, subUser := range subUsers {
 		formattedData = append(formattedData, map[string]interface{}{
 			"user_id": subUser.UserID,
 			"role":    subUser.Role,
@@ -61,7 +61,7 @@ func dataSourceSubUserDetailRead(ctx context.Context, d *schema.ResourceData, m
 	vpcId := d.Get("vpc_id").(string)
 
 	// Get all available regions and find the one with the sub-user
-	serviceEnable := objectStorageService.CheckServiceEnable(vpcId)
+	serviceEnable := objectStorageService.CheckServiceEnable(ctx, vpcId)
 	if serviceEnable.Total == 0 {
 		return diag.FromErr(fmt.Errorf("no S3 services found for VPC %s", vpcId))
 	}
@@ -71,7 +71,7 @@ func dataSourceSubUserDetailRead(ctx context.Context, d *schema.ResourceData, m
 
 	// Try to find the sub-user in any available region
 	for _, service := range serviceEnable.Data {
-		subUser = objectStorageService.DetailSubUser(vpcId, service.S3ServiceID, subUserId)
+		subUser, _ = objectStorageService.DetailSubUser(ctx, vpcId, service.S3ServiceID, subUserId)
 		if subUser != nil && subUser.UserID != "" {
 			break
 		}
@@ -0,0 +1,186 @@
+package fptcloud_object_storage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	common "terraform-provider-fptcloud/commons"
+	"terraform-provider-fptcloud/commons/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &ephemeralObjectStorageCredentials{}
+	_ ephemeral.EphemeralResourceWithConfigure = &ephemeralObjectStorageCredentials{}
+	_ ephemeral.EphemeralResourceWithClose     = &ephemeralObjectStorageCredentials{}
+)
+
+// credentialsPrivateKey is the key of the private data locating the access key to delete on close
+const credentialsPrivateKey = "credentials"
+
+type ephemeralObjectStorageCredentials struct {
+	client  *common.Client
+	service ObjectStorageService
+}
+
+type objectStorageCredentialsModel struct {
+	VpcId           types.String `tfsdk:"vpc_id"`
+	RegionName      types.String `tfsdk:"region_name"`
+	UserId          types.String `tfsdk:"user_id"`
+	AccessKeyId     types.String `tfsdk:"access_key_id"`
+	SecretAccessKey types.String `tfsdk:"secret_access_key"`
+}
+
+// objectStorageCredentials locates an access key opened by the ephemeral resource
+type objectStorageCredentials struct {
+	VpcId       string `json:"vpc_id"`
+	S3ServiceId string `json:"s3_service_id"`
+	UserId      string `json:"user_id,omitempty"`
+	AccessKeyId string `json:"access_key_id"`
+}
+
+// NewEphemeralObjectStorageCredentials returns the ephemeral object storage credentials, an access key created when
+// Terraform needs it and deleted once Terraform is done with it, so that its secret is never persisted in the state
+func NewEphemeralObjectStorageCredentials() ephemeral.EphemeralResource {
+	return &ephemeralObjectStorageCredentials{}
+}
+
+func (e *ephemeralObjectStorageCredentials) Metadata(_ context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_object_storage_credentials"
+}
+
+func (e *ephemeralObjectStorageCredentials) Schema(_ context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "Creates a short-lived object storage access key, of the tenant or of a sub user, which is deleted once Terraform is done with it and never stored in the plan or state. Requires Terraform 1.10 or later.",
+		Attributes: map[string]schema.Attribute{
+			"vpc_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Validators:  []validator.String{utils.NotEmptyValidator()},
+				Description: common.InheritedVpcIdDescription("The VPC id that the S3 service belongs to"),
+			},
+			"region_name": schema.StringAttribute{
+				Required:    true,
+				Validators:  []validator.String{utils.NotEmptyValidator()},
+				Description: "The region name that's are the same with the region name in the S3 service. Currently, we have: HCM-01, HCM-02, HN-01, HN-02",
+			},
+			"user_id": schema.StringAttribute{
+				Optional:    true,
+				Validators:  []validator.String{utils.NotEmptyValidator()},
+				Description: "The sub user id, can retrieve from data source `fptcloud_object_storage_sub_user`. The access key is created for the tenant when unset.",
+			},
+			"access_key_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The access key ID",
+			},
+			"secret_access_key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The secret access key",
+			},
+		},
+	}
+}
+
+func (e *ephemeralObjectStorageCredentials) Configure(_ context.Context, request ephemeral.ConfigureRequest, response *ephemeral.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*common.Client)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *common.Client, got: %T. Please report this issue to the provider developers.", request.ProviderData),
+		)
+		return
+	}
+
+	e.client = client
+	e.service = NewObjectStorageService(client)
+}
+
+func (e *ephemeralObjectStorageCredentials) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var config objectStorageCredentialsModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	config.VpcId = e.client.DefaultVpcIdValue(config.VpcId, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	s3ServiceId := getServiceEnableRegion(ctx, e.service, config.VpcId.ValueString(), config.RegionName.ValueString()).S3ServiceId
+	if s3ServiceId == "" {
+		response.Diagnostics.AddError("Error creating object storage credentials", fmt.Sprintf(regionError, config.RegionName.ValueString()))
+		return
+	}
+
+	credentials := objectStorageCredentials{
+		VpcId:       config.VpcId.ValueString(),
+		S3ServiceId: s3ServiceId,
+		UserId:      config.UserId.ValueString(),
+	}
+	var secretKey string
+	if credentials.UserId != "" {
+		tflog.Info(ctx, "Creating an access key for the sub user "+credentials.UserId)
+		resp := e.service.CreateSubUserAccessKey(ctx, credentials.VpcId, s3ServiceId, credentials.UserId)
+		if !resp.Status {
+			response.Diagnostics.AddError("Error creating object storage credentials", fmt.Sprintf("error creating sub-user access key: %s", resp.Message))
+			return
+		}
+		credentials.AccessKeyId, secretKey = resp.Credential.AccessKey, resp.Credential.SecretKey
+	} else {
+		tflog.Info(ctx, "Creating an access key in the region "+config.RegionName.ValueString())
+		resp := e.service.CreateAccessKey(ctx, credentials.VpcId, s3ServiceId)
+		if !resp.Status {
+			response.Diagnostics.AddError("Error creating object storage credentials", fmt.Sprintf("failed to create access key: %s", resp.Message))
+			return
+		}
+		credentials.AccessKeyId, secretKey = resp.Credential.AccessKey, resp.Credential.SecretKey
+	}
+
+	private, err := json.Marshal(credentials)
+	if err != nil {
+		response.Diagnostics.AddError("Error creating object storage credentials", err.Error())
+		return
+	}
+	response.Diagnostics.Append(response.Private.SetKey(ctx, credentialsPrivateKey, private)...)
+
+	config.AccessKeyId = types.StringValue(credentials.AccessKeyId)
+	config.SecretAccessKey = types.StringValue(secretKey)
+	response.Diagnostics.Append(response.Result.Set(ctx, &config)...)
+}
+
+// Close deletes the access key created by Open
+func (e *ephemeralObjectStorageCredentials) Close(ctx context.Context, request ephemeral.CloseRequest, response *ephemeral.CloseResponse) {
+	private, diags := request.Private.GetKey(ctx, credentialsPrivateKey)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() || private == nil {
+		return
+	}
+
+	var credentials objectStorageCredentials
+	if err := json.Unmarshal(private, &credentials); err != nil {
+		response.Diagnostics.AddError("Error deleting object storage credentials", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "Deleting the access key "+credentials.AccessKeyId)
+	var resp CommonResponse
+	if credentials.UserId != "" {
+		resp = e.service.DeleteSubUserAccessKey(ctx, credentials.VpcId, credentials.S3ServiceId, credentials.UserId, credentials.AccessKeyId)
+	} else {
+		resp = e.service.DeleteAccessKey(ctx, credentials.VpcId, credentials.S3ServiceId, credentials.AccessKeyId)
+	}
+	if !resp.Status {
+		response.Diagnostics.AddError("Error deleting object storage credentials", fmt.Sprintf("failed to delete the access key %s: %s", credentials.AccessKeyId, resp.Message))
+	}
+}
@@ -42,39 +42,34 @@ type BucketCors struct {
 }
 
 type BucketCorsResponse struct {
-	Status    bool `json:"status"`
-	CorsRules []struct {
-		ID             string   `json:"ID"`
-		AllowedHeaders []string `json:"AllowedHeaders,omitempty"`
-		AllowedMethods []string `json:"AllowedMethods"`
-		AllowedOrigins []string `json:"AllowedOrigins"`
-		ExposeHeaders  []string `json:"ExposeHeaders,omitempty"`
-		MaxAgeSeconds  int      `json:"MaxAgeSeconds"`
-	} `json:"cors_rules"`
-	Total int `json:"total"`
+	Status    bool       `json:"status"`
+	CorsRules []CorsRule `json:"cors_rules"`
+	Total     int        `json:"total"`
 }
 
 type BucketLifecycleResponse struct {
-	Status bool `json:"status"`
-	Rules  []struct {
-		Expiration struct {
-			ExpiredObjectDeleteMarker bool `json:"ExpiredObjectDeleteMarker,omitempty"`
-			Days                      int  `json:"Days,omitempty"`
-		} `json:"Expiration"`
-		ID     string `json:"ID"`
-		Filter struct {
-			Prefix string `json:"Prefix"`
-		} `json:"Filter,omitempty"`
-		Status                      string `json:"Status"`
-		NoncurrentVersionExpiration struct {
-			NoncurrentDays int `json:"NoncurrentDays"`
-		} `json:"NoncurrentVersionExpiration"`
-		AbortIncompleteMultipartUpload struct {
-			DaysAfterInitiation int `json:"DaysAfterInitiation"`
-		} `json:"AbortIncompleteMultipartUpload"`
-		Prefix string `json:"Prefix,omitempty"`
-	} `json:"rules"`
-	Total int `json:"total"`
+	Status bool                  `json:"status"`
+	Rules  []BucketLifecycleRule `json:"rules"`
+	Total  int                   `json:"total"`
+}
+
+type BucketLifecycleRule struct {
+	Expiration struct {
+		ExpiredObjectDeleteMarker bool `json:"ExpiredObjectDeleteMarker,omitempty"`
+		Days                      int  `json:"Days,omitempty"`
+	} `json:"Expiration"`
+	ID     string `json:"ID"`
+	Filter struct {
+		Prefix string `json:"Prefix"`
+	} `json:"Filter,omitempty"`
+	Status                      string `json:"Status"`
+	NoncurrentVersionExpiration struct {
+		NoncurrentDays int `json:"NoncurrentDays"`
+	} `json:"NoncurrentVersionExpiration"`
+	AbortIncompleteMultipartUpload struct {
+		DaysAfterInitiation int `json:"DaysAfterInitiation"`
+	} `json:"AbortIncompleteMultipartUpload"`
+	Prefix string `json:"Prefix,omitempty"`
 }
 
 type BucketPolicyRequest struct {
@@ -176,15 +171,17 @@ type Filter struct {
 }
 
 type ListBucketResponse struct {
-	Buckets []struct {
-		Name             string `json:"Name"`
-		CreationDate     string `json:"CreationDate"`
-		IsEmpty          bool   `json:"isEmpty"`
-		S3ServiceID      string `json:"s3_service_id"`
-		IsEnabledLogging bool   `json:"isEnabledLogging"`
-		Endpoint         string `json:"endpoint"`
-	} `json:"buckets"`
-	Total int `json:"total"`
+	Buckets []Bucket `json:"buckets"`
+	Total   int      `json:"total"`
+}
+
+type Bucket struct {
+	Name             string `json:"Name"`
+	CreationDate     string `json:"CreationDate"`
+	IsEmpty          bool   `json:"isEmpty"`
+	S3ServiceID      string `json:"s3_service_id"`
+	IsEnabledLogging bool   `json:"isEnabledLogging"`
+	Endpoint         string `json:"endpoint"`
 }
 
 type NoncurrentVersionExpiration struct {
@@ -251,13 +248,6 @@ type SubUserCreateRequest struct {
 }
 
 type SubUserListResponse struct {
-	SubUsers []struct {
-		UserID     string   `json:"user_id"`
-		Arn        string   `json:"arn"`
-		Active     bool     `json:"active"`
-		Role       string   `json:"role"`
-		CreatedAt  string   `json:"created_at,omitempty"`
-		AccessKeys []string `json:"access_keys,omitempty"`
-	} `json:"sub_users"`
-	Total int `json:"total"`
+	SubUsers []DetailSubUser `json:"sub_users"`
+	Total    int             `json:"total"`
 }
@@ -1,6 +1,7 @@
 package fptcloud_object_storage
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -13,52 +14,52 @@ const (
 
 // ObjectStorageService defines the interface for object storage operations
 type ObjectStorageService interface {
-	CheckServiceEnable(vpcId string) S3ServiceEnableResponse
+	CheckServiceEnable(ctx context.Context, vpcId string) S3ServiceEnableResponse
 
 	// Bucket
-	ListBuckets(vpcId, s3ServiceId string, page, pageSize int) ListBucketResponse
-	CreateBucket(req BucketRequest, vpcId, s3ServiceId string) CommonResponse
-	DeleteBucket(vpcId, s3ServiceId, bucketName string) CommonResponse
+	ListBuckets(ctx context.Context, vpcId, s3ServiceId string, page, pageSize int) (ListBucketResponse, error)
+	CreateBucket(ctx context.Context, req BucketRequest, vpcId, s3ServiceId string) CommonResponse
+	DeleteBucket(ctx context.Context, vpcId, s3ServiceId, bucketName string) CommonResponse
 
 	// Access key
-	ListAccessKeys(vpcId, s3ServiceId string) (AccessKey, error)
-	DeleteAccessKey(vpcId, s3ServiceId, accessKeyId string) CommonResponse
-	CreateAccessKey(vpcId, s3ServiceId string) *CreateAccessKeyResponse
+	ListAccessKeys(ctx context.Context, vpcId, s3ServiceId string) (AccessKey, error)
+	DeleteAccessKey(ctx context.Context, vpcId, s3ServiceId, accessKeyId string) CommonResponse
+	CreateAccessKey(ctx context.Context, vpcId, s3ServiceId string) *CreateAccessKeyResponse
 
 	// Sub user
-	CreateSubUser(req SubUser, vpcId, s3ServiceId string) *CommonResponse
-	DeleteSubUser(vpcId, s3ServiceId, subUserId string) error
-	ListSubUsers(vpcId, s3ServiceId string, page, pageSize int) (SubUserListResponse, error)
-	DetailSubUser(vpcId, s3ServiceId, subUserId string) *DetailSubUser
-	CreateSubUserAccessKey(vpcId, s3ServiceId, subUserId string) *SubUserCreateKeyResponse
-	DeleteSubUserAccessKey(vpcId, s3ServiceId, subUserId, accessKeyId string) CommonResponse
+	CreateSubUser(ctx context.Context, req SubUser, vpcId, s3ServiceId string) *CommonResponse
+	DeleteSubUser(ctx context.Context, vpcId, s3ServiceId, subUserId string) error
+	ListSubUsers(ctx context.Context, vpcId, s3ServiceId string, page, pageSize int) (SubUserListResponse, error)
+	DetailSubUser(ctx context.Context, vpcId, s3ServiceId, subUserId string) (*DetailSubUser, error)
+	CreateSubUserAccessKey(ctx context.Context, vpcId, s3ServiceId, subUserId string) *SubUserCreateKeyResponse
+	DeleteSubUserAccessKey(ctx context.Context, vpcId, s3ServiceId, subUserId, accessKeyId string) CommonResponse
 
 	// bucket configuration
-	PutBucketPolicy(vpcId, s3ServiceId, bucketName string, policy interface{}) CommonResponse
-	GetBucketPolicy(vpcId, s3ServiceId, bucketName string) *BucketPolicyResponse
+	PutBucketPolicy(ctx context.Context, vpcId, s3ServiceId, bucketName string, policy interface{}) CommonResponse
+	GetBucketPolicy(ctx context.Context, vpcId, s3ServiceId, bucketName string) *BucketPolicyResponse
 
 	// CORS configuration
-	CreateBucketCors(vpcId, s3ServiceId, bucketName string, cors map[string]interface{}) CommonResponse
-	UpdateBucketCors(vpcId, s3ServiceId, bucketName string, cors []map[string]interface{}) CommonResponse
-	GetBucketCors(vpcId, s3ServiceId, bucketName string, page, pageSize int) (*BucketCorsResponse, error)
+	CreateBucketCors(ctx context.Context, vpcId, s3ServiceId, bucketName string, cors map[string]interface{}) CommonResponse
+	UpdateBucketCors(ctx context.Context, vpcId, s3ServiceId, bucketName string, cors []map[string]interface{}) CommonResponse
+	GetBucketCors(ctx context.Context, vpcId, s3ServiceId, bucketName string, page, pageSize int) (*BucketCorsResponse, error)
 
 	// Versioning configuration
-	PutBucketVersioning(vpcId, s3ServiceId, bucketName string, versioning BucketVersioningRequest) error
-	GetBucketVersioning(vpcId, s3ServiceId, bucketName string) *BucketVersioningResponse
+	PutBucketVersioning(ctx context.Context, vpcId, s3ServiceId, bucketName string, versioning BucketVersioningRequest) error
+	GetBucketVersioning(ctx context.Context, vpcId, s3ServiceId, bucketName string) *BucketVersioningResponse
 
 	// Acl configuration
-	PutBucketAcl(vpcId, s3ServiceId, bucketName string, acl BucketAclRequest) PutBucketAclResponse
-	GetBucketAcl(vpcId, s3ServiceId, bucketName string) *BucketAclResponse
+	PutBucketAcl(ctx context.Context, vpcId, s3ServiceId, bucketName string, acl BucketAclRequest) PutBucketAclResponse
+	GetBucketAcl(ctx context.Context, vpcId, s3ServiceId, bucketName string) *BucketAclResponse
 
 	// Static website configuration
-	PutBucketWebsite(vpcId, s3ServiceId, bucketName string, website BucketWebsiteRequest) CommonResponse
-	GetBucketWebsite(vpcId, s3ServiceId, bucketName string) *BucketWebsiteResponse
-	DeleteBucketStaticWebsite(vpcId, s3ServiceId, bucketName string) CommonResponse
+	PutBucketWebsite(ctx context.Context, vpcId, s3ServiceId, bucketName string, website BucketWebsiteRequest) CommonResponse
+	GetBucketWebsite(ctx context.Context, vpcId, s3ServiceId, bucketName string) *BucketWebsiteResponse
+	DeleteBucketStaticWebsite(ctx context.Context, vpcId, s3ServiceId, bucketName string) CommonResponse
 
 	// Lifecycle configuration
-	GetBucketLifecycle(vpcId, s3ServiceId, bucketName string, page, pageSize int) BucketLifecycleResponse
-	PutBucketLifecycle(vpcId, s3ServiceId, bucketName string, lifecycle map[string]interface{}) CommonResponse
-	DeleteBucketLifecycle(vpcId, s3ServiceId, bucketName string, lifecycle map[string]interface{}) CommonResponse
+	GetBucketLifecycle(ctx context.Context, vpcId, s3ServiceId, bucketName string, page, pageSize int) BucketLifecycleResponse
+	PutBucketLifecycle(ctx context.Context, vpcId, s3ServiceId, bucketName string, lifecycle map[string]interface{}) CommonResponse
+	DeleteBucketLifecycle(ctx context.Context, vpcId, s3ServiceId, bucketName string, lifecycle map[string]interface{}) CommonResponse
 }
 
 // ObjectStorageServiceImpl is the implementation of ObjectStorageService
@@ -71,9 +72,9 @@ func NewObjectStorageService(client *common.Client) ObjectStorageService {
 	return &ObjectStorageServiceImpl{client: client}
 }
 
-func (s *ObjectStorageServiceImpl) CheckServiceEnable(vpcId string) S3ServiceEnableResponse {
+func (s *ObjectStorageServiceImpl) CheckServiceEnable(ctx context.Context, vpcId string) S3ServiceEnableResponse {
 	apiPath := common.ApiPath.CheckS3ServiceEnable(vpcId)
-	resp, err := s.client.SendGetRequest(apiPath)
+	resp, err := s.client.SendGetRequestWithContext(ctx, apiPath)
 	if err != nil {
 		log.Printf("[ERROR] FPTCloud Provider - CheckServiceEnable API request failed: %v", err)
 		return S3ServiceEnableResponse{Total: 0}
@@ -87,10 +88,10 @@ func (s *ObjectStorageServiceImpl) CheckServiceEnable(vpcId string) S3ServiceEna
 	return response
 }
 
-func (s *ObjectStorageServiceImpl) CreateBucket(req BucketRequest, vpcId, s3ServiceId string) CommonResponse {
+func (s *ObjectStorageServiceImpl) CreateBucket(ctx context.Context, req BucketRequest, vpcId, s3ServiceId string) CommonResponse {
 
 	apiPath := common.ApiPath.CreateBucket(vpcId, s3ServiceId)
-	resp, err := s.client.SendPostRequest(apiPath, req)
+	resp, err := s.client.SendPostRequestWithContext(ctx, apiPath, req)
 	if err != nil {
 		return CommonResponse{Status: false, Message: err.Error()}
 	}
@@ -105,9 +106,9 @@ func (s *ObjectStorageServiceImpl) CreateBucket(req BucketRequest, vpcId, s3Serv
 }
 
 // CreateSubUser creates a new sub-user
-func (s *ObjectStorageServiceImpl) CreateSubUser(req SubUser, vpcId, s3ServiceId string) *CommonResponse {
+func (s *ObjectStorageServiceImpl) CreateSubUser(ctx context.Context, req SubUser, vpcId, s3ServiceId string) *CommonResponse {
 	apiPath := common.ApiPath.CreateSubUser(vpcId, s3ServiceId)
-	resp, err := s.client.SendPostRequest(apiPath, req)
+	resp, err := s.client.SendPostRequestWithContext(ctx, apiPath, req)
 	if err != nil {
 		return &CommonResponse{Status: false, Message: err.Error()}
 	}
@@ -120,9 +121,9 @@ func (s *ObjectStorageServiceImpl) CreateSubUser(req SubUser, vpcId, s3ServiceId
 	return &CommonResponse{Status: subUser.Status, Message: "Sub-user created successfully"}
 }
 
-func (s *ObjectStorageServiceImpl) CreateAccessKey(vpcId, s3ServiceId string) *CreateAccessKeyResponse {
+func (s *ObjectStorageServiceImpl) CreateAccessKey(ctx context.Context, vpcId, s3ServiceId string) *CreateAccessKeyResponse {
 	apiPath := common.ApiPath.CreateAccessKey(vpcId, s3ServiceId)
-	resp, err := s.client.SendPostRequest(apiPath, nil)
+	resp, err := s.client.SendPostRequestWithContext(ctx, apiPath, nil)
 	if err != nil {
 		return &CreateAccessKeyResponse{Status: false, Message: err.Error()}
 	}
@@ -136,25 +137,25 @@ func (s *ObjectStorageServiceImpl) CreateAccessKey(vpcId, s3ServiceId string) *C
 	return &accessKey
 }
 
-func (s *ObjectStorageServiceImpl) ListBuckets(vpcId, s3ServiceId string, page, pageSize int) ListBucketResponse {
+func (s *ObjectStorageServiceImpl) ListBuckets(ctx context.Context, vpcId, s3ServiceId string, page, pageSize int) (ListBucketResponse, error) {
 	apiPath := common.ApiPath.ListBuckets(vpcId, s3ServiceId, page, pageSize)
-	resp, err := s.client.SendGetRequest(apiPath)
+	resp, err := s.client.SendGetRequestWithContext(ctx, apiPath)
 	if err != nil {
-		return ListBucketResponse{Total: 0}
+		return ListBucketResponse{Total: 0}, fmt.Errorf("failed to list buckets: %w", common.DecodeError(err))
 	}
 
 	var buckets ListBucketResponse
 	err = json.Unmarshal(resp, &buckets)
 	if err != nil {
-		return ListBucketResponse{Total: 0}
+		return ListBucketResponse{Total: 0}, fmt.Errorf("failed to unmarshal bucket list response: %v", err)
 	}
 
-	return buckets
+	return buckets, nil
 }
 
-func (s *ObjectStorageServiceImpl) ListSubUsers(vpcId, s3ServiceId string, page, pageSize int) (SubUserListResponse, error) {
+func (s *ObjectStorageServiceImpl) ListSubUsers(ctx context.Context, vpcId, s3ServiceId string, page, pageSize int) (SubUserListResponse, error) {
 	apiPath := common.ApiPath.ListSubUsers(vpcId, s3ServiceId, page, pageSize)
-	resp, err := s.client.SendGetRequest(apiPath)
+	resp, err := s.client.SendGetRequestWithContext(ctx, apiPath)
 	if err != nil {
 		return SubUserListResponse{Total: 0}, fmt.Errorf("failed to list sub-users: %v", err)
 	}
@@ -168,9 +169,9 @@ func (s *ObjectStorageServiceImpl) ListSubUsers(vpcId, s3ServiceId string, page,
 	return subUsers, nil
 }
 
-func (s *ObjectStorageServiceImpl) ListAccessKeys(vpcId, s3ServiceId string) (AccessKey, error) {
+func (s *ObjectStorageServiceImpl) ListAccessKeys(ctx context.Context, vpcId, s3ServiceId string) (AccessKey, error) {
 	apiPath := common.ApiPath.ListAccessKeys(vpcId, s3ServiceId)
-	resp, err := s.client.SendGetRequest(apiPath)
+	resp, err := s.client.SendGetRequestWithContext(ctx, apiPath)
 	if err != nil {
 		return AccessKey{}, fmt.Errorf("failed to list access keys: %v", err)
 	}
@@ -184,39 +185,39 @@ func (s *ObjectStorageServiceImpl) ListAccessKeys(vpcId, s3ServiceId string) (Ac
 	return accessKey, nil
 }
 
-func (s *ObjectStorageServiceImpl) DeleteBucket(vpcId, s3ServiceId, bucketName string) CommonResponse {
+func (s *ObjectStorageServiceImpl) DeleteBucket(ctx context.Context, vpcId, s3ServiceId, bucketName string) CommonResponse {
 	apiPath := common.ApiPath.DeleteBucket(vpcId, s3ServiceId)
 	payload := map[string]string{"name": bucketName}
 
-	if _, err := s.client.SendDeleteRequestWithBody(apiPath, payload); err != nil {
+	if _, err := s.client.SendDeleteRequestWithBodyWithContext(ctx, apiPath, payload); err != nil {
 
 		return CommonResponse{Status: false}
 	}
 	return CommonResponse{Status: true, Message: "Bucket deleted successfully"}
 }
 
-func (s *ObjectStorageServiceImpl) DeleteAccessKey(vpcId, s3ServiceId, accessKeyId string) CommonResponse {
+func (s *ObjectStorageServiceImpl) DeleteAccessKey(ctx context.Context, vpcId, s3ServiceId, accessKeyId string) CommonResponse {
 	apiPath := common.ApiPath.DeleteAccessKey(vpcId, s3ServiceId)
 	body := map[string]string{"accessKey": accessKeyId}
 
-	if _, err := s.client.SendDeleteRequestWithBody(apiPath, body); err != nil {
+	if _, err := s.client.SendDeleteRequestWithBodyWithContext(ctx, apiPath, body); err != nil {
 		return CommonResponse{Status: false, Message: err.Error()}
 	}
 	return CommonResponse{Status: true, Message: "Access key deleted successfully"}
 }
 
 // Implement bucket policy methods
-func (s *ObjectStorageServiceImpl) PutBucketPolicy(vpcId, s3ServiceId, bucketName string, policy interface{}) CommonResponse {
+func (s *ObjectStorageServiceImpl) PutBucketPolicy(ctx context.Context, vpcId, s3ServiceId, bucketName string, policy interface{}) CommonResponse {
 	apiPath := common.ApiPath.PutBucketPolicy(vpcId, s3ServiceId, bucketName)
-	if _, err := s.client.SendPutRequest(apiPath, policy); err != nil {
+	if _, err := s.client.SendPutRequestWithContext(ctx, apiPath, policy); err != nil {
 		return CommonResponse{Status: false}
 	}
 	return CommonResponse{Status: true}
 }
 
-func (s *ObjectStorageServiceImpl) GetBucketPolicy(vpcId, s3ServiceId, bucketName string) *BucketPolicyResponse {
+func (s *ObjectStorageServiceImpl) GetBucketPolicy(ctx context.Context, vpcId, s3ServiceId, bucketName string) *BucketPolicyResponse {
 	apiPath := common.ApiPath.GetBucketPolicy(vpcId, s3ServiceId, bucketName)
-	resp, err := s.client.SendGetRequest(apiPath)
+	resp, err := s.client.SendGetRequestWithContext(ctx, apiPath)
 	if err != nil {
 		return &BucketPolicyResponse{Status: false}
 	}
@@ -229,27 +230,27 @@ func (s *ObjectStorageServiceImpl) GetBucketPolicy(vpcId, s3ServiceId, bucketNam
 }
 
 // Implement CORS methods
-func (s *ObjectStorageServiceImpl) CreateBucketCors(vpcId, s3ServiceId, bucketName string, cors map[string]interface{}) CommonResponse {
+func (s *ObjectStorageServiceImpl) CreateBucketCors(ctx context.Context, vpcId, s3ServiceId, bucketName string, cors map[string]interface{}) CommonResponse {
 	apiPath := common.ApiPath.CreateBucketCors(vpcId, s3ServiceId, bucketName)
-	if _, err := s.client.SendPostRequest(apiPath, cors); err != nil {
+	if _, err := s.client.SendPostRequestWithContext(ctx, apiPath, cors); err != nil {
 		return CommonResponse{Status: false, Message: err.Error()}
 	}
 	return CommonResponse{Status: true, Message: "Bucket CORS configuration updated successfully"}
 }
 
-func (s *ObjectStorageServiceImpl) UpdateBucketCors(vpcId, s3ServiceId, bucketName string, cors []map[string]interface{}) CommonResponse {
+func (s *ObjectStorageServiceImpl) UpdateBucketCors(ctx context.Context, vpcId, s3ServiceId, bucketName string, cors []map[string]interface{}) CommonResponse {
 	apiPath := common.ApiPath.PutBucketCORS(vpcId, s3ServiceId, bucketName)
 	// Backend expects { "CORSRules": [ ... ] }
 	payload := map[string]interface{}{"CORSRules": cors}
-	if _, err := s.client.SendPutRequest(apiPath, payload); err != nil {
+	if _, err := s.client.SendPutRequestWithContext(ctx, apiPath, payload); err != nil {
 		return CommonResponse{Status: false, Message: err.Error()}
 	}
 	return CommonResponse{Status: true, Message: "Bucket CORS configuration updated successfully"}
 }
 
-func (s *ObjectStorageServiceImpl) GetBucketCors(vpcId, s3ServiceId, bucketName string, page, pageSize int) (*BucketCorsResponse, error) {
+func (s *ObjectStorageServiceImpl) GetBucketCors(ctx context.Context, vpcId, s3ServiceId, bucketName string, page, pageSize int) (*BucketCorsResponse, error) {
 	apiPath := common.ApiPath.GetBucketCORS(vpcId, s3ServiceId, bucketName, page, pageSize)
-	resp, err := s.client.SendGetRequest(apiPath)
+	resp, err := s.client.SendGetRequestWithContext(ctx, apiPath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get bucket CORS: %v", err)
 	}
@@ -262,17 +263,17 @@ func (s *ObjectStorageServiceImpl) GetBucketCors(vpcId, s3ServiceId, bucketName
 }
 
 // Implement versioning methods
-func (s *ObjectStorageServiceImpl) PutBucketVersioning(vpcId, s3ServiceId, bucketName string, versioning BucketVersioningRequest) error {
+func (s *ObjectStorageServiceImpl) PutBucketVersioning(ctx context.Context, vpcId, s3ServiceId, bucketName string, versioning BucketVersioningRequest) error {
 	apiPath := common.ApiPath.PutBucketVersioning(vpcId, s3ServiceId, bucketName)
-	if _, err := s.client.SendPutRequest(apiPath, versioning); err != nil {
+	if _, err := s.client.SendPutRequestWithContext(ctx, apiPath, versioning); err != nil {
 		return fmt.Errorf("failed to put bucket versioning: %v", err)
 	}
 	return nil
 }
 
-func (s *ObjectStorageServiceImpl) GetBucketVersioning(vpcId, s3ServiceId, bucketName string) *BucketVersioningResponse {
+func (s *ObjectStorageServiceImpl) GetBucketVersioning(ctx context.Context, vpcId, s3ServiceId, bucketName string) *BucketVersioningResponse {
 	apiPath := common.ApiPath.GetBucketVersioning(vpcId, s3ServiceId, bucketName)
-	resp, err := s.client.SendGetRequest(apiPath)
+	resp, err := s.client.SendGetRequestWithContext(ctx, apiPath)
 	if err != nil {
 		return &BucketVersioningResponse{Status: false}
 	}
@@ -284,23 +285,23 @@ func (s *ObjectStorageServiceImpl) GetBucketVersioning(vpcId, s3ServiceId, bucke
 	return &versioning
 }
 
-func (s *ObjectStorageServiceImpl) PutBucketWebsite(vpcId, s3ServiceId, bucketName string, website BucketWebsiteRequest) CommonResponse {
+func (s *ObjectStorageServiceImpl) PutBucketWebsite(ctx context.Context, vpcId, s3ServiceId, bucketName string, website BucketWebsiteRequest) CommonResponse {
 	apiPath := common.ApiPath.PutBucketWebsite(vpcId, s3ServiceId, bucketName)
-	if _, err := s.client.SendPutRequest(apiPath, website); err != nil {
+	if _, err := s.client.SendPutRequestWithContext(ctx, apiPath, website); err != nil {
 		return CommonResponse{Status: false}
 	}
 	return CommonResponse{Status: true}
 }
-func (s *ObjectStorageServiceImpl) DeleteBucketStaticWebsite(vpcId, s3ServiceId, bucketName string) CommonResponse {
+func (s *ObjectStorageServiceImpl) DeleteBucketStaticWebsite(ctx context.Context, vpcId, s3ServiceId, bucketName string) CommonResponse {
 	apiPath := common.ApiPath.DeleteBucketStaticWebsite(vpcId, s3ServiceId, bucketName)
-	if _, err := s.client.SendDeleteRequest(apiPath); err != nil {
+	if _, err := s.client.SendDeleteRequestWithContext(ctx, apiPath); err != nil {
 		return CommonResponse{Status: false}
 	}
 	return CommonResponse{Status: true}
 }
-func (s *ObjectStorageServiceImpl) GetBucketWebsite(vpcId, s3ServiceId, bucketName string) *BucketWebsiteResponse {
+func (s *ObjectStorageServiceImpl) GetBucketWebsite(ctx context.Context, vpcId, s3ServiceId, bucketName string) *BucketWebsiteResponse {
 	apiPath := common.ApiPath.GetBucketWebsite(vpcId, s3ServiceId, bucketName)
-	resp, err := s.client.SendGetRequest(apiPath)
+	resp, err := s.client.SendGetRequestWithContext(ctx, apiPath)
 	if err != nil {
 		return &BucketWebsiteResponse{Status: false}
 	}
@@ -312,9 +313,9 @@ func (s *ObjectStorageServiceImpl) GetBucketWebsite(vpcId, s3ServiceId, bucketNa
 	return &website
 }
 
-func (s *ObjectStorageServiceImpl) PutBucketAcl(vpcId, s3ServiceId, bucketName string, acl BucketAclRequest) PutBucketAclResponse {
+func (s *ObjectStorageServiceImpl) PutBucketAcl(ctx context.Context, vpcId, s3ServiceId, bucketName string, acl BucketAclRequest) PutBucketAclResponse {
 	apiPath := common.ApiPath.PutBucketAcl(vpcId, s3ServiceId, bucketName)
-	resp, err := s.client.SendPutRequest(apiPath, acl)
+	resp, err := s.client.SendPutRequestWithContext(ctx, apiPath, acl)
 	if err != nil {
 		return PutBucketAclResponse{Status: false}
 	}
@@ -326,9 +327,9 @@ func (s *ObjectStorageServiceImpl) PutBucketAcl(vpcId, s3ServiceId, bucketName s
 	return putBucketAclResponse
 }
 
-func (s *ObjectStorageServiceImpl) GetBucketAcl(vpcId, s3ServiceId, bucketName string) *BucketAclResponse {
+func (s *ObjectStorageServiceImpl) GetBucketAcl(ctx context.Context, vpcId, s3ServiceId, bucketName string) *BucketAclResponse {
 	apiPath := common.ApiPath.GetBucketAcl(vpcId, s3ServiceId, bucketName)
-	resp, err := s.client.SendGetRequest(apiPath)
+	resp, err := s.client.SendGetRequestWithContext(ctx, apiPath)
 	if err != nil {
 		return &BucketAclResponse{Status: false}
 	}
@@ -340,17 +341,17 @@ func (s *ObjectStorageServiceImpl) GetBucketAcl(vpcId, s3ServiceId, bucketName s
 	return &acl
 }
 
-func (s *ObjectStorageServiceImpl) DeleteSubUser(vpcId, s3ServiceId, subUserId string) error {
+func (s *ObjectStorageServiceImpl) DeleteSubUser(ctx context.Context, vpcId, s3ServiceId, subUserId string) error {
 	apiPath := common.ApiPath.DeleteSubUser(vpcId, s3ServiceId, subUserId)
-	if _, err := s.client.SendDeleteRequest(apiPath); err != nil {
+	if _, err := s.client.SendDeleteRequestWithContext(ctx, apiPath); err != nil {
 		return fmt.Errorf("failed to delete sub-user: %v", err)
 	}
 	return nil
 }
 
-func (s *ObjectStorageServiceImpl) GetBucketLifecycle(vpcId, s3ServiceId, bucketName string, page, pageSize int) BucketLifecycleResponse {
+func (s *ObjectStorageServiceImpl) GetBucketLifecycle(ctx context.Context, vpcId, s3ServiceId, bucketName string, page, pageSize int) BucketLifecycleResponse {
 	apiPath := common.ApiPath.GetBucketLifecycle(vpcId, s3ServiceId, bucketName, page, pageSize)
-	resp, err := s.client.SendGetRequest(apiPath)
+	resp, err := s.client.SendGetRequestWithContext(ctx, apiPath)
 	if err != nil {
 		return BucketLifecycleResponse{Total: 0, Status: false}
 	}
@@ -362,9 +363,9 @@ func (s *ObjectStorageServiceImpl) GetBucketLifecycle(vpcId, s3ServiceId, bucket
 	return bucketLifecycle
 }
 
-func (s *ObjectStorageServiceImpl) PutBucketLifecycle(vpcId, s3ServiceId, bucketName string, lifecycle map[string]interface{}) CommonResponse {
+func (s *ObjectStorageServiceImpl) PutBucketLifecycle(ctx context.Context, vpcId, s3ServiceId, bucketName string, lifecycle map[string]interface{}) CommonResponse {
 	apiPath := common.ApiPath.PutBucketLifecycle(vpcId, s3ServiceId, bucketName)
-	resp, err := s.client.SendPostRequest(apiPath, lifecycle)
+	resp, err := s.client.SendPostRequestWithContext(ctx, apiPath, lifecycle)
 	if err != nil {
 		return CommonResponse{Status: false, Message: err.Error()}
 	}
@@ -376,9 +377,9 @@ func (s *ObjectStorageServiceImpl) PutBucketLifecycle(vpcId, s3ServiceId, bucket
 	return bucketLifecycle
 }
 
-func (s *ObjectStorageServiceImpl) DeleteBucketLifecycle(vpcId, s3ServiceId, bucketName string, lifecycle map[string]interface{}) CommonResponse {
+func (s *ObjectStorageServiceImpl) DeleteBucketLifecycle(ctx context.Context, vpcId, s3ServiceId, bucketName string, lifecycle map[string]interface{}) CommonResponse {
 	apiPath := common.ApiPath.DeleteBucketLifecycle(vpcId, s3ServiceId, bucketName)
-	resp, err := s.client.SendPutRequest(apiPath, lifecycle)
+	resp, err := s.client.SendPutRequestWithContext(ctx, apiPath, lifecycle)
 	if err != nil {
 		return CommonResponse{Status: false, Message: err.Error()}
 	}
@@ -390,9 +391,9 @@ func (s *ObjectStorageServiceImpl) DeleteBucketLifecycle(vpcId, s3ServiceId, buc
 	return bucketLifecycle
 }
 
-func (s *ObjectStorageServiceImpl) CreateSubUserAccessKey(vpcId, s3ServiceId, subUserId string) *SubUserCreateKeyResponse {
+func (s *ObjectStorageServiceImpl) CreateSubUserAccessKey(ctx context.Context, vpcId, s3ServiceId, subUserId string) *SubUserCreateKeyResponse {
 	apiPath := common.ApiPath.CreateSubUserAccessKey(vpcId, s3ServiceId, subUserId)
-	resp, err := s.client.SendPostRequest(apiPath, nil)
+	resp, err := s.client.SendPostRequestWithContext(ctx, apiPath, nil)
 	if err != nil {
 		return &SubUserCreateKeyResponse{Status: false, Message: err.Error()}
 	}
@@ -404,25 +405,63 @@ func (s *ObjectStorageServiceImpl) CreateSubUserAccessKey(vpcId, s3ServiceId, su
 	return &subUserKeys
 }
 
-func (s *ObjectStorageServiceImpl) DeleteSubUserAccessKey(vpcId, s3ServiceId, subUserId, accessKeyId string) CommonResponse {
+func (s *ObjectStorageServiceImpl) DeleteSubUserAccessKey(ctx context.Context, vpcId, s3ServiceId, subUserId, accessKeyId string) CommonResponse {
 	apiPath := common.ApiPath.DeleteSubUserAccessKey(vpcId, s3ServiceId, subUserId)
 	payload := map[string]string{"accessKey": accessKeyId}
-	if _, err := s.client.SendDeleteRequestWithBody(apiPath, payload); err != nil {
+	if _, err := s.client.SendDeleteRequestWithBodyWithContext(ctx, apiPath, payload); err != nil {
 		return CommonResponse{Status: false, Message: err.Error()}
 	}
 	return CommonResponse{Status: true, Message: "Access key deleted successfully"}
 }
 
-func (s *ObjectStorageServiceImpl) DetailSubUser(vpcId, s3ServiceId, subUserId string) *DetailSubUser {
+func (s *ObjectStorageServiceImpl) DetailSubUser(ctx context.Context, vpcId, s3ServiceId, subUserId string) (*DetailSubUser, error) {
 	apiPath := common.ApiPath.DetailSubUser(vpcId, s3ServiceId, subUserId)
-	resp, err := s.client.SendGetRequest(apiPath)
+	resp, err := s.client.SendGetRequestWithContext(ctx, apiPath)
 	if err != nil {
-		return nil
+		return nil, fmt.Errorf("failed to get sub-user detail: %w", common.DecodeError(err))
 	}
 
 	var detail DetailSubUser
 	if err := json.Unmarshal(resp, &detail); err != nil {
-		return nil
+		return nil, fmt.Errorf("failed to unmarshal sub-user detail response: %v", err)
+	}
+	return &detail, nil
+}
+
+// bucketPages fetches the pages of the buckets of an S3 service
+func bucketPages(service ObjectStorageService, vpcId, s3ServiceId string) common.PageFetcher[Bucket] {
+	return func(ctx context.Context, page int, pageSize int) ([]Bucket, int, error) {
+		response, err := service.ListBuckets(ctx, vpcId, s3ServiceId, page, pageSize)
+		return response.Buckets, response.Total, err
+	}
+}
+
+// subUserPages fetches the pages of the sub-users of an S3 service
+func subUserPages(service ObjectStorageService, vpcId, s3ServiceId string) common.PageFetcher[DetailSubUser] {
+	return func(ctx context.Context, page int, pageSize int) ([]DetailSubUser, int, error) {
+		response, err := service.ListSubUsers(ctx, vpcId, s3ServiceId, page, pageSize)
+		return response.SubUsers, response.Total, err
+	}
+}
+
+// bucketCorsRulePages fetches the pages of the CORS rules of a bucket
+func bucketCorsRulePages(service ObjectStorageService, vpcId, s3ServiceId, bucketName string) common.PageFetcher[CorsRule] {
+	return func(ctx context.Context, page int, pageSize int) ([]CorsRule, int, error) {
+		response, err := service.GetBucketCors(ctx, vpcId, s3ServiceId, bucketName, page, pageSize)
+		if err != nil {
+			return nil, 0, err
+		}
+		return response.CorsRules, response.Total, nil
+	}
+}
+
+// bucketLifecycleRulePages fetches the pages of the life cycle rules of a bucket
+func bucketLifecycleRulePages(service ObjectStorageService, vpcId, s3ServiceId, bucketName string) common.PageFetcher[BucketLifecycleRule] {
+	return func(ctx context.Context, page int, pageSize int) ([]BucketLifecycleRule, int, error) {
+		response := service.GetBucketLifecycle(ctx, vpcId, s3ServiceId, bucketName, page, pageSize)
+		if !response.Status {
+			return nil, 0, fmt.Errorf("failed to fetch life cycle rules for bucket %s", bucketName)
+		}
+		return response.Rules, response.Total, nil
 	}
-	return &detail
 }
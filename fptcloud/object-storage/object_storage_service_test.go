@@ -1,6 +1,7 @@
 package fptcloud_object_storage_test
 
 import (
+	"context"
 	"testing"
 
 	common "terraform-provider-fptcloud/commons"
@@ -25,7 +26,7 @@ func TestCreateResourceAccessKeyReturnsResourceAccessKeyIDWhenSuccess(t *testing
 	service := fptcloud_object_storage.NewObjectStorageService(mockClient)
 	vpcId := "vpc_id"
 	s3ServiceId := "s3_service_id"
-	resourceAccessKeyID := service.CreateAccessKey(vpcId, s3ServiceId)
+	resourceAccessKeyID := service.CreateAccessKey(context.Background(), vpcId, s3ServiceId)
 	assert.NotNil(t, resourceAccessKeyID)
 
 	assert.Equal(t, "11111111-aaaa-1111-bbbb-111111111111", resourceAccessKeyID.Credential.AccessKey)
@@ -47,7 +48,7 @@ func TestCreateResourceAccessKeyReturnsErrorWhenFailed(t *testing.T) {
 	service := fptcloud_object_storage.NewObjectStorageService(mockClient)
 	vpcId := "vpc_id"
 	s3ServiceId := "s3_service_id"
-	resourceAccessKeyID := service.CreateAccessKey(vpcId, s3ServiceId)
+	resourceAccessKeyID := service.CreateAccessKey(context.Background(), vpcId, s3ServiceId)
 	assert.NotNil(t, resourceAccessKeyID)
 
 	assert.Equal(t, "", resourceAccessKeyID.Credential.AccessKey)
@@ -69,7 +70,7 @@ func TestDeleteResouurceAccessKeyReturnOkWhenSuccess(t *testing.T) {
 	vpcId := "vpc_id"
 	s3ServiceId := "s3_service_id"
 	credentialId := "credential_id"
-	res := service.DeleteAccessKey(vpcId, s3ServiceId, credentialId)
+	res := service.DeleteAccessKey(context.Background(), vpcId, s3ServiceId, credentialId)
 	assert.NotNil(t, res)
 	assert.Equal(t, true, res.Status)
 	assert.Equal(t, "Access key deleted successfully", res.Message)
@@ -96,7 +97,7 @@ func TestListAccessKeysReturnAccessKeysWhenSuccess(t *testing.T) {
 	service := fptcloud_object_storage.NewObjectStorageService(mockClient)
 	vpcId := "vpc_id"
 	s3ServiceId := "s3_service_id"
-	accessKeys, _ := service.ListAccessKeys(vpcId, s3ServiceId)
+	accessKeys, _ := service.ListAccessKeys(context.Background(), vpcId, s3ServiceId)
 	assert.NotNil(t, accessKeys)
 	assert.Equal(t, "credential_id", accessKeys.Credentials[0].ID)
 	assert.Equal(t, "11111111-aaaa-1111-bbbb-111111111111", accessKeys.Credentials[0].Credentials[0].AccessKey)
@@ -120,7 +121,7 @@ func TestCreateBucketReturnsBucketIDWhenSuccess(t *testing.T) {
 		Acl:        "private",
 		ObjectLock: false,
 	}
-	r := service.CreateBucket(bucketRequest, vpcId, s3ServiceId)
+	r := service.CreateBucket(context.Background(), bucketRequest, vpcId, s3ServiceId)
 	assert.NotNil(t, r)
 	assert.Equal(t, true, r.Status)
 }
@@ -142,7 +143,7 @@ func TestCreateBucketReturnsErrorWhenFailed(t *testing.T) {
 		Acl:        "private",
 		ObjectLock: false,
 	}
-	r := service.CreateBucket(bucketRequest, vpcId, s3ServiceId)
+	r := service.CreateBucket(context.Background(), bucketRequest, vpcId, s3ServiceId)
 	assert.NotNil(t, r)
 	assert.Equal(t, false, r.Status)
 }
@@ -168,7 +169,8 @@ func TestListBucketsReturnsBucketsWhenSuccess(t *testing.T) {
 	service := fptcloud_object_storage.NewObjectStorageService(mockClient)
 	vpcId := "vpc_id"
 	s3ServiceId := "s3_service_id"
-	buckets := service.ListBuckets(vpcId, s3ServiceId, 5, 10)
+	buckets, err := service.ListBuckets(context.Background(), vpcId, s3ServiceId, 5, 10)
+	assert.NoError(t, err)
 	assert.NotNil(t, buckets)
 	assert.Equal(t, "bucket_name", buckets.Buckets[0].Name)
 	assert.Equal(t, "2024-11-26T16:43:55.121000+00:00", buckets.Buckets[0].CreationDate)
@@ -190,7 +192,8 @@ func TestListBucketsReturnsErrorWhenFailed(t *testing.T) {
 	service := fptcloud_object_storage.NewObjectStorageService(mockClient)
 	vpcId := "vpc_id"
 	s3ServiceId := "s3_service_id"
-	buckets := service.ListBuckets(vpcId, s3ServiceId, 5, 10)
+	buckets, err := service.ListBuckets(context.Background(), vpcId, s3ServiceId, 5, 10)
+	assert.NoError(t, err)
 	assert.NotNil(t, buckets)
 	assert.Equal(t, 0, buckets.Total)
 }
@@ -207,7 +210,7 @@ func TestDeleteBucketReturnsOkWhenSuccess(t *testing.T) {
 	vpcId := "vpc_id"
 	s3ServiceId := "s3_service_id"
 	bucketName := "bucket_name"
-	res := service.DeleteBucket(vpcId, s3ServiceId, bucketName)
+	res := service.DeleteBucket(context.Background(), vpcId, s3ServiceId, bucketName)
 	assert.NotNil(t, res)
 	assert.Equal(t, true, res.Status)
 }
@@ -228,7 +231,7 @@ func TestCreateSubUserReturnsTrueWhenSuccess(t *testing.T) {
 		Role:   "admin",
 		UserId: "user_id",
 	}
-	r := service.CreateSubUser(subUserRequest, vpcId, s3ServiceId)
+	r := service.CreateSubUser(context.Background(), subUserRequest, vpcId, s3ServiceId)
 	assert.NotNil(t, r)
 	assert.Equal(t, true, r.Status)
 	assert.Equal(t, "Sub-user created successfully", r.Message)
@@ -249,7 +252,7 @@ func TestCreateSubUserReturnsFalseWhenFailed(t *testing.T) {
 		Role:   "admin",
 		UserId: "user_id",
 	}
-	r := service.CreateSubUser(subUserRequest, vpcId, s3ServiceId)
+	r := service.CreateSubUser(context.Background(), subUserRequest, vpcId, s3ServiceId)
 	assert.NotNil(t, r)
 	assert.Equal(t, false, r.Status)
 }
@@ -264,7 +267,7 @@ func TestDeleteSubUserReturnOkWhenSuccess(t *testing.T) {
 	vpcId := "vpc_id"
 	s3ServiceId := "s3_service_id"
 	subUserId := "sub_user_id"
-	err := service.DeleteSubUser(vpcId, s3ServiceId, subUserId)
+	err := service.DeleteSubUser(context.Background(), vpcId, s3ServiceId, subUserId)
 	assert.Nil(t, err)
 }
 
@@ -287,7 +290,7 @@ func TestListSubUsersReturnsSubUsersWhenSuccess(t *testing.T) {
 	service := fptcloud_object_storage.NewObjectStorageService(mockClient)
 	vpcId := "vpc_id"
 	s3ServiceId := "s3_service_id"
-	subUsers, err := service.ListSubUsers(vpcId, s3ServiceId, 5, 25)
+	subUsers, err := service.ListSubUsers(context.Background(), vpcId, s3ServiceId, 5, 25)
 	assert.NotNil(t, subUsers)
 	assert.Nil(t, err)
 	assert.Equal(t, 1, subUsers.Total)
@@ -309,7 +312,7 @@ func TestListSubUsersReturnsErrorWhenFailed(t *testing.T) {
 	service := fptcloud_object_storage.NewObjectStorageService(mockClient)
 	vpcId := "vpc_id"
 	s3ServiceId := "s3_service_id"
-	subUsers, err := service.ListSubUsers(vpcId, s3ServiceId, 5, 25)
+	subUsers, err := service.ListSubUsers(context.Background(), vpcId, s3ServiceId, 5, 25)
 	assert.NotNil(t, subUsers)
 	assert.NotNil(t, err)
 	assert.Equal(t, 0, subUsers.Total)
@@ -332,7 +335,8 @@ func TestGetDetailSubUserReturnOkWhenSuccess(t *testing.T) {
 	vpcId := "vpc_id"
 	s3ServiceId := "s3_service_id"
 	subUserId := "sub_user_id"
-	subUser := service.DetailSubUser(vpcId, s3ServiceId, subUserId)
+	subUser, err := service.DetailSubUser(context.Background(), vpcId, s3ServiceId, subUserId)
+	assert.NoError(t, err)
 	assert.NotNil(t, subUser)
 	assert.Equal(t, "sgn-replicate123123", subUser.UserID)
 	assert.Equal(t, true, subUser.Active)
@@ -348,7 +352,8 @@ func TestGetDetailSubUserReturnNilWhenError(t *testing.T) {
 	vpcId := "vpc_id"
 	s3ServiceId := "s3_service_id"
 	subUserId := "nonexistent_user"
-	subUser := service.DetailSubUser(vpcId, s3ServiceId, subUserId)
+	subUser, err := service.DetailSubUser(context.Background(), vpcId, s3ServiceId, subUserId)
+	assert.Error(t, err)
 	assert.Nil(t, subUser, "DetailSubUser should return nil when user is not found")
 }
 
@@ -368,7 +373,7 @@ func TestCreateSubUserAccessKeyReturnsAccessKeyWhenSuccess(t *testing.T) {
 	vpcId := "vpc_id"
 	s3ServiceId := "s3_service_id"
 	subUserId := "sub_user_id"
-	accessKey := service.CreateSubUserAccessKey(vpcId, s3ServiceId, subUserId)
+	accessKey := service.CreateSubUserAccessKey(context.Background(), vpcId, s3ServiceId, subUserId)
 	assert.NotNil(t, accessKey)
 	assert.Equal(t, "example_access_key", accessKey.Credential.AccessKey)
 	assert.Equal(t, "example_secret_key", accessKey.Credential.SecretKey)
@@ -387,7 +392,7 @@ func TestCreateSubUserAccessKeyReturnsErrorWhenFailed(t *testing.T) {
 	vpcId := "vpc_id"
 	s3ServiceId := "s3_service_id"
 	subUserId := "sub_user_id"
-	accessKey := service.CreateSubUserAccessKey(vpcId, s3ServiceId, subUserId)
+	accessKey := service.CreateSubUserAccessKey(context.Background(), vpcId, s3ServiceId, subUserId)
 	assert.NotNil(t, accessKey)
 	assert.Equal(t, "", accessKey.Credential.AccessKey)
 	assert.Equal(t, "", accessKey.Credential.SecretKey)
@@ -407,7 +412,7 @@ func TestDeleteSubUserAccessKeyReturnOkWhenSuccess(t *testing.T) {
 	s3ServiceId := "s3_service_id"
 	subUserId := "sub_user_id"
 	accessKeyId := "access_key_id"
-	res := service.DeleteSubUserAccessKey(vpcId, s3ServiceId, subUserId, accessKeyId)
+	res := service.DeleteSubUserAccessKey(context.Background(), vpcId, s3ServiceId, subUserId, accessKeyId)
 	assert.NotNil(t, res)
 	assert.Equal(t, true, res.Status)
 }
@@ -426,7 +431,7 @@ func TestPutBucketPolicyReturnOkWhenSuccess(t *testing.T) {
 	bucketName := "bucket_name"
 	policy := map[string]interface {
 	}{"Version": "2012-10-17"}
-	res := service.PutBucketPolicy(vpcId, s3ServiceId, bucketName, policy)
+	res := service.PutBucketPolicy(context.Background(), vpcId, s3ServiceId, bucketName, policy)
 	assert.NotNil(t, res)
 	assert.Equal(t, true, res.Status)
 }
@@ -444,7 +449,7 @@ func TestGetBucketPolicyReturnsPolicyWhenSuccess(t *testing.T) {
 	vpcId := "vpc_id"
 	s3ServiceId := "s3_service_id"
 	bucketName := "bucket_name"
-	policy := service.GetBucketPolicy(vpcId, s3ServiceId, bucketName)
+	policy := service.GetBucketPolicy(context.Background(), vpcId, s3ServiceId, bucketName)
 	assert.NotNil(t, policy)
 	assert.Equal(t, "{\"Version\":\"2012-10-17\",\"Statement\":[{\"Sid\":\"AllowAllS3Actions\",\"Effect\":\"Allow\",\"Principal\":\"*\",\"Action\":\"s3:*\",\"Resource\":\"arn:aws:s3:::bucket_name/*\"}]}", policy.Policy)
 	assert.Equal(t, true, policy.Status)
@@ -463,7 +468,7 @@ func TestGetBucketPolicyReturnsFalseWhenFailed(t *testing.T) {
 	vpcId := "vpc_id"
 	s3ServiceId := "s3_service_id"
 	bucketName := "bucket_name"
-	policy := service.GetBucketPolicy(vpcId, s3ServiceId, bucketName)
+	policy := service.GetBucketPolicy(context.Background(), vpcId, s3ServiceId, bucketName)
 	assert.NotNil(t, policy)
 	assert.Equal(t, false, policy.Status)
 }
@@ -481,7 +486,7 @@ func TestCreateBucketCorsReturnOkWhenSuccess(t *testing.T) {
 	cors := map[string]interface{}{
 		"AllowedHeaders": []string{"*"},
 	}
-	res := service.CreateBucketCors("vpc_id", "s3_service_id", bucketName, cors)
+	res := service.CreateBucketCors(context.Background(), "vpc_id", "s3_service_id", bucketName, cors)
 	assert.NotNil(t, res)
 	assert.Equal(t, true, res.Status)
 }
@@ -500,7 +505,7 @@ func TestUpdateBucketCorsReturnOkWhenSuccess(t *testing.T) {
 		"AllowedHeaders": []string{"*"},
 	}
 	arrCors := append([]map[string]interface{}{}, cors)
-	res := service.UpdateBucketCors("vpc_id", "s3_service_id", bucketName, arrCors)
+	res := service.UpdateBucketCors(context.Background(), "vpc_id", "s3_service_id", bucketName, arrCors)
 	assert.NotNil(t, res)
 	assert.Equal(t, true, res.Status)
 }
@@ -523,7 +528,7 @@ func TestGetBucketCorsReturnCorsWhenSuccess(t *testing.T) {
 	defer server.Close()
 	service := fptcloud_object_storage.NewObjectStorageService(mockClient)
 	bucketName := "bucket_name"
-	cors, err := service.GetBucketCors("vpc_id", "s3_service_id", bucketName, 5, 25)
+	cors, err := service.GetBucketCors(context.Background(), "vpc_id", "s3_service_id", bucketName, 5, 25)
 	assert.NotNil(t, cors)
 	assert.Nil(t, err)
 	assert.Equal(t, true, cors.Status)
@@ -542,7 +547,7 @@ func TestGetBucketCorsReturnFalseWhenFailed(t *testing.T) {
 	defer server.Close()
 	service := fptcloud_object_storage.NewObjectStorageService(mockClient)
 	bucketName := "bucket_name"
-	cors, err := service.GetBucketCors("vpc_id", "s3_service_id", bucketName, 5, 25)
+	cors, err := service.GetBucketCors(context.Background(), "vpc_id", "s3_service_id", bucketName, 5, 25)
 	assert.Nil(t, cors)
 	assert.NotNil(t, err)
 }
@@ -560,7 +565,7 @@ func TestPutBucketVersioningReturnNilWhenSuccess(t *testing.T) {
 	versioning := fptcloud_object_storage.BucketVersioningRequest{
 		Status: "Enabled",
 	}
-	res := service.PutBucketVersioning("vpc_id", "s3_service_id", bucketName, versioning)
+	res := service.PutBucketVersioning(context.Background(), "vpc_id", "s3_service_id", bucketName, versioning)
 	assert.Nil(t, res)
 }
 
@@ -575,7 +580,7 @@ func TestGetBucketVersioningReturnBucketVersioning(t *testing.T) {
 	defer server.Close()
 	service := fptcloud_object_storage.NewObjectStorageService(mockClient)
 	bucketName := "bucket_name"
-	versioning := service.GetBucketVersioning("vpc_id", "s3_service_id", bucketName)
+	versioning := service.GetBucketVersioning(context.Background(), "vpc_id", "s3_service_id", bucketName)
 	assert.NotNil(t, versioning)
 	assert.Equal(t, true, versioning.Status)
 }
@@ -595,7 +600,7 @@ func TestPutBucketAclReturnAclWhenSuccess(t *testing.T) {
 		CannedAcl:    "private",
 		ApplyObjects: true,
 	}
-	res := service.PutBucketAcl("vpc_id", "s3_service_id", bucketName, acl)
+	res := service.PutBucketAcl(context.Background(), "vpc_id", "s3_service_id", bucketName, acl)
 	assert.NotNil(t, res)
 	assert.Equal(t, true, res.Status)
 	assert.Equal(t, "task_id", res.TaskID)
@@ -626,7 +631,7 @@ func TestGetBucketAclReturnAclWhenSuccess(t *testing.T) {
 	defer server.Close()
 	service := fptcloud_object_storage.NewObjectStorageService(mockClient)
 	bucketName := "bucket_name"
-	acl := service.GetBucketAcl("vpc_id", "s3_service_id", bucketName)
+	acl := service.GetBucketAcl(context.Background(), "vpc_id", "s3_service_id", bucketName)
 	assert.NotNil(t, acl)
 	assert.Equal(t, true, acl.Status)
 	assert.Equal(t, "example_user_id", acl.Owner.DisplayName)
@@ -647,7 +652,7 @@ func TestGetBucketAclReturnFalseWhenFailed(t *testing.T) {
 	defer server.Close()
 	service := fptcloud_object_storage.NewObjectStorageService(mockClient)
 	bucketName := "bucket_name"
-	acl := service.GetBucketAcl("vpc_id", "s3_service_id", bucketName)
+	acl := service.GetBucketAcl(context.Background(), "vpc_id", "s3_service_id", bucketName)
 	assert.NotNil(t, acl)
 	assert.Equal(t, false, acl.Status)
 }
@@ -662,7 +667,7 @@ func TestGetBucketAclReturnFalseWhenFailedUnmarshalJson(t *testing.T) {
 	defer server.Close()
 	service := fptcloud_object_storage.NewObjectStorageService(mockClient)
 	bucketName := "bucket_name"
-	acl := service.GetBucketAcl("vpc_id", "s3_service_id", bucketName)
+	acl := service.GetBucketAcl(context.Background(), "vpc_id", "s3_service_id", bucketName)
 	assert.NotNil(t, acl)
 	assert.Equal(t, false, acl.Status)
 }
@@ -682,7 +687,7 @@ func TestPutBucketWebsiteReturnOkWhenSuccess(t *testing.T) {
 		Suffix: "index2.html",
 		Bucket: "bucket_name",
 	}
-	res := service.PutBucketWebsite("vpc_id", "s3_service_id", bucketName, website)
+	res := service.PutBucketWebsite(context.Background(), "vpc_id", "s3_service_id", bucketName, website)
 	assert.NotNil(t, res)
 	assert.Equal(t, true, res.Status)
 }
@@ -702,7 +707,7 @@ func TestPutBucketWebsiteReturnOFalseWhenFailed(t *testing.T) {
 		Suffix: "index2.html",
 		Bucket: "bucket_name",
 	}
-	res := service.PutBucketWebsite("vpc_id", "s3_service_id", bucketName, website)
+	res := service.PutBucketWebsite(context.Background(), "vpc_id", "s3_service_id", bucketName, website)
 	assert.NotNil(t, res)
 	assert.Equal(t, true, res.Status)
 }
@@ -717,7 +722,7 @@ func TestDeleteBucketStaticWebsiteReturnTrueWhenSuccess(t *testing.T) {
 	defer server.Close()
 	service := fptcloud_object_storage.NewObjectStorageService(mockClient)
 	bucketName := "bucket_name"
-	res := service.DeleteBucketStaticWebsite("vpc_id", "s3_service_id", bucketName)
+	res := service.DeleteBucketStaticWebsite(context.Background(), "vpc_id", "s3_service_id", bucketName)
 	assert.NotNil(t, res)
 	assert.Equal(t, true, res.Status)
 }
@@ -732,7 +737,7 @@ func TestDeleteBucketStaticWebsiteReturnFalseWhenError(t *testing.T) {
 	defer server.Close()
 	service := fptcloud_object_storage.NewObjectStorageService(mockClient)
 	bucketName := "bucket_name"
-	res := service.DeleteBucketStaticWebsite("vpc_id", "s3_service_id", bucketName)
+	res := service.DeleteBucketStaticWebsite(context.Background(), "vpc_id", "s3_service_id", bucketName)
 	assert.NotNil(t, res)
 	assert.Equal(t, true, res.Status)
 }
@@ -769,7 +774,7 @@ func TestGetBucketWebsiteReturnWebsiteWhenSuccess(t *testing.T) {
 	defer server.Close()
 	service := fptcloud_object_storage.NewObjectStorageService(mockClient)
 	bucketName := "bucket_name"
-	website := service.GetBucketWebsite("vpc_id", "s3_service_id", bucketName)
+	website := service.GetBucketWebsite(context.Background(), "vpc_id", "s3_service_id", bucketName)
 	assert.NotNil(t, website)
 	assert.Equal(t, true, website.Status)
 	assert.Equal(t, "some_index.html", website.Config.IndexDocument.Suffix)
@@ -786,7 +791,7 @@ func TestGetBucketWebsiteReturnFalseWhenError(t *testing.T) {
 	defer server.Close()
 	service := fptcloud_object_storage.NewObjectStorageService(mockClient)
 	bucketName := "bucket_name"
-	website := service.GetBucketWebsite("vpc_id", "s3_service_id", bucketName)
+	website := service.GetBucketWebsite(context.Background(), "vpc_id", "s3_service_id", bucketName)
 	assert.NotNil(t, website)
 	assert.Equal(t, false, website.Status)
 }
@@ -812,7 +817,7 @@ func TestGetBucketLifecycleReturnRuleWhenSuccess(t *testing.T) {
 	defer server.Close()
 	service := fptcloud_object_storage.NewObjectStorageService(mockClient)
 	bucketName := "bucket_name"
-	lifecycle := service.GetBucketLifecycle("vpc_id", "s3_service_id", bucketName, 5, 25)
+	lifecycle := service.GetBucketLifecycle(context.Background(), "vpc_id", "s3_service_id", bucketName, 5, 25)
 	assert.NotNil(t, lifecycle)
 	assert.Equal(t, true, lifecycle.Status)
 	assert.Equal(t, "rule_id", lifecycle.Rules[0].ID)
@@ -834,7 +839,7 @@ func TestGetBucketLifecycleReturnFalseWhenFailed(t *testing.T) {
 	defer server.Close()
 	service := fptcloud_object_storage.NewObjectStorageService(mockClient)
 	bucketName := "bucket_name"
-	lifecycle := service.GetBucketLifecycle("vpc_id", "s3_service_id", bucketName, 5, 25)
+	lifecycle := service.GetBucketLifecycle(context.Background(), "vpc_id", "s3_service_id", bucketName, 5, 25)
 	assert.NotNil(t, lifecycle)
 	assert.Equal(t, false, lifecycle.Status)
 	assert.Equal(t, 0, lifecycle.Total)
@@ -858,7 +863,7 @@ func TestPutBucketLifecycleReturnOkWhenSuccess(t *testing.T) {
 			"Days": 8,
 		},
 	}
-	res := service.PutBucketLifecycle("vpc_id", "s3_service_id", bucketName, rule)
+	res := service.PutBucketLifecycle(context.Background(), "vpc_id", "s3_service_id", bucketName, rule)
 	assert.NotNil(t, res)
 	assert.Equal(t, true, res.Status)
 }
@@ -881,7 +886,7 @@ func TestPutBucketLifecycleReturnFalseWhenError(t *testing.T) {
 		"Prefix": "filer",
 		"Status": "Enabled",
 	}
-	res := service.PutBucketLifecycle("vpc_id", "s3_service_id", bucketName, rule)
+	res := service.PutBucketLifecycle(context.Background(), "vpc_id", "s3_service_id", bucketName, rule)
 	assert.NotNil(t, res)
 	assert.Equal(t, false, res.Status)
 }
@@ -904,7 +909,7 @@ func TestPutBucketLifecycleReturnFalseWhenErrorUnmarshalJson(t *testing.T) {
 		},
 		"Prefix": "prefix",
 	}
-	res := service.PutBucketLifecycle("vpc_id", "s3_service_id", bucketName, rule)
+	res := service.PutBucketLifecycle(context.Background(), "vpc_id", "s3_service_id", bucketName, rule)
 	assert.NotNil(t, res)
 	assert.Equal(t, false, res.Status)
 }
@@ -927,7 +932,7 @@ func TestDeleteBucketLifecycleReturnOkWhenSuccess(t *testing.T) {
 		"Prefix": "prefix",
 		"Status": "Enabled",
 	}
-	res := service.DeleteBucketLifecycle("vpc_id", "s3_service_id", bucketName, rule)
+	res := service.DeleteBucketLifecycle(context.Background(), "vpc_id", "s3_service_id", bucketName, rule)
 	assert.NotNil(t, res)
 	assert.Equal(t, true, res.Status)
 }
@@ -952,7 +957,7 @@ func TestDeleteBucketLifecycleReturnFalseWhenError(t *testing.T) {
 			"Days": 12,
 		},
 	}
-	res := service.DeleteBucketLifecycle("vpc_id", "s3_service_id", bucketName, rule)
+	res := service.DeleteBucketLifecycle(context.Background(), "vpc_id", "s3_service_id", bucketName, rule)
 	assert.NotNil(t, res)
 	assert.Equal(t, false, res.Status)
 }
@@ -975,7 +980,7 @@ func TestDeleteBucketLifecycleReturnFalseWhenErrorUnmarshalJson(t *testing.T) {
 		},
 		"Status": "Disabled",
 	}
-	res := service.DeleteBucketLifecycle("vpc_id", "s3_service_id", bucketName, rule)
+	res := service.DeleteBucketLifecycle(context.Background(), "vpc_id", "s3_service_id", bucketName, rule)
 	assert.NotNil(t, res)
 	assert.Equal(t, false, res.Status)
 }
@@ -1003,7 +1008,7 @@ func TestCheckServiceEnableReturnServicesWhenSuccess(t *testing.T) {
 	defer server.Close()
 	service := fptcloud_object_storage.NewObjectStorageService(mockClient)
 	vpcId := "vpc_id"
-	services := service.CheckServiceEnable(vpcId)
+	services := service.CheckServiceEnable(context.Background(), vpcId)
 	assert.NotNil(t, services)
 	assert.Equal(t, 1, services.Total)
 	assert.Equal(t, "HN-02", services.Data[0].S3ServiceName)
@@ -1022,7 +1027,7 @@ func TestCheckServiceEnableReturnFalseWhenError(t *testing.T) {
 	defer server.Close()
 	service := fptcloud_object_storage.NewObjectStorageService(mockClient)
 	vpcId := "vpc_id"
-	services := service.CheckServiceEnable(vpcId)
+	services := service.CheckServiceEnable(context.Background(), vpcId)
 	assert.NotNil(t, services)
 	assert.Equal(t, 0, services.Total)
 }
@@ -1038,7 +1043,7 @@ func TestCheckServiceEnableReturnFalseWhenErrorUnmarshal(t *testing.T) {
 	defer server.Close()
 	service := fptcloud_object_storage.NewObjectStorageService(mockClient)
 	vpcId := "vpc_id"
-	services := service.CheckServiceEnable(vpcId)
+	services := service.CheckServiceEnable(context.Background(), vpcId)
 	assert.NotNil(t, services)
 	assert.Equal(t, 0, services.Total)
 }
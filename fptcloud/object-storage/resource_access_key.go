@@ -3,6 +3,7 @@ package fptcloud_object_storage
 import (
 	"context"
 	"fmt"
+	"log"
 	common "terraform-provider-fptcloud/commons"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -66,12 +67,12 @@ func resourceAccessKeyCreate(ctx context.Context, d *schema.ResourceData, m inte
 	service := NewObjectStorageService(client)
 	vpcId := d.Get("vpc_id").(string)
 	regionName := d.Get("region_name").(string)
-	s3ServiceDetail := getServiceEnableRegion(service, vpcId, regionName)
+	s3ServiceDetail := getServiceEnableRegion(ctx, service, vpcId, regionName)
 	if s3ServiceDetail.S3ServiceId == "" {
 		return diag.FromErr(fmt.Errorf(regionError, regionName))
 	}
 
-	resp := service.CreateAccessKey(vpcId, s3ServiceDetail.S3ServiceId)
+	resp := service.CreateAccessKey(ctx, vpcId, s3ServiceDetail.S3ServiceId)
 
 	if !resp.Status {
 		return diag.Errorf("failed to create access key: %s", resp.Message)
@@ -108,13 +109,16 @@ func resourceAccessKeyRead(ctx context.Context, d *schema.ResourceData, m interf
 	service := NewObjectStorageService(client)
 	vpcId := d.Get("vpc_id").(string)
 	regionName := d.Get("region_name").(string)
-	s3ServiceId := getServiceEnableRegion(service, vpcId, regionName).S3ServiceId
-	resp, err := service.ListAccessKeys(vpcId, s3ServiceId)
+	s3ServiceId := getServiceEnableRegion(ctx, service, vpcId, regionName).S3ServiceId
+	resp, err := service.ListAccessKeys(ctx, vpcId, s3ServiceId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	secretAccessKey := d.Get("secret_access_key").(string)
 	accessKeyId := d.Get("access_key_id").(string)
+	if accessKeyId == "" {
+		accessKeyId = d.Id()
+	}
 	for _, accessKey := range resp.Credentials {
 		for _, key := range accessKey.Credentials {
 			if key.AccessKey == accessKeyId {
@@ -126,10 +130,13 @@ func resourceAccessKeyRead(ctx context.Context, d *schema.ResourceData, m interf
 					d.SetId("")
 					return diag.FromErr(err)
 				}
-				break
+				return nil
 			}
 		}
 	}
+
+	log.Printf("[WARN] Access key %s not found, removing it from state", accessKeyId)
+	d.SetId("")
 	return nil
 }
 
@@ -144,7 +151,7 @@ func resourceAccessKeyDelete(ctx context.Context, d *schema.ResourceData, m inte
 		accessKeyId = d.Get("access_key_id").(string)
 	}
 
-	s3ServiceDetail := getServiceEnableRegion(service, vpcId, regionName)
+	s3ServiceDetail := getServiceEnableRegion(ctx, service, vpcId, regionName)
 	if s3ServiceDetail.S3ServiceId == "" {
 		return diag.FromErr(fmt.Errorf(regionError, regionName))
 	}
@@ -153,7 +160,7 @@ func resourceAccessKeyDelete(ctx context.Context, d *schema.ResourceData, m inte
 		return diag.Errorf("access_key_id is required for deletion")
 	}
 
-	data := service.DeleteAccessKey(vpcId, s3ServiceDetail.S3ServiceId, accessKeyId)
+	data := service.DeleteAccessKey(ctx, vpcId, s3ServiceDetail.S3ServiceId, accessKeyId)
 	if !data.Status {
 		return diag.Errorf("failed to delete access key %s: %s", accessKeyId, data.Message)
 	}
@@ -3,6 +3,7 @@ package fptcloud_object_storage
 import (
 	"context"
 	"fmt"
+	"log"
 	common "terraform-provider-fptcloud/commons"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -64,15 +65,14 @@ func ResourceBucket() *schema.Resource {
 			"status": {
 				Type:        schema.TypeBool,
 				Computed:    true,
-				ForceNew:    true,
 				Description: "The status after create or delete the bucket",
 			},
 		},
 	}
 }
 
-func getServiceEnableRegion(objectStorageService ObjectStorageService, vpcId, regionName string) S3ServiceDetail {
-	serviceEnable := objectStorageService.CheckServiceEnable(vpcId)
+func getServiceEnableRegion(ctx context.Context, objectStorageService ObjectStorageService, vpcId, regionName string) S3ServiceDetail {
+	serviceEnable := objectStorageService.CheckServiceEnable(ctx, vpcId)
 	if serviceEnable.Total == 0 {
 		return S3ServiceDetail{}
 	}
@@ -104,12 +104,12 @@ func resourceBucketCreate(ctx context.Context, d *schema.ResourceData, m interfa
 		Acl:        d.Get("acl").(string),
 		ObjectLock: true,
 	}
-	s3ServiceDetail := getServiceEnableRegion(objectStorageService, vpcId, d.Get("region_name").(string))
+	s3ServiceDetail := getServiceEnableRegion(ctx, objectStorageService, vpcId, d.Get("region_name").(string))
 	if s3ServiceDetail.S3ServiceId == "" {
 		return diag.FromErr(fmt.Errorf(regionError, d.Get("region_name").(string)))
 	}
 
-	bucket := objectStorageService.CreateBucket(req, vpcId, s3ServiceDetail.S3ServiceId)
+	bucket := objectStorageService.CreateBucket(ctx, req, vpcId, s3ServiceDetail.S3ServiceId)
 	if !bucket.Status {
 		return diag.Errorf("failed to create bucket: %s", bucket.Message)
 	}
@@ -134,12 +134,12 @@ func resourceBucketDelete(ctx context.Context, d *schema.ResourceData, m interfa
 		return diag.Errorf("cannot delete bucket: no valid ID found, bucket may not have been created")
 	}
 
-	s3ServiceDetail := getServiceEnableRegion(objectStorageService, vpcId, d.Get("region_name").(string))
+	s3ServiceDetail := getServiceEnableRegion(ctx, objectStorageService, vpcId, d.Get("region_name").(string))
 	if s3ServiceDetail.S3ServiceId == "" {
 		return diag.FromErr(fmt.Errorf(regionError, d.Get("region_name").(string)))
 	}
 
-	status := objectStorageService.DeleteBucket(vpcId, s3ServiceDetail.S3ServiceId, bucketName)
+	status := objectStorageService.DeleteBucket(ctx, vpcId, s3ServiceDetail.S3ServiceId, bucketName)
 	if !status.Status {
 		return diag.Errorf("failed to delete bucket %s: %s", bucketName, status.Message)
 	}
@@ -159,28 +159,20 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, m interface
 	vpcId := d.Get("vpc_id").(string)
 	regionName := d.Get("region_name").(string)
 
-	s3ServiceDetail := getServiceEnableRegion(objectStorageService, vpcId, regionName)
+	s3ServiceDetail := getServiceEnableRegion(ctx, objectStorageService, vpcId, regionName)
 	if s3ServiceDetail.S3ServiceId == "" {
 		return diag.FromErr(fmt.Errorf(regionError, regionName))
 	}
 
 	// List buckets to find the specific bucket
-	buckets := objectStorageService.ListBuckets(vpcId, s3ServiceDetail.S3ServiceId, 1, 1000)
-	if buckets.Total == 0 {
-		d.SetId("")
-		return diag.Errorf("no buckets found")
+	buckets, err := common.ListAll(ctx, common.DefaultPageSize, bucketPages(objectStorageService, vpcId, s3ServiceDetail.S3ServiceId))
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	// Find the specific bucket
-	var foundBucket *struct {
-		Name             string `json:"Name"`
-		CreationDate     string `json:"CreationDate"`
-		IsEmpty          bool   `json:"isEmpty"`
-		S3ServiceID      string `json:"s3_service_id"`
-		IsEnabledLogging bool   `json:"isEnabledLogging"`
-		Endpoint         string `json:"endpoint"`
-	}
-	for _, bucket := range buckets.Buckets {
+	var foundBucket *Bucket
+	for _, bucket := range buckets {
 		if bucket.Name == bucketName {
 			foundBucket = &bucket
 			break
@@ -188,8 +180,9 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, m interface
 	}
 
 	if foundBucket == nil {
+		log.Printf("[WARN] Bucket %s not found, removing it from state", bucketName)
 		d.SetId("")
-		return diag.Errorf("bucket %s not found", bucketName)
+		return nil
 	}
 
 	// Set the basic attributes
@@ -204,6 +197,7 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, m interface
 	}
 	// Note: ListBuckets API doesn't return acl, versioning, object_lock details
 	// These would need to be retrieved from state or other APIs
+	// The status of an existing bucket is true, whatever an earlier create or delete left in state
 	if err := d.Set("status", true); err != nil {
 		return diag.FromErr(err)
 	}
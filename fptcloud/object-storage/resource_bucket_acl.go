@@ -66,7 +66,7 @@ func resourceBucketAclCreate(ctx context.Context, d *schema.ResourceData, m inte
 	regionName := d.Get("region_name").(string)
 	cannedAcl := d.Get("canned_acl").(string)
 	applyObjects := d.Get("apply_objects").(bool)
-	s3ServiceDetail := getServiceEnableRegion(service, vpcId, regionName)
+	s3ServiceDetail := getServiceEnableRegion(ctx, service, vpcId, regionName)
 	if s3ServiceDetail.S3ServiceId == "" {
 		return diag.FromErr(fmt.Errorf(regionError, regionName))
 	}
@@ -74,7 +74,7 @@ func resourceBucketAclCreate(ctx context.Context, d *schema.ResourceData, m inte
 	bucketAclRequest.CannedAcl = cannedAcl
 	bucketAclRequest.ApplyObjects = applyObjects
 
-	r := service.PutBucketAcl(vpcId, s3ServiceDetail.S3ServiceId, bucketName, bucketAclRequest)
+	r := service.PutBucketAcl(ctx, vpcId, s3ServiceDetail.S3ServiceId, bucketName, bucketAclRequest)
 	if !r.Status {
 		if err := d.Set("status", false); err != nil {
 			return diag.Errorf("failed to create bucket ACL for bucket %s", bucketName)
@@ -102,11 +102,11 @@ func resourceBucketAclRead(ctx context.Context, d *schema.ResourceData, m interf
 	vpcId := d.Get("vpc_id").(string)
 	bucketName := d.Get("bucket_name").(string)
 	regionName := d.Get("region_name").(string)
-	s3ServiceDetail := getServiceEnableRegion(service, vpcId, regionName)
+	s3ServiceDetail := getServiceEnableRegion(ctx, service, vpcId, regionName)
 	if s3ServiceDetail.S3ServiceId == "" {
 		return diag.FromErr(fmt.Errorf(regionError, regionName))
 	}
-	r := service.GetBucketAcl(vpcId, s3ServiceDetail.S3ServiceId, bucketName)
+	r := service.GetBucketAcl(ctx, vpcId, s3ServiceDetail.S3ServiceId, bucketName)
 	if !r.Status {
 		return diag.Errorf("failed to get bucket ACL for bucket %s", bucketName)
 	}
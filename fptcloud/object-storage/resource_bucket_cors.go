@@ -78,7 +78,7 @@ func resourceBucketCorsCreate(ctx context.Context, d *schema.ResourceData, m int
 	vpcId := d.Get("vpc_id").(string)
 	bucketName := d.Get("bucket_name").(string)
 	regionName := d.Get("region_name").(string)
-	s3ServiceDetail := getServiceEnableRegion(service, vpcId, regionName)
+	s3ServiceDetail := getServiceEnableRegion(ctx, service, vpcId, regionName)
 	if s3ServiceDetail.S3ServiceId == "" {
 		return diag.FromErr(fmt.Errorf(regionError, regionName))
 	}
@@ -110,7 +110,7 @@ func resourceBucketCorsCreate(ctx context.Context, d *schema.ResourceData, m int
 	if len(jsonMap.ExposeHeaders) > 0 {
 		payload["ExposeHeaders"] = jsonMap.ExposeHeaders
 	}
-	r := service.CreateBucketCors(vpcId, s3ServiceDetail.S3ServiceId, bucketName, payload)
+	r := service.CreateBucketCors(ctx, vpcId, s3ServiceDetail.S3ServiceId, bucketName, payload)
 	if !r.Status {
 		if err := d.Set("status", false); err != nil {
 			return diag.FromErr(err)
@@ -123,32 +123,29 @@ func resourceBucketCorsCreate(ctx context.Context, d *schema.ResourceData, m int
 	}
 	return nil
 }
-func resourceBucketCorsRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceBucketCorsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*common.Client)
 	service := NewObjectStorageService(client)
 	bucketName := d.Get("bucket_name").(string)
 	vpcId := d.Get("vpc_id").(string)
 	regionName := d.Get("region_name").(string)
-	s3ServiceDetail := getServiceEnableRegion(service, vpcId, regionName)
+	s3ServiceDetail := getServiceEnableRegion(ctx, service, vpcId, regionName)
 	if s3ServiceDetail.S3ServiceId == "" {
 		return diag.FromErr(fmt.Errorf(regionError, regionName))
 	}
-	page := 1
-	pageSize := 999999
-
-	bucketCorsDetails, _ := service.GetBucketCors(vpcId, s3ServiceDetail.S3ServiceId, bucketName, page, pageSize)
-	if !bucketCorsDetails.Status {
-		return diag.FromErr(fmt.Errorf("failed to fetch life cycle rules for bucket %s", bucketName))
+	corsRules, err := common.ListAll(ctx, common.DefaultPageSize, bucketCorsRulePages(service, vpcId, s3ServiceDetail.S3ServiceId, bucketName))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to fetch cors rules for bucket %s: %w", bucketName, err))
 	}
 	d.SetId(bucketName)
 	var formattedData []interface{}
-	if bucketCorsDetails.Total == 0 {
+	if len(corsRules) == 0 {
 		if err := d.Set("bucket_cors_rules", make([]interface{}, 0)); err != nil {
 			d.SetId("")
 			return diag.FromErr(err)
 		}
 	}
-	for _, corsRuleDetail := range bucketCorsDetails.CorsRules {
+	for _, corsRuleDetail := range corsRules {
 		data := map[string]interface{}{
 			"id": corsRuleDetail.ID,
 		}
@@ -167,7 +164,7 @@ func resourceBucketCorsDelete(ctx context.Context, d *schema.ResourceData, m int
 	bucketName := d.Get("bucket_name").(string)
 	vpcId := d.Get("vpc_id").(string)
 	regionName := d.Get("region_name").(string)
-	s3ServiceDetail := getServiceEnableRegion(service, vpcId, regionName)
+	s3ServiceDetail := getServiceEnableRegion(ctx, service, vpcId, regionName)
 	if s3ServiceDetail.S3ServiceId == "" {
 		return diag.FromErr(fmt.Errorf(regionError, regionName))
 	}
@@ -207,7 +204,7 @@ func resourceBucketCorsDelete(ctx context.Context, d *schema.ResourceData, m int
 		}
 		payload = append(payload, rulePayload)
 	}
-	r := service.UpdateBucketCors(vpcId, s3ServiceDetail.S3ServiceId, bucketName, payload)
+	r := service.UpdateBucketCors(ctx, vpcId, s3ServiceDetail.S3ServiceId, bucketName, payload)
 	if !r.Status {
 		if err := d.Set("status", false); err != nil {
 			return diag.FromErr(err)
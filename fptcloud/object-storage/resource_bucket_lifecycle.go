@@ -125,7 +125,7 @@ func resourceBucketLifeCycleCreate(ctx context.Context, d *schema.ResourceData,
 	} else {
 		return diag.FromErr(fmt.Errorf("either 'life_cycle_rule' or 'life_cycle_rule_file' must be specified"))
 	}
-	s3ServiceDetail := getServiceEnableRegion(service, vpcId, regionName)
+	s3ServiceDetail := getServiceEnableRegion(ctx, service, vpcId, regionName)
 	if s3ServiceDetail.S3ServiceId == "" {
 		return diag.FromErr(fmt.Errorf(regionError, regionName))
 	}
@@ -148,7 +148,7 @@ func resourceBucketLifeCycleCreate(ctx context.Context, d *schema.ResourceData,
 	if jsonMap.Expiration.ExpiredObjectDeleteMarker {
 		payload["Expiration"] = map[string]interface{}{"ExpiredObjectDeleteMarker": jsonMap.Expiration.ExpiredObjectDeleteMarker}
 	}
-	r := service.PutBucketLifecycle(vpcId, s3ServiceDetail.S3ServiceId, bucketName, payload)
+	r := service.PutBucketLifecycle(ctx, vpcId, s3ServiceDetail.S3ServiceId, bucketName, payload)
 	d.SetId(bucketName)
 	if !r.Status {
 		if err := d.Set("state", false); err != nil {
@@ -164,32 +164,29 @@ func resourceBucketLifeCycleCreate(ctx context.Context, d *schema.ResourceData,
 
 	return nil
 }
-func resourceBucketLifeCycleRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceBucketLifeCycleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*common.Client)
 	service := NewObjectStorageService(client)
 	bucketName := d.Get("bucket_name").(string)
 	vpcId := d.Get("vpc_id").(string)
 	regionName := d.Get("region_name").(string)
-	s3ServiceDetail := getServiceEnableRegion(service, vpcId, regionName)
+	s3ServiceDetail := getServiceEnableRegion(ctx, service, vpcId, regionName)
 	if s3ServiceDetail.S3ServiceId == "" {
 		return diag.FromErr(fmt.Errorf(regionError, regionName))
 	}
-	page := 1
-	pageSize := 999999
-
-	lifeCycleResponse := service.GetBucketLifecycle(vpcId, s3ServiceDetail.S3ServiceId, bucketName, page, pageSize)
-	if !lifeCycleResponse.Status {
-		return diag.FromErr(fmt.Errorf("failed to fetch life cycle rules for bucket %s", bucketName))
+	lifecycleRules, err := common.ListAll(ctx, common.DefaultPageSize, bucketLifecycleRulePages(service, vpcId, s3ServiceDetail.S3ServiceId, bucketName))
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	d.SetId(bucketName)
 	var formattedData []interface{}
-	if lifeCycleResponse.Total == 0 {
+	if len(lifecycleRules) == 0 {
 		if err := d.Set("rules", make([]interface{}, 0)); err != nil {
 			d.SetId("")
 			return diag.FromErr(err)
 		}
 	}
-	for _, lifecycleRule := range lifeCycleResponse.Rules {
+	for _, lifecycleRule := range lifecycleRules {
 		data := map[string]interface{}{
 			"id": lifecycleRule.ID,
 		}
@@ -208,7 +205,7 @@ func resourceBucketLifeCycleDelete(ctx context.Context, d *schema.ResourceData,
 	bucketName := d.Get("bucket_name").(string)
 	vpcId := d.Get("vpc_id").(string)
 	regionName := d.Get("region_name").(string)
-	s3ServiceDetail := getServiceEnableRegion(service, vpcId, regionName)
+	s3ServiceDetail := getServiceEnableRegion(ctx, service, vpcId, regionName)
 	if s3ServiceDetail.S3ServiceId == "" {
 		return diag.FromErr(fmt.Errorf(regionError, regionName))
 	}
@@ -244,7 +241,7 @@ func resourceBucketLifeCycleDelete(ctx context.Context, d *schema.ResourceData,
 	if jsonMap.Expiration.ExpiredObjectDeleteMarker {
 		payload["Expiration"] = map[string]interface{}{"ExpiredObjectDeleteMarker": jsonMap.Expiration.ExpiredObjectDeleteMarker}
 	}
-	r := service.DeleteBucketLifecycle(vpcId, s3ServiceDetail.S3ServiceId, bucketName, payload)
+	r := service.DeleteBucketLifecycle(ctx, vpcId, s3ServiceDetail.S3ServiceId, bucketName, payload)
 	if !r.Status {
 		if err := d.Set("state", false); err != nil {
 			return diag.FromErr(err)
@@ -80,7 +80,7 @@ func resourceBucketPolicyCreate(ctx context.Context, d *schema.ResourceData, m i
 		return diag.FromErr(fmt.Errorf("either 'policy' or 'policy_file' must be specified"))
 	}
 
-	s3ServiceDetail := getServiceEnableRegion(service, vpcId, regionName)
+	s3ServiceDetail := getServiceEnableRegion(ctx, service, vpcId, regionName)
 	if s3ServiceDetail.S3ServiceId == "" {
 		return diag.FromErr(fmt.Errorf(regionError, regionName))
 	}
@@ -93,7 +93,7 @@ func resourceBucketPolicyCreate(ctx context.Context, d *schema.ResourceData, m i
 	payload := map[string]interface{}{
 		"policy": jsonMap,
 	}
-	resp := service.PutBucketPolicy(vpcId, s3ServiceDetail.S3ServiceId, bucketName, payload)
+	resp := service.PutBucketPolicy(ctx, vpcId, s3ServiceDetail.S3ServiceId, bucketName, payload)
 
 	if !resp.Status {
 		if err := d.Set("status", false); err != nil {
@@ -117,12 +117,12 @@ func resourceBucketPolicyDelete(ctx context.Context, d *schema.ResourceData, m i
 	bucketName := d.Get("bucket_name").(string)
 	vpcId := d.Get("vpc_id").(string)
 	regionName := d.Get("region_name").(string)
-	s3ServiceDetail := getServiceEnableRegion(service, vpcId, regionName)
+	s3ServiceDetail := getServiceEnableRegion(ctx, service, vpcId, regionName)
 	if s3ServiceDetail.S3ServiceId == "" {
 		return diag.FromErr(fmt.Errorf(regionError, d.Get("region_name").(string)))
 	}
 
-	resp := service.PutBucketPolicy(vpcId, s3ServiceDetail.S3ServiceId, bucketName, BucketPolicyRequest{
+	resp := service.PutBucketPolicy(ctx, vpcId, s3ServiceDetail.S3ServiceId, bucketName, BucketPolicyRequest{
 		Policy: "",
 	})
 
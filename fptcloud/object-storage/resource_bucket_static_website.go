@@ -71,11 +71,11 @@ func resourceBucketStaticWebsiteCreate(ctx context.Context, d *schema.ResourceDa
 	regionName := d.Get("region_name").(string)
 	indexDocument := d.Get("index_document_suffix").(string)
 	errorDocument := d.Get("error_document_key").(string)
-	s3ServiceDetail := getServiceEnableRegion(service, vpcId, regionName)
+	s3ServiceDetail := getServiceEnableRegion(ctx, service, vpcId, regionName)
 	if s3ServiceDetail.S3ServiceId == "" {
 		return diag.FromErr(fmt.Errorf(regionError, d.Get("region_name").(string)))
 	}
-	putBucketWebsite := service.PutBucketWebsite(vpcId, s3ServiceDetail.S3ServiceId, bucketName, BucketWebsiteRequest{
+	putBucketWebsite := service.PutBucketWebsite(ctx, vpcId, s3ServiceDetail.S3ServiceId, bucketName, BucketWebsiteRequest{
 		Bucket: bucketName,
 		Suffix: indexDocument,
 		Key:    errorDocument,
@@ -102,12 +102,12 @@ func resourceDeleteBucketStaticWebsite(ctx context.Context, d *schema.ResourceDa
 	bucketName := d.Get("bucket_name").(string)
 	vpcId := d.Get("vpc_id").(string)
 	regionName := d.Get("region_name").(string)
-	s3ServiceDetail := getServiceEnableRegion(service, vpcId, regionName)
+	s3ServiceDetail := getServiceEnableRegion(ctx, service, vpcId, regionName)
 	if s3ServiceDetail.S3ServiceId == "" {
 		return diag.FromErr(fmt.Errorf(regionError, d.Get("region_name").(string)))
 	}
 
-	resp := service.DeleteBucketStaticWebsite(vpcId, s3ServiceDetail.S3ServiceId, bucketName)
+	resp := service.DeleteBucketStaticWebsite(ctx, vpcId, s3ServiceDetail.S3ServiceId, bucketName)
 	if !resp.Status {
 		return diag.Errorf("failed to delete bucket website for bucket %s", bucketName)
 	}
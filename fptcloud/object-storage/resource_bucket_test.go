@@ -0,0 +1,64 @@
+package fptcloud_object_storage_test
+
+import (
+	"context"
+	"testing"
+
+	fptcloud_object_storage "terraform-provider-fptcloud/fptcloud/object-storage"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+// upgradeBucketState upgrades the JSON state of a bucket of the given schema version like Terraform does
+func upgradeBucketState(t *testing.T, version int64, state string) map[string]tftypes.Value {
+	provider := &schema.Provider{ResourcesMap: map[string]*schema.Resource{
+		"fptcloud_object_storage_bucket": fptcloud_object_storage.ResourceBucket(),
+	}}
+	server := schema.NewGRPCProviderServer(provider)
+	schemas, err := server.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	if !assert.NoError(t, err) {
+		return nil
+	}
+
+	response, err := server.UpgradeResourceState(context.Background(), &tfprotov5.UpgradeResourceStateRequest{
+		TypeName: "fptcloud_object_storage_bucket",
+		Version:  version,
+		RawState: &tfprotov5.RawState{JSON: []byte(state)},
+	})
+	if !assert.NoError(t, err) || !assert.Empty(t, response.Diagnostics) {
+		return nil
+	}
+
+	upgraded, err := response.UpgradedState.Unmarshal(schemas.ResourceSchemas["fptcloud_object_storage_bucket"].ValueType())
+	if !assert.NoError(t, err) {
+		return nil
+	}
+	var attributes map[string]tftypes.Value
+	assert.NoError(t, upgraded.As(&attributes))
+	return attributes
+}
+
+func TestResourceBucket_ReadsEarlierState(t *testing.T) {
+	bucket := fptcloud_object_storage.ResourceBucket()
+	assert.Zero(t, bucket.SchemaVersion)
+	assert.False(t, bucket.Schema["status"].ForceNew)
+
+	// The state written while status forced a new bucket has the same shape
+	for _, status := range []string{`false`, `null`, `true`} {
+		upgraded := upgradeBucketState(t, 0, `{
+			"id": "bucket",
+			"name": "bucket",
+			"versioning": "Suspended",
+			"region_name": "HCM-02",
+			"acl": "private",
+			"vpc_id": "vpc-id",
+			"object_lock": false,
+			"status": `+status+`
+		}`)
+		assert.True(t, upgraded["name"].Equal(tftypes.NewValue(tftypes.String, "bucket")))
+		assert.True(t, upgraded["vpc_id"].Equal(tftypes.NewValue(tftypes.String, "vpc-id")))
+	}
+}
@@ -54,11 +54,11 @@ func resourceBucketVersioningCreate(ctx context.Context, d *schema.ResourceData,
 	versioningStatus := d.Get("versioning_status").(string)
 	vpcId := d.Get("vpc_id").(string)
 	regionName := d.Get("region_name").(string)
-	s3ServiceDetail := getServiceEnableRegion(service, vpcId, regionName)
+	s3ServiceDetail := getServiceEnableRegion(ctx, service, vpcId, regionName)
 	if s3ServiceDetail.S3ServiceId == "" {
 		return diag.FromErr(fmt.Errorf(regionError, d.Get("region_name").(string)))
 	}
-	err := service.PutBucketVersioning(vpcId, s3ServiceDetail.S3ServiceId, bucketName, BucketVersioningRequest{
+	err := service.PutBucketVersioning(ctx, vpcId, s3ServiceDetail.S3ServiceId, bucketName, BucketVersioningRequest{
 		Status: versioningStatus,
 	})
 
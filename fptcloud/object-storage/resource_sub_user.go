@@ -3,6 +3,7 @@ package fptcloud_object_storage
 import (
 	"context"
 	"fmt"
+	"log"
 	common "terraform-provider-fptcloud/commons"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -71,12 +72,12 @@ func resourceSubUserCreate(ctx context.Context, d *schema.ResourceData, m interf
 		UserId: subUserId,
 	}
 
-	s3ServiceDetail := getServiceEnableRegion(objectStorageService, vpcId, regionName)
+	s3ServiceDetail := getServiceEnableRegion(ctx, objectStorageService, vpcId, regionName)
 	if s3ServiceDetail.S3ServiceId == "" {
 		return diag.FromErr(fmt.Errorf(regionError, regionName))
 	}
 
-	err := objectStorageService.CreateSubUser(req, vpcId, s3ServiceDetail.S3ServiceId)
+	err := objectStorageService.CreateSubUser(ctx, req, vpcId, s3ServiceDetail.S3ServiceId)
 	if !err.Status {
 		return diag.FromErr(fmt.Errorf("error creating sub-user: %s", err.Message))
 	}
@@ -96,21 +97,21 @@ func resourceSubUserRead(ctx context.Context, d *schema.ResourceData, m interfac
 	objectStorageService := NewObjectStorageService(client)
 	vpcId := d.Get("vpc_id").(string)
 	regionName := d.Get("region_name").(string)
-	s3ServiceDetail := getServiceEnableRegion(objectStorageService, vpcId, regionName)
+	s3ServiceDetail := getServiceEnableRegion(ctx, objectStorageService, vpcId, regionName)
 	if s3ServiceDetail.S3ServiceId == "" {
 		return diag.FromErr(fmt.Errorf(regionError, regionName))
 	}
 
 	subUserId := d.Id()
-	subUser := objectStorageService.DetailSubUser(vpcId, s3ServiceDetail.S3ServiceId, subUserId)
-	if subUser == nil {
-		d.SetId("")
-		return diag.Errorf("sub-user with ID %s not found", subUserId)
+	subUser, err := objectStorageService.DetailSubUser(ctx, vpcId, s3ServiceDetail.S3ServiceId, subUserId)
+	if err != nil && !common.IsNotFound(err) {
+		return diag.FromErr(err)
 	}
 
-	if subUser.UserID == "" {
+	if subUser == nil || subUser.UserID == "" {
+		log.Printf("[WARN] Sub-user %s not found, removing it from state", subUserId)
 		d.SetId("")
-		return diag.Errorf("sub-user with ID %s not found", subUserId)
+		return nil
 	}
 
 	if err := d.Set("user_id", subUser.UserID); err != nil {
@@ -144,11 +145,11 @@ func resourceSubUserDelete(ctx context.Context, d *schema.ResourceData, m any) d
 	client := m.(*common.Client)
 	objectStorageService := NewObjectStorageService(client)
 	vpcId := d.Get("vpc_id").(string)
-	s3ServiceDetail := getServiceEnableRegion(objectStorageService, vpcId, d.Get("region_name").(string))
+	s3ServiceDetail := getServiceEnableRegion(ctx, objectStorageService, vpcId, d.Get("region_name").(string))
 	if s3ServiceDetail.S3ServiceId == "" {
 		return diag.FromErr(fmt.Errorf(regionError, d.Get("region_name").(string)))
 	}
-	err := objectStorageService.DeleteSubUser(vpcId, s3ServiceDetail.S3ServiceId, d.Id())
+	err := objectStorageService.DeleteSubUser(ctx, vpcId, s3ServiceDetail.S3ServiceId, d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
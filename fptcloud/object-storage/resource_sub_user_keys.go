@@ -3,6 +3,7 @@ package fptcloud_object_storage
 import (
 	"context"
 	"fmt"
+	"log"
 	common "terraform-provider-fptcloud/commons"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -52,11 +53,11 @@ func resourceSubUserAccessKeyCreate(ctx context.Context, d *schema.ResourceData,
 
 	vpcId := d.Get("vpc_id").(string)
 	subUserId := d.Get("user_id").(string)
-	s3ServiceDetail := getServiceEnableRegion(objectStorageService, vpcId, d.Get("region_name").(string))
+	s3ServiceDetail := getServiceEnableRegion(ctx, objectStorageService, vpcId, d.Get("region_name").(string))
 	if s3ServiceDetail.S3ServiceId == "" {
 		return diag.FromErr(fmt.Errorf(regionError, d.Get("region_name").(string)))
 	}
-	resp := objectStorageService.CreateSubUserAccessKey(vpcId, s3ServiceDetail.S3ServiceId, subUserId)
+	resp := objectStorageService.CreateSubUserAccessKey(ctx, vpcId, s3ServiceDetail.S3ServiceId, subUserId)
 
 	if !resp.Status {
 		return diag.FromErr(fmt.Errorf("error creating sub-user access key: %s", resp.Message))
@@ -79,15 +80,20 @@ func resourceReadUserDetail(ctx context.Context, d *schema.ResourceData, m inter
 	objectStorageService := NewObjectStorageService(client)
 
 	vpcId := d.Get("vpc_id").(string)
-	s3ServiceDetail := getServiceEnableRegion(objectStorageService, vpcId, d.Get("region_name").(string))
+	s3ServiceDetail := getServiceEnableRegion(ctx, objectStorageService, vpcId, d.Get("region_name").(string))
 	if s3ServiceDetail.S3ServiceId == "" {
 		return diag.FromErr(fmt.Errorf(regionError, d.Get("region_name").(string)))
 	}
 	subUserId := d.Get("user_id").(string)
 
-	subUser := objectStorageService.DetailSubUser(vpcId, s3ServiceDetail.S3ServiceId, subUserId)
-	if subUser.UserID == "" {
-		return diag.Errorf("sub-user with ID %s not found", subUserId)
+	subUser, err := objectStorageService.DetailSubUser(ctx, vpcId, s3ServiceDetail.S3ServiceId, subUserId)
+	if err != nil && !common.IsNotFound(err) {
+		return diag.FromErr(err)
+	}
+	if subUser == nil || subUser.UserID == "" {
+		log.Printf("[WARN] Sub-user %s not found, removing its access key from state", subUserId)
+		d.SetId("")
+		return nil
 	}
 	if err := d.Set("user_id", subUser.UserID); err != nil {
 		return diag.FromErr(err)
@@ -99,14 +105,14 @@ func resourceSubUserAccessKeyDelete(ctx context.Context, d *schema.ResourceData,
 	objectStorageService := NewObjectStorageService(client)
 
 	vpcId := d.Get("vpc_id").(string)
-	s3ServiceDetail := getServiceEnableRegion(objectStorageService, vpcId, d.Get("region_name").(string))
+	s3ServiceDetail := getServiceEnableRegion(ctx, objectStorageService, vpcId, d.Get("region_name").(string))
 	if s3ServiceDetail.S3ServiceId == "" {
 		return diag.FromErr(fmt.Errorf(regionError, d.Get("region_name").(string)))
 	}
 	subUserId := d.Get("user_id").(string)
 	accessKeyToDelete := d.Get("access_key").(string)
 
-	resp := objectStorageService.DeleteSubUserAccessKey(vpcId, s3ServiceDetail.S3ServiceId, subUserId, accessKeyToDelete)
+	resp := objectStorageService.DeleteSubUserAccessKey(ctx, vpcId, s3ServiceDetail.S3ServiceId, subUserId, accessKeyToDelete)
 	if !resp.Status {
 		return diag.Errorf("failed to delete sub-user access key: %s", resp.Message)
 	}
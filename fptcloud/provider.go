@@ -3,7 +3,9 @@ package fptcloud
 import (
 	"context"
 	"log"
+	"strings"
 	common "terraform-provider-fptcloud/commons"
+	"terraform-provider-fptcloud/commons/config"
 	fptcloud_database_flavors "terraform-provider-fptcloud/fptcloud/database_flavors"
 	fptcloud_flavor "terraform-provider-fptcloud/fptcloud/flavor"
 	fptcloud_floating_ip "terraform-provider-fptcloud/fptcloud/floating-ip"
@@ -27,8 +29,10 @@ import (
 	fptcloud_vgpu "terraform-provider-fptcloud/fptcloud/vgpu"
 	fptcloud_vpc "terraform-provider-fptcloud/fptcloud/vpc"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 var (
@@ -41,37 +45,123 @@ var (
 
 // Provider fptcloud provider
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"token": {
 				Type:        schema.TypeString,
-				Required:    true,
-				DefaultFunc: schema.EnvDefaultFunc("FPTCLOUD_TOKEN", ""),
+				Optional:    true,
 				Description: "This is the Fpt cloud API token. Alternatively, this can also be specified using `FPTCLOUD_TOKEN` environment variable.",
 			},
 			"tenant_name": {
 				Type:        schema.TypeString,
-				Required:    true,
-				DefaultFunc: schema.EnvDefaultFunc("FPTCLOUD_TENANT_NAME", ""),
+				Optional:    true,
 				Description: "The tenant name to use",
 			},
 			"region": {
 				Type:        schema.TypeString,
-				Required:    true,
-				DefaultFunc: schema.EnvDefaultFunc("FPTCLOUD_REGION", ""),
-				Description: "The region to use (VN/HAN | VN/SGN | JP/JCSI2)",
+				Optional:    true,
+				Description: "The region to use (" + strings.Join(common.RegionCodes(), " | ") + "). Alternatively, this can also be specified using `FPTCLOUD_REGION` environment variable.",
 			},
 			"api_endpoint": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("FPTCLOUD_API_URL", ProdAPI),
 				Description: "The URL to use",
 			},
+			"profile": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the profile of the shared config file (`~/.fptcloud/config`, or `FPTCLOUD_CONFIG_FILE`) providing the credentials not set in the provider configuration or the environment. Alternatively, this can also be specified using `FPTCLOUD_PROFILE` environment variable. Defaults to the `default` profile when it exists.",
+			},
 			"timeout": {
 				Type:        schema.TypeInt,
 				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("FPTCLOUD_TIMEOUT", 15),
-				Description: "Timeout in minutes (optional)",
+				Description: "Timeout in minutes of the API requests and of the resource operations without a `timeouts` block, 15 by default. Alternatively, this can also be specified using `FPTCLOUD_TIMEOUT` environment variable.",
+			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum number of retries for throttled or transiently failing API requests. Alternatively, this can also be specified using `FPTCLOUD_MAX_RETRIES` environment variable.",
+			},
+			"retry_max_wait": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Maximum wait in seconds between two retries of an API request. Alternatively, this can also be specified using `FPTCLOUD_RETRY_MAX_WAIT` environment variable.",
+			},
+			"ca_cert_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"ca_cert_pem"},
+				Description:   "Path to a PEM encoded CA bundle trusted in addition to the system roots. Alternatively, this can also be specified using `FPTCLOUD_CA_CERT_FILE` environment variable.",
+			},
+			"ca_cert_pem": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"ca_cert_file"},
+				Description:   "PEM encoded CA bundle trusted in addition to the system roots.",
+			},
+			"client_cert_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"client_cert_pem"},
+				Description:   "Path to a PEM encoded client certificate for mutual TLS. Alternatively, this can also be specified using `FPTCLOUD_CLIENT_CERT_FILE` environment variable.",
+			},
+			"client_cert_pem": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"client_cert_file"},
+				Description:   "PEM encoded client certificate for mutual TLS.",
+			},
+			"client_key_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"client_key_pem"},
+				Description:   "Path to the PEM encoded private key of the client certificate. Alternatively, this can also be specified using `FPTCLOUD_CLIENT_KEY_FILE` environment variable.",
+			},
+			"client_key_pem": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"client_key_file"},
+				Description:   "PEM encoded private key of the client certificate.",
+			},
+			"insecure_skip_verify": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Skip TLS certificate verification of the API endpoint. Only use this for lab endpoints. Alternatively, this can also be specified using `FPTCLOUD_INSECURE_SKIP_VERIFY` environment variable.",
+			},
+			"proxy_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "URL of the proxy used to reach the API. When unset, the standard `HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY` environment variables apply. Alternatively, this can also be specified using `FPTCLOUD_PROXY_URL` environment variable.",
+			},
+			"read_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Refuse every request that may change the infrastructure, such as creating, updating or deleting a resource, so that plans and data sources can run with production credentials safely. Alternatively, this can also be specified using `FPTCLOUD_READ_ONLY` environment variable.",
+			},
+			"skip_credentials_validation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Configure the provider without checking the token, the tenant_name and the region against the API. Alternatively, this can also be specified using `FPTCLOUD_SKIP_CREDENTIALS_VALIDATION` environment variable.",
+			},
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The VPC id used by the resources and data sources without their own `vpc_id`. Alternatively, this can also be specified using `FPTCLOUD_VPC_ID` environment variable.",
+			},
+			"default_tag_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Tag IDs applied to every taggable resource in addition to its own `tag_ids`. They are left out of the `tag_ids` of the resources unless configured there, and tracked in their `tag_ids_all`: a resource missing one of them is updated by the next apply.",
+			},
+			"ignore_tag_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Tag IDs applied outside of Terraform. They are kept when the tags of a resource are updated and left out of its `tag_ids`, so that they don't show as drift.",
 			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
@@ -117,12 +207,11 @@ func Provider() *schema.Provider {
 			"fptcloud_load_balancer_v2_sizes":               fptcloud_load_balancer_v2.DataSourceSizes(),
 			"fptcloud_tagging":                              fptcloud_tagging.DataSourceTagging(),
 		},
+		// The resources left to migrate to the framework provider, listed in the README
 		ResourcesMap: map[string]*schema.Resource{
 			"fptcloud_storage":                              fptcloud_storage.ResourceStorage(),
-			"fptcloud_ssh_key":                              fptcloud_ssh.ResourceSSHKey(),
 			"fptcloud_security_group":                       fptcloud_security_group.ResourceSecurityGroup(),
 			"fptcloud_security_group_rule":                  fptcloud_security_group_rule.ResourceSecurityGroupRule(),
-			"fptcloud_instance":                             fptcloud_instance.ResourceInstance(),
 			"fptcloud_instance_group":                       fptcloud_instance_group.ResourceInstanceGroup(),
 			"fptcloud_floating_ip":                          fptcloud_floating_ip.ResourceFloatingIp(),
 			"fptcloud_floating_ip_association":              fptcloud_floating_ip_association.ResourceFloatingIpAssociation(),
@@ -147,54 +236,131 @@ func Provider() *schema.Provider {
 		},
 		ConfigureContextFunc: providerConfigureContext,
 	}
+	common.InheritVpcId(p.ResourcesMap, p.DataSourcesMap)
+	return p
 }
 
 // Provider configuration
-func providerConfigureContext(_ context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
-	var diags diag.Diagnostics
-	var regionValue, tokenValue, tenantNameValue, apiURL string
-	var timeoutValue int
-	var client *common.Client
-	var err error
+func providerConfigureContext(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	raw := d.GetRawConfig()
+	settings, errs := config.Resolve(providerConfig(raw))
+	if len(errs) > 0 {
+		return nil, attributeDiagnostics(errs)
+	}
 
-	if region, ok := d.GetOk("region"); ok {
-		regionValue = region.(string)
+	client, err := config.SharedClient(settings, common.Component{
+		Name:    "terraform-provider-fptcloud",
+		Version: ProviderVersion,
+	})
+	if err != nil {
+		return nil, diag.Errorf("[ERR] %s", err)
+	}
+
+	if !settings.SkipCredentialsValidation {
+		if errs := validateCredentials(ctx, client); len(errs) > 0 {
+			return nil, attributeDiagnostics(errs)
+		}
 	}
 
-	if tenantName, ok := d.GetOk("tenant_name"); ok {
-		tenantNameValue = tenantName.(string)
+	log.Printf("[DEBUG] Fptcloud API URL: %s\n", settings.ApiEndpoint)
+	log.Printf("[DEBUG] Fptcloud tenant name: %s\n", settings.TenantName)
+	return client, nil
+}
+
+// providerConfig returns the provider configuration of the raw HCL, unknown values being unset
+func providerConfig(raw cty.Value) config.Config {
+	return config.Config{
+		Token:                     configString(raw, "token"),
+		TenantName:                configString(raw, "tenant_name"),
+		Region:                    configString(raw, "region"),
+		ApiEndpoint:               configString(raw, "api_endpoint"),
+		Profile:                   configString(raw, "profile"),
+		Timeout:                   configInt(raw, "timeout"),
+		MaxRetries:                configInt(raw, "max_retries"),
+		RetryMaxWait:              configInt(raw, "retry_max_wait"),
+		CACertFile:                configString(raw, "ca_cert_file"),
+		CACertPEM:                 configString(raw, "ca_cert_pem"),
+		ClientCertFile:            configString(raw, "client_cert_file"),
+		ClientCertPEM:             configString(raw, "client_cert_pem"),
+		ClientKeyFile:             configString(raw, "client_key_file"),
+		ClientKeyPEM:              configString(raw, "client_key_pem"),
+		InsecureSkipVerify:        configBool(raw, "insecure_skip_verify"),
+		ProxyURL:                  configString(raw, "proxy_url"),
+		ReadOnly:                  configBool(raw, "read_only"),
+		SkipCredentialsValidation: configBool(raw, "skip_credentials_validation"),
+		VpcId:                     configString(raw, "vpc_id"),
+		DefaultTagIds:             configStringSet(raw, "default_tag_ids"),
+		IgnoreTagIds:              configStringSet(raw, "ignore_tag_ids"),
 	}
+}
 
-	if token, ok := d.GetOk("token"); ok {
-		tokenValue = token.(string)
-	} else {
-		return nil, diag.Errorf("[ERR] token not found")
+// configString returns the value of a provider attribute as written in HCL, nil when unset
+func configString(raw cty.Value, name string) *string {
+	value := configAttribute(raw, name)
+	if value.IsNull() || value.Type() != cty.String {
+		return nil
 	}
+	result := value.AsString()
+	return &result
+}
 
-	if apiEndpoint, ok := d.GetOk("api_endpoint"); ok {
-		apiURL = apiEndpoint.(string)
-	} else {
-		apiURL = ProdAPI
+func configInt(raw cty.Value, name string) *int {
+	value := configAttribute(raw, name)
+	if value.IsNull() || value.Type() != cty.Number {
+		return nil
 	}
+	result, _ := value.AsBigFloat().Int64()
+	converted := int(result)
+	return &converted
+}
 
-	if timeout, ok := d.GetOk("timeout"); ok {
-		timeoutValue = timeout.(int)
-	} else {
-		timeoutValue = 15 // Default 15 minutes
+func configBool(raw cty.Value, name string) *bool {
+	value := configAttribute(raw, name)
+	if value.IsNull() || value.Type() != cty.Bool {
+		return nil
 	}
+	result := value.True()
+	return &result
+}
 
-	client, err = common.NewClientWithURL(tokenValue, apiURL, regionValue, tenantNameValue, timeoutValue)
-	if err != nil {
-		return nil, diag.FromErr(err)
+// configStringSet returns the known string elements of a set attribute of the raw provider configuration
+func configStringSet(raw cty.Value, name string) []string {
+	value := configAttribute(raw, name)
+	if value.IsNull() || !value.CanIterateElements() {
+		return nil
+	}
+	var result []string
+	for it := value.ElementIterator(); it.Next(); {
+		_, element := it.Element()
+		if element.IsKnown() && !element.IsNull() && element.Type() == cty.String {
+			result = append(result, element.AsString())
+		}
 	}
+	return result
+}
 
-	userAgent := &common.Component{
-		Name:    "terraform-provider-fptcloud",
-		Version: ProviderVersion,
+// attributeDiagnostics returns the diagnostics of provider configuration errors, scoped to their attribute
+func attributeDiagnostics(errs []*config.AttributeError) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, err := range errs {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       err.Summary,
+			Detail:        err.Detail,
+			AttributePath: cty.GetAttrPath(err.Attribute),
+		})
 	}
-	client.SetUserAgent(userAgent)
+	return diags
+}
 
-	log.Printf("[DEBUG] Fptcloud API URL: %s\n", apiURL)
-	log.Printf("[DEBUG] Fptcloud tenant name: %s\n", tenantNameValue)
-	return client, diags
+// configAttribute returns the attribute of the raw provider configuration, null when unset or unknown
+func configAttribute(raw cty.Value, name string) cty.Value {
+	if raw.IsNull() || !raw.IsKnown() || !raw.Type().IsObjectType() || !raw.Type().HasAttribute(name) {
+		return cty.NullVal(cty.DynamicPseudoType)
+	}
+	value := raw.GetAttr(name)
+	if !value.IsKnown() {
+		return cty.NullVal(cty.DynamicPseudoType)
+	}
+	return value
 }
@@ -2,10 +2,16 @@ package fptcloud
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"strings"
 
 	"testing"
 
+	common "terraform-provider-fptcloud/commons"
+	"terraform-provider-fptcloud/commons/config"
+
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -27,16 +33,200 @@ func TestProviderImp(t *testing.T) {
 func TestConfig(t *testing.T) {
 	rawProvider := Provider()
 	raw := map[string]interface{}{
-		"token":       "example_token",
-		"tenant_name": "example_tenant_name",
-		"region":      "example_region",
-		"timeout":     10,
+		"skip_credentials_validation": true,
+		"token":                       "example_token",
+		"tenant_name":                 "example_tenant_name",
+		"region":                      "VN/HAN",
+		"timeout":                     10,
+	}
+
+	diags := rawProvider.Configure(context.Background(), testProviderConfig(rawProvider, raw))
+	if diags.HasError() {
+		t.Fatalf("provider configure failed: %s", diagnosticsToString(diags))
+	}
+}
+
+// TestConfigProfile tests the credentials resolved from a named profile of the shared config file
+func TestConfigProfile(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "config")
+	content := "[japan]\ntoken = profile_token\ntenant_name = profile_tenant_name\nregion = JP/JCSI2\n"
+	if err := os.WriteFile(configFile, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(common.ConfigFileEnvVar, configFile)
+	t.Setenv("FPTCLOUD_TOKEN", "")
+	t.Setenv("FPTCLOUD_TENANT_NAME", "")
+	t.Setenv("FPTCLOUD_REGION", "")
+
+	rawProvider := Provider()
+	raw := map[string]interface{}{
+		"skip_credentials_validation": true,
+		"profile":                     "japan",
+		"tenant_name":                 "example_tenant_name",
+	}
+
+	diags := rawProvider.Configure(context.Background(), testProviderConfig(rawProvider, raw))
+	if diags.HasError() {
+		t.Fatalf("provider configure failed: %s", diagnosticsToString(diags))
+	}
+
+	client := rawProvider.Meta().(*common.Client)
+	if client.APIKey != "profile_token" || client.TenantName != "example_tenant_name" || client.Region != "JP/JCSI2" {
+		t.Fatalf("unexpected credentials: %s, %s, %s", client.APIKey, client.TenantName, client.Region)
+	}
+
+	raw["profile"] = "missing"
+	diags = rawProvider.Configure(context.Background(), testProviderConfig(rawProvider, raw))
+	if !diags.HasError() {
+		t.Fatal("expected an error for a missing profile")
+	}
+}
+
+// TestConfigSharedClient tests that providers configured alike share one API client
+func TestConfigSharedClient(t *testing.T) {
+	raw := map[string]interface{}{
+		"skip_credentials_validation": true,
+		"token":                       "example_token",
+		"tenant_name":                 "example_tenant_name",
+		"region":                      "VN/HAN",
+	}
+
+	clients := make([]*common.Client, 2)
+	for i := range clients {
+		rawProvider := Provider()
+		diags := rawProvider.Configure(context.Background(), testProviderConfig(rawProvider, raw))
+		if diags.HasError() {
+			t.Fatalf("provider configure failed: %s", diagnosticsToString(diags))
+		}
+		clients[i] = rawProvider.Meta().(*common.Client)
+	}
+
+	if clients[0] != clients[1] {
+		t.Fatal("expected the providers to share the API client")
+	}
+	if clients[0].Timeout != config.DefaultTimeout {
+		t.Fatalf("unexpected timeout: %d", clients[0].Timeout)
+	}
+}
+
+// TestConfigTagIds tests that the provider tag IDs reach the API client
+func TestConfigTagIds(t *testing.T) {
+	raw := map[string]interface{}{
+		"skip_credentials_validation": true,
+		"token":                       "example_token",
+		"tenant_name":                 "example_tenant_name",
+		"region":                      "VN/HAN",
+		"default_tag_ids":             []string{"owner"},
+		"ignore_tag_ids":              []string{"backup"},
+	}
+
+	rawProvider := Provider()
+	diags := rawProvider.Configure(context.Background(), testProviderConfig(rawProvider, raw))
+	if diags.HasError() {
+		t.Fatalf("provider configure failed: %s", diagnosticsToString(diags))
+	}
+
+	client := rawProvider.Meta().(*common.Client)
+	if len(client.Tags.DefaultTagIds) != 1 || client.Tags.DefaultTagIds[0] != "owner" {
+		t.Fatalf("unexpected default tag IDs: %v", client.Tags.DefaultTagIds)
+	}
+	if len(client.Tags.IgnoreTagIds) != 1 || client.Tags.IgnoreTagIds[0] != "backup" {
+		t.Fatalf("unexpected ignored tag IDs: %v", client.Tags.IgnoreTagIds)
+	}
+}
+
+// TestProviderVpcId tests that the required vpc_id of the resources and data sources falls back to the provider vpc_id
+func TestProviderVpcId(t *testing.T) {
+	t.Setenv("FPTCLOUD_VPC_ID", "")
+	raw := map[string]interface{}{
+		"skip_credentials_validation": true,
+		"token":                       "example_token",
+		"tenant_name":                 "example_tenant_name",
+		"region":                      "VN/HAN",
+		"vpc_id":                      "example_vpc_id",
+	}
+
+	rawProvider := Provider()
+	for _, name := range []string{"fptcloud_storage", "fptcloud_subnet", "fptcloud_security_group", "fptcloud_floating_ip"} {
+		vpcId := rawProvider.ResourcesMap[name].Schema["vpc_id"]
+		if vpcId.Required || !vpcId.Optional || !vpcId.Computed {
+			t.Fatalf("expected the vpc_id of %s to fall back to the provider vpc_id", name)
+		}
+	}
+	if rawProvider.DataSourcesMap["fptcloud_flavor"].Schema["vpc_id"].Required {
+		t.Fatal("expected the vpc_id of fptcloud_flavor to fall back to the provider vpc_id")
 	}
 
-	diags := rawProvider.Configure(context.Background(), terraform.NewResourceConfigRaw(raw))
+	diags := rawProvider.Configure(context.Background(), testProviderConfig(rawProvider, raw))
 	if diags.HasError() {
 		t.Fatalf("provider configure failed: %s", diagnosticsToString(diags))
 	}
+	if vpcId := rawProvider.Meta().(*common.Client).VpcId; vpcId != "example_vpc_id" {
+		t.Fatalf("unexpected vpc_id: %s", vpcId)
+	}
+}
+
+// TestProviderVpcId_BuiltTwice tests that every provider falls back to the provider vpc_id, although some resources
+// share their schema between providers
+func TestProviderVpcId_BuiltTwice(t *testing.T) {
+	Provider()
+	rawProvider := Provider()
+	for _, name := range []string{"fptcloud_storage", "fptcloud_subnet", "fptcloud_security_group", "fptcloud_load_balancer_v2_listener"} {
+		resource := rawProvider.ResourcesMap[name]
+		if resource.Schema["vpc_id"].Required || resource.CustomizeDiff == nil {
+			t.Fatalf("expected the vpc_id of %s to be planned from the provider vpc_id", name)
+		}
+	}
+	for _, name := range []string{"fptcloud_instance", "fptcloud_load_balancer_v2_listener", "fptcloud_load_balancer_v2_certificates"} {
+		if rawProvider.DataSourcesMap[name].Schema["vpc_id"].Required {
+			t.Fatalf("expected the vpc_id of the %s data source to fall back to the provider vpc_id", name)
+		}
+	}
+}
+
+// TestConfigMissingCredentials tests the attribute scoped errors of missing credentials
+func TestConfigMissingCredentials(t *testing.T) {
+	t.Setenv(common.ConfigFileEnvVar, filepath.Join(t.TempDir(), "missing"))
+	t.Setenv("FPTCLOUD_TOKEN", "")
+	t.Setenv("FPTCLOUD_TENANT_NAME", "")
+	t.Setenv("FPTCLOUD_REGION", "VN/HAN")
+
+	rawProvider := Provider()
+	diags := rawProvider.Configure(context.Background(), testProviderConfig(rawProvider, map[string]interface{}{}))
+	if len(diags) != 2 {
+		t.Fatalf("expected token and tenant_name errors, got: %s", diagnosticsToString(diags))
+	}
+	if !diags[0].AttributePath.Equals(cty.GetAttrPath("token")) || !diags[1].AttributePath.Equals(cty.GetAttrPath("tenant_name")) {
+		t.Fatalf("unexpected attribute paths: %s", diagnosticsToString(diags))
+	}
+}
+
+// testProviderConfig builds the provider configuration the way Terraform sends it, unset attributes being null
+func testProviderConfig(p *schema.Provider, raw map[string]interface{}) *terraform.ResourceConfig {
+	block := schema.InternalMap(p.Schema).CoreConfigSchema()
+	attributes := map[string]cty.Value{}
+	for name, attribute := range block.Attributes {
+		switch value := raw[name].(type) {
+		case string:
+			attributes[name] = cty.StringVal(value)
+		case int:
+			attributes[name] = cty.NumberIntVal(int64(value))
+		case bool:
+			attributes[name] = cty.BoolVal(value)
+		case []string:
+			elements := make([]cty.Value, 0, len(value))
+			for _, element := range value {
+				elements = append(elements, cty.StringVal(element))
+			}
+			attributes[name] = cty.SetVal(elements)
+		default:
+			attributes[name] = cty.NullVal(attribute.Type)
+		}
+	}
+	value := cty.ObjectVal(attributes)
+	resourceConfig := terraform.NewResourceConfigShimmed(value, block)
+	resourceConfig.CtyValue = value
+	return resourceConfig
 }
 
 func diagnosticsToString(diags diag.Diagnostics) string {
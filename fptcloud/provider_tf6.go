@@ -2,14 +2,23 @@ package fptcloud
 
 import (
 	"context"
-	"os"
+	"strings"
 	common "terraform-provider-fptcloud/commons"
+	"terraform-provider-fptcloud/commons/config"
 	fptcloud_database "terraform-provider-fptcloud/fptcloud/database"
 	fptcloud_dfke "terraform-provider-fptcloud/fptcloud/dfke"
 	fptcloud_edge_gateway "terraform-provider-fptcloud/fptcloud/edge_gateway"
+	fptcloud_instance "terraform-provider-fptcloud/fptcloud/instance"
 	fptcloud_mfke "terraform-provider-fptcloud/fptcloud/mfke"
+	fptcloud_mfke_kubeconfig "terraform-provider-fptcloud/fptcloud/mfke-kubeconfig"
+	fptcloud_object_storage "terraform-provider-fptcloud/fptcloud/object-storage"
+	fptcloud_ssh "terraform-provider-fptcloud/fptcloud/ssh"
+	fptcloud_subnet "terraform-provider-fptcloud/fptcloud/subnet"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -19,15 +28,35 @@ import (
 )
 
 var (
-	_ provider.Provider = &xplatProvider{}
+	_ provider.Provider                       = &xplatProvider{}
+	_ provider.ProviderWithEphemeralResources = &xplatProvider{}
+	_ provider.ProviderWithFunctions          = &xplatProvider{}
 )
 
 type xplatProviderModel struct {
-	Region      types.String `tfsdk:"region"`
-	Token       types.String `tfsdk:"token"`
-	TenantName  types.String `tfsdk:"tenant_name"`
-	ApiEndpoint types.String `tfsdk:"api_endpoint"`
-	Timeout     types.Int64  `tfsdk:"timeout"`
+	Region       types.String `tfsdk:"region"`
+	Token        types.String `tfsdk:"token"`
+	TenantName   types.String `tfsdk:"tenant_name"`
+	ApiEndpoint  types.String `tfsdk:"api_endpoint"`
+	Profile      types.String `tfsdk:"profile"`
+	Timeout      types.Int64  `tfsdk:"timeout"`
+	MaxRetries   types.Int64  `tfsdk:"max_retries"`
+	RetryMaxWait types.Int64  `tfsdk:"retry_max_wait"`
+
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	CACertPEM          types.String `tfsdk:"ca_cert_pem"`
+	ClientCertFile     types.String `tfsdk:"client_cert_file"`
+	ClientCertPEM      types.String `tfsdk:"client_cert_pem"`
+	ClientKeyFile      types.String `tfsdk:"client_key_file"`
+	ClientKeyPEM       types.String `tfsdk:"client_key_pem"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	ProxyURL           types.String `tfsdk:"proxy_url"`
+
+	ReadOnly                  types.Bool   `tfsdk:"read_only"`
+	SkipCredentialsValidation types.Bool   `tfsdk:"skip_credentials_validation"`
+	VpcId                     types.String `tfsdk:"vpc_id"`
+	DefaultTagIds             types.Set    `tfsdk:"default_tag_ids"`
+	IgnoreTagIds              types.Set    `tfsdk:"ignore_tag_ids"`
 }
 
 type xplatProvider struct {
@@ -55,7 +84,7 @@ func (x *xplatProvider) Schema(ctx context.Context, request provider.SchemaReque
 		Description: "",
 		Attributes: map[string]schema.Attribute{
 			"region": schema.StringAttribute{
-				Description: "The region to use (VN/HAN | VN/SGN | JP/JCSI2)",
+				Description: "The region to use (" + strings.Join(common.RegionCodes(), " | ") + "). Alternatively, this can also be specified using `FPTCLOUD_REGION` environment variable.",
 				Optional:    true,
 			},
 
@@ -74,9 +103,92 @@ func (x *xplatProvider) Schema(ctx context.Context, request provider.SchemaReque
 				Optional:    true,
 			},
 
+			"profile": schema.StringAttribute{
+				Description: "Name of the profile of the shared config file (`~/.fptcloud/config`, or `FPTCLOUD_CONFIG_FILE`) providing the credentials not set in the provider configuration or the environment. Alternatively, this can also be specified using `FPTCLOUD_PROFILE` environment variable. Defaults to the `default` profile when it exists.",
+				Optional:    true,
+			},
+
 			"timeout": schema.Int64Attribute{
-				Description: "Timeout in minutes (optional)",
+				Description: "Timeout in minutes of the API requests and of the resource operations without a `timeouts` block, 15 by default. Alternatively, this can also be specified using `FPTCLOUD_TIMEOUT` environment variable.",
+				Optional:    true,
+			},
+
+			"max_retries": schema.Int64Attribute{
+				Description: "Maximum number of retries for throttled or transiently failing API requests. Alternatively, this can also be specified using `FPTCLOUD_MAX_RETRIES` environment variable.",
+				Optional:    true,
+			},
+
+			"retry_max_wait": schema.Int64Attribute{
+				Description: "Maximum wait in seconds between two retries of an API request. Alternatively, this can also be specified using `FPTCLOUD_RETRY_MAX_WAIT` environment variable.",
+				Optional:    true,
+			},
+
+			"ca_cert_file": schema.StringAttribute{
+				Description: "Path to a PEM encoded CA bundle trusted in addition to the system roots. Alternatively, this can also be specified using `FPTCLOUD_CA_CERT_FILE` environment variable.",
+				Optional:    true,
+			},
+
+			"ca_cert_pem": schema.StringAttribute{
+				Description: "PEM encoded CA bundle trusted in addition to the system roots.",
+				Optional:    true,
+			},
+
+			"client_cert_file": schema.StringAttribute{
+				Description: "Path to a PEM encoded client certificate for mutual TLS. Alternatively, this can also be specified using `FPTCLOUD_CLIENT_CERT_FILE` environment variable.",
+				Optional:    true,
+			},
+
+			"client_cert_pem": schema.StringAttribute{
+				Description: "PEM encoded client certificate for mutual TLS.",
+				Optional:    true,
+			},
+
+			"client_key_file": schema.StringAttribute{
+				Description: "Path to the PEM encoded private key of the client certificate. Alternatively, this can also be specified using `FPTCLOUD_CLIENT_KEY_FILE` environment variable.",
+				Optional:    true,
+			},
+
+			"client_key_pem": schema.StringAttribute{
+				Description: "PEM encoded private key of the client certificate.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+
+			"insecure_skip_verify": schema.BoolAttribute{
+				Description: "Skip TLS certificate verification of the API endpoint. Only use this for lab endpoints. Alternatively, this can also be specified using `FPTCLOUD_INSECURE_SKIP_VERIFY` environment variable.",
+				Optional:    true,
+			},
+
+			"proxy_url": schema.StringAttribute{
+				Description: "URL of the proxy used to reach the API. When unset, the standard `HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY` environment variables apply. Alternatively, this can also be specified using `FPTCLOUD_PROXY_URL` environment variable.",
+				Optional:    true,
+			},
+
+			"read_only": schema.BoolAttribute{
+				Description: "Refuse every request that may change the infrastructure, such as creating, updating or deleting a resource, so that plans and data sources can run with production credentials safely. Alternatively, this can also be specified using `FPTCLOUD_READ_ONLY` environment variable.",
+				Optional:    true,
+			},
+
+			"skip_credentials_validation": schema.BoolAttribute{
+				Description: "Configure the provider without checking the token, the tenant_name and the region against the API. Alternatively, this can also be specified using `FPTCLOUD_SKIP_CREDENTIALS_VALIDATION` environment variable.",
+				Optional:    true,
+			},
+
+			"vpc_id": schema.StringAttribute{
+				Description: "The VPC id used by the resources and data sources without their own `vpc_id`. Alternatively, this can also be specified using `FPTCLOUD_VPC_ID` environment variable.",
+				Optional:    true,
+			},
+
+			"default_tag_ids": schema.SetAttribute{
+				Description: "Tag IDs applied to every taggable resource in addition to its own `tag_ids`. They are left out of the `tag_ids` of the resources unless configured there, and tracked in their `tag_ids_all`: a resource missing one of them is updated by the next apply.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+
+			"ignore_tag_ids": schema.SetAttribute{
+				Description: "Tag IDs applied outside of Terraform. They are kept when the tags of a resource are updated and left out of its `tag_ids`, so that they don't show as drift.",
 				Optional:    true,
+				ElementType: types.StringType,
 			},
 		},
 	}
@@ -84,82 +196,117 @@ func (x *xplatProvider) Schema(ctx context.Context, request provider.SchemaReque
 
 func (x *xplatProvider) Configure(ctx context.Context, request provider.ConfigureRequest, response *provider.ConfigureResponse) {
 	tflog.Info(ctx, "Configuring FPTCloud client")
-	var config xplatProviderModel
+	var model xplatProviderModel
 
-	diags := request.Config.Get(ctx, &config)
+	diags := request.Config.Get(ctx, &model)
 	response.Diagnostics.Append(diags...)
 	if response.Diagnostics.HasError() {
 		return
 	}
-	token := os.Getenv("FPTCLOUD_TOKEN")
-	region := os.Getenv("FPTCLOUD_REGION")
-	tenantName := os.Getenv("FPTCLOUD_TENANT_NAME")
-	apiEndpoint := os.Getenv("FPTCLOUD_API_URL")
-	var timeout int = 5
-
-	if !config.Token.IsNull() {
-		token = config.Token.ValueString()
-	}
-
-	if !config.Region.IsNull() {
-		region = config.Region.ValueString()
-	}
-
-	if !config.TenantName.IsNull() {
-		tenantName = config.TenantName.ValueString()
-	}
-
-	if !config.ApiEndpoint.IsNull() {
-		apiEndpoint = config.ApiEndpoint.ValueString()
-	}
 
-	if !config.Timeout.IsNull() {
-		timeout = int(config.Timeout.ValueInt64())
+	settings, errs := config.Resolve(model.config(ctx, &response.Diagnostics))
+	for _, err := range errs {
+		response.Diagnostics.AddAttributeError(path.Root(err.Attribute), err.Summary, err.Detail)
 	}
-
-	if apiEndpoint == "" {
-		apiEndpoint = ProdAPI
-	}
-
-	if token == "" {
-		response.Diagnostics.AddAttributeError(
-			path.Root("token"),
-			"Missing token",
-			"Token must be specified to authenticate to provision resources",
-		)
-	}
-
 	if response.Diagnostics.HasError() {
 		return
 	}
 
-	ctx = tflog.SetField(ctx, "token", token)
+	ctx = tflog.SetField(ctx, "token", settings.Token)
 	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "token")
 	tflog.Debug(ctx, "Creating FPTCloud client")
 
-	client, err := common.NewClientWithURL(token, apiEndpoint, region, tenantName, timeout)
-
+	client, err := config.SharedClient(settings, common.Component{
+		Name:    "terraform-provider-fptcloud",
+		Version: ProviderVersion,
+	})
 	if err != nil {
 		response.Diagnostics.AddError("Error creating client", err.Error())
 		return
 	}
 
-	userAgent := &common.Component{
-		Name:    "terraform-provider-fptcloud",
-		Version: ProviderVersion,
+	if !settings.SkipCredentialsValidation {
+		for _, err := range validateCredentials(ctx, client) {
+			response.Diagnostics.AddAttributeError(path.Root(err.Attribute), err.Summary, err.Detail)
+		}
+		if response.Diagnostics.HasError() {
+			return
+		}
 	}
-	client.SetUserAgent(userAgent)
 
 	response.DataSourceData = client
+	response.EphemeralResourceData = client
 	response.ResourceData = client
 
 	tflog.Info(ctx, "Configured FPTCloud client", map[string]any{
 		"success":      true,
-		"api_endpoint": apiEndpoint,
-		"tenant_name":  tenantName,
+		"api_endpoint": settings.ApiEndpoint,
+		"tenant_name":  settings.TenantName,
 	})
 }
 
+// config returns the provider configuration of the model, unknown values being unset as with the SDKv2 provider
+func (m xplatProviderModel) config(ctx context.Context, diags *diag.Diagnostics) config.Config {
+	return config.Config{
+		Token:                     stringPointer(m.Token),
+		TenantName:                stringPointer(m.TenantName),
+		Region:                    stringPointer(m.Region),
+		ApiEndpoint:               stringPointer(m.ApiEndpoint),
+		Profile:                   stringPointer(m.Profile),
+		Timeout:                   intPointer(m.Timeout),
+		MaxRetries:                intPointer(m.MaxRetries),
+		RetryMaxWait:              intPointer(m.RetryMaxWait),
+		CACertFile:                stringPointer(m.CACertFile),
+		CACertPEM:                 stringPointer(m.CACertPEM),
+		ClientCertFile:            stringPointer(m.ClientCertFile),
+		ClientCertPEM:             stringPointer(m.ClientCertPEM),
+		ClientKeyFile:             stringPointer(m.ClientKeyFile),
+		ClientKeyPEM:              stringPointer(m.ClientKeyPEM),
+		InsecureSkipVerify:        boolPointer(m.InsecureSkipVerify),
+		ProxyURL:                  stringPointer(m.ProxyURL),
+		ReadOnly:                  boolPointer(m.ReadOnly),
+		SkipCredentialsValidation: boolPointer(m.SkipCredentialsValidation),
+		VpcId:                     stringPointer(m.VpcId),
+		DefaultTagIds:             stringSet(ctx, m.DefaultTagIds, diags),
+		IgnoreTagIds:              stringSet(ctx, m.IgnoreTagIds, diags),
+	}
+}
+
+// stringPointer returns the value of a String attribute, nil when unset or unknown
+func stringPointer(value types.String) *string {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	return value.ValueStringPointer()
+}
+
+// boolPointer returns the value of a Bool attribute, nil when unset or unknown
+func boolPointer(value types.Bool) *bool {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	return value.ValueBoolPointer()
+}
+
+// intPointer returns the value of an Int64 attribute as an int, nil when unset or unknown
+func intPointer(value types.Int64) *int {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	result := int(value.ValueInt64())
+	return &result
+}
+
+// stringSet returns the elements of a set of strings attribute, nil when unset or unknown
+func stringSet(ctx context.Context, value types.Set, diags *diag.Diagnostics) []string {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	var result []string
+	diags.Append(value.ElementsAs(ctx, &result, false)...)
+	return result
+}
+
 func (x *xplatProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		fptcloud_dfke.NewDataSourceDedicatedKubernetesEngine,
@@ -176,5 +323,23 @@ func (x *xplatProvider) Resources(ctx context.Context) []func() resource.Resourc
 		fptcloud_mfke.NewResourceManagedKubernetesEngine,
 		fptcloud_database.NewResourceDatabase,
 		fptcloud_database.NewResourceDatabaseStatus,
+		fptcloud_instance.NewResourceInstance,
+		fptcloud_ssh.NewResourceSSHKey,
+	}
+}
+
+func (x *xplatProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		fptcloud_mfke_kubeconfig.NewEphemeralMfkeKubeconfig,
+		fptcloud_object_storage.NewEphemeralObjectStorageCredentials,
+	}
+}
+
+func (x *xplatProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		fptcloud_mfke.NewFunctionMfkeClusterSlug,
+		fptcloud_subnet.NewFunctionIpRangeContains,
+		fptcloud_subnet.NewFunctionSubnetStaticPool,
+		common.NewFunctionParseImportId,
 	}
 }
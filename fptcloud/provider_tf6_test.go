@@ -0,0 +1,239 @@
+package fptcloud
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	common "terraform-provider-fptcloud/commons"
+	"terraform-provider-fptcloud/commons/config"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+// sdkv2States are states written by the SDKv2 resources migrated to the framework
+var sdkv2States = map[string]string{
+	"fptcloud_instance": `{
+		"id": "instance-id",
+		"vpc_id": "vpc-id",
+		"name": "web",
+		"status": "POWERED_ON",
+		"private_ip": "",
+		"public_ip": "",
+		"flavor_name": "2C4G",
+		"image_name": "Ubuntu-22.04",
+		"subnet_id": "subnet-id",
+		"storage_size_gb": 40,
+		"storage_policy_id": "policy-id",
+		"security_group_ids": ["security-group-id"],
+		"instance_group_id": "",
+		"ssh_key": "ssh-ed25519 AAAA",
+		"password": null,
+		"created_at": "2026-01-02T03:04:05Z",
+		"tag_ids": [],
+		"timeouts": null
+	}`,
+	"fptcloud_ssh_key": `{"id": "ssh-key-id", "name": "laptop", "public_key": "ssh-ed25519 AAAA"}`,
+}
+
+// TestMuxedProvider tests that the SDKv2 and the framework provider servers serve distinct resources
+func TestMuxedProvider(t *testing.T) {
+	ctx := context.Background()
+	muxServer, err := tf5muxserver.NewMuxServer(ctx,
+		providerserver.NewProtocol5(NewXplatProvider("test")()),
+		Provider().GRPCProvider,
+	)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	schemas, err := muxServer.ProviderServer().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	assert.NoError(t, err)
+	assert.Empty(t, schemas.Diagnostics)
+	for name := range sdkv2States {
+		assert.Contains(t, schemas.ResourceSchemas, name)
+		assert.NotContains(t, Provider().ResourcesMap, name)
+	}
+	assert.Contains(t, schemas.EphemeralResourceSchemas, "fptcloud_mfke_kubeconfig")
+	assert.Contains(t, schemas.EphemeralResourceSchemas, "fptcloud_object_storage_credentials")
+	for _, name := range []string{"mfke_cluster_slug", "ip_range_contains", "subnet_static_pool", "parse_import_id"} {
+		assert.Contains(t, schemas.Functions, name)
+	}
+}
+
+// TestMigratedResourcesReadSDKv2State tests that the framework resources read the state of their SDKv2 version as is
+func TestMigratedResourcesReadSDKv2State(t *testing.T) {
+	ctx := context.Background()
+	server := providerserver.NewProtocol5(NewXplatProvider("test")())()
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	for name, state := range sdkv2States {
+		t.Run(name, func(t *testing.T) {
+			resourceSchema := schemas.ResourceSchemas[name]
+			if !assert.NotNil(t, resourceSchema) {
+				return
+			}
+			assert.Zero(t, resourceSchema.Version)
+
+			response, err := server.UpgradeResourceState(ctx, &tfprotov5.UpgradeResourceStateRequest{
+				TypeName: name,
+				Version:  0,
+				RawState: &tfprotov5.RawState{JSON: []byte(state)},
+			})
+			if !assert.NoError(t, err) || !assert.Empty(t, response.Diagnostics) {
+				return
+			}
+
+			upgraded, err := response.UpgradedState.Unmarshal(resourceSchema.ValueType())
+			assert.NoError(t, err)
+			expected, err := tftypes.ValueFromJSON([]byte(state), resourceSchema.ValueType())
+			assert.NoError(t, err)
+			assert.True(t, upgraded.Equal(expected), upgraded.String())
+		})
+	}
+}
+
+// TestProviderFunctions tests the provider functions against the helpers of the resources
+func TestProviderFunctions(t *testing.T) {
+	ctx := context.Background()
+	muxServer, err := tf5muxserver.NewMuxServer(ctx,
+		providerserver.NewProtocol5(NewXplatProvider("test")()),
+		Provider().GRPCProvider,
+	)
+	if !assert.NoError(t, err) {
+		return
+	}
+	server := muxServer.ProviderServer()
+	pool := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"start": tftypes.String, "end": tftypes.String}}
+	importId := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"vpc_id": tftypes.String, "id_or_name": tftypes.String}}
+
+	tests := []struct {
+		name      string
+		function  string
+		arguments []string
+		expected  tftypes.Value
+		errorArg  *int64
+	}{
+		{"slug", "mfke_cluster_slug", []string{"prod", "abc123"}, tftypes.NewValue(tftypes.String, "prod-abc123"), nil},
+		{"slug already suffixed", "mfke_cluster_slug", []string{"prod-abc123", "abc123"}, tftypes.NewValue(tftypes.String, "prod-abc123"), nil},
+		{"in pool", "ip_range_contains", []string{"10.0.0.2-10.0.0.254", "10.0.0.254"}, tftypes.NewValue(tftypes.Bool, true), nil},
+		{"out of pool", "ip_range_contains", []string{"10.0.0.2-10.0.0.254", "10.0.0.1"}, tftypes.NewValue(tftypes.Bool, false), nil},
+		{"in cidr", "ip_range_contains", []string{"10.0.0.0/24", "10.0.0.10"}, tftypes.NewValue(tftypes.Bool, true), nil},
+		{"out of cidr", "ip_range_contains", []string{"10.0.0.0/24", "10.0.1.10"}, tftypes.NewValue(tftypes.Bool, false), nil},
+		{"invalid ip", "ip_range_contains", []string{"10.0.0.0/24", "10.0.0"}, tftypes.Value{}, nil},
+		{"static pool", "subnet_static_pool", []string{"10.0.0.2-10.0.0.254"}, tftypes.NewValue(pool, map[string]tftypes.Value{
+			"start": tftypes.NewValue(tftypes.String, "10.0.0.2"),
+			"end":   tftypes.NewValue(tftypes.String, "10.0.0.254"),
+		}), nil},
+		{"reversed static pool", "subnet_static_pool", []string{"10.0.0.254-10.0.0.2"}, tftypes.Value{}, new(int64)},
+		{"import id", "parse_import_id", []string{"vpc/name/with/slashes"}, tftypes.NewValue(importId, map[string]tftypes.Value{
+			"vpc_id":     tftypes.NewValue(tftypes.String, "vpc"),
+			"id_or_name": tftypes.NewValue(tftypes.String, "name/with/slashes"),
+		}), nil},
+		{"import id alone", "parse_import_id", []string{"id"}, tftypes.NewValue(importId, map[string]tftypes.Value{
+			"vpc_id":     tftypes.NewValue(tftypes.String, nil),
+			"id_or_name": tftypes.NewValue(tftypes.String, "id"),
+		}), nil},
+		{"invalid import id", "parse_import_id", []string{"vpc/"}, tftypes.Value{}, new(int64)},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			arguments := make([]*tfprotov5.DynamicValue, 0, len(test.arguments))
+			for _, argument := range test.arguments {
+				value, err := tfprotov5.NewDynamicValue(tftypes.String, tftypes.NewValue(tftypes.String, argument))
+				if !assert.NoError(t, err) {
+					return
+				}
+				arguments = append(arguments, &value)
+			}
+
+			response, err := server.CallFunction(ctx, &tfprotov5.CallFunctionRequest{Name: test.function, Arguments: arguments})
+			if !assert.NoError(t, err) {
+				return
+			}
+			if test.expected.Type() == nil {
+				if assert.NotNil(t, response.Error) {
+					assert.Equal(t, test.errorArg, response.Error.FunctionArgument, response.Error.Text)
+				}
+				return
+			}
+			if !assert.Nil(t, response.Error) {
+				return
+			}
+			result, err := response.Result.Unmarshal(test.expected.Type())
+			assert.NoError(t, err)
+			assert.True(t, result.Equal(test.expected), result.String())
+		})
+	}
+}
+
+// TestProviderConfigUnknownValues tests that both provider servers leave the provider attributes unknown at plan time
+// unset, so that they resolve alike from the environment
+func TestProviderConfigUnknownValues(t *testing.T) {
+	t.Setenv(common.ConfigFileEnvVar, filepath.Join(t.TempDir(), "missing"))
+	t.Setenv("FPTCLOUD_TOKEN", "env_token")
+	t.Setenv("FPTCLOUD_TENANT_NAME", "env_tenant_name")
+	t.Setenv("FPTCLOUD_REGION", "VN/HAN")
+	t.Setenv("FPTCLOUD_TIMEOUT", "5")
+	t.Setenv("FPTCLOUD_READ_ONLY", "true")
+	t.Setenv("FPTCLOUD_VPC_ID", "env_vpc_id")
+	unknown := []string{"token", "region", "vpc_id", "timeout", "read_only", "default_tag_ids"}
+	known := map[string]string{"tenant_name": "example_tenant_name"}
+
+	ctx := context.Background()
+	var schemaResponse provider.SchemaResponse
+	NewXplatProvider("test")().Schema(ctx, provider.SchemaRequest{}, &schemaResponse)
+	objectType := schemaResponse.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+	for _, name := range unknown {
+		attributes[name] = tftypes.NewValue(objectType.AttributeTypes[name], tftypes.UnknownValue)
+	}
+	for name, value := range known {
+		attributes[name] = tftypes.NewValue(tftypes.String, value)
+	}
+
+	var model xplatProviderModel
+	var diags diag.Diagnostics
+	diags.Append(tfsdk.Config{Schema: schemaResponse.Schema, Raw: tftypes.NewValue(objectType, attributes)}.Get(ctx, &model)...)
+	frameworkSettings, frameworkErrs := config.Resolve(model.config(ctx, &diags))
+	assert.Empty(t, diags)
+	assert.Empty(t, frameworkErrs)
+
+	block := schema.InternalMap(Provider().Schema).CoreConfigSchema()
+	values := make(map[string]cty.Value, len(block.Attributes))
+	for name, attribute := range block.Attributes {
+		values[name] = cty.NullVal(attribute.Type)
+	}
+	for _, name := range unknown {
+		values[name] = cty.UnknownVal(block.Attributes[name].Type)
+	}
+	for name, value := range known {
+		values[name] = cty.StringVal(value)
+	}
+	sdkv2Settings, sdkv2Errs := config.Resolve(providerConfig(cty.ObjectVal(values)))
+	assert.Empty(t, sdkv2Errs)
+
+	assert.Equal(t, sdkv2Settings, frameworkSettings)
+	if assert.NotNil(t, frameworkSettings) {
+		assert.Equal(t, "env_token", frameworkSettings.Token)
+		assert.Equal(t, "example_tenant_name", frameworkSettings.TenantName)
+		assert.Equal(t, 5, frameworkSettings.Timeout)
+		assert.True(t, frameworkSettings.ReadOnly)
+		assert.Equal(t, "env_vpc_id", frameworkSettings.VpcId)
+	}
+}
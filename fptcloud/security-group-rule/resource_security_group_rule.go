@@ -2,12 +2,13 @@ package fptcloud_security_group_rule
 
 import (
 	"context"
+	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
 	common "terraform-provider-fptcloud/commons"
+	"terraform-provider-fptcloud/commons/waiter"
 	"time"
 )
 
@@ -93,7 +94,7 @@ func ResourceSecurityGroupRule() *schema.Resource {
 		ReadContext:   resourceSecurityGroupRuleRead,
 		DeleteContext: resourceSecurityGroupRuleDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: common.ImportVpcResource("<vpc_id>/<id>", resourceSecurityGroupRuleImport),
 		},
 	}
 }
@@ -144,7 +145,7 @@ func resourceSecurityGroupRuleCreate(ctx context.Context, d *schema.ResourceData
 		createdModel.SecurityGroupId = securityGroupId.(string)
 	}
 
-	securityGroupRuleId, err := securityGroupRuleService.Create(vpcId.(string), createdModel)
+	securityGroupRuleId, err := securityGroupRuleService.Create(ctx, vpcId.(string), createdModel)
 	if err != nil {
 		return diag.Errorf("[ERR] Failed to create security group rule: %s", err)
 	}
@@ -156,22 +157,22 @@ func resourceSecurityGroupRuleCreate(ctx context.Context, d *schema.ResourceData
 	}
 
 	// Waiting for status active
-	createStateConf := &retry.StateChangeConf{
-		Pending: []string{"PENDING", "UPDATING"},
-		Target:  []string{"REALIZED", "ACTIVE"},
-		Refresh: func() (interface{}, string, error) {
-			resp, err := securityGroupRuleService.Find(vpcId.(string), securityGroupRuleId)
+	createWaiter := &waiter.StateWaiter[*SecurityGroupRule]{
+		Description: fmt.Sprintf("security group rule %s to be created", securityGroupRuleId),
+		Pending:     []string{"PENDING", "UPDATING"},
+		Target:      []string{"REALIZED", "ACTIVE"},
+		Refresh: func(ctx context.Context) (*SecurityGroupRule, string, error) {
+			resp, err := securityGroupRuleService.Find(ctx, vpcId.(string), securityGroupRuleId)
 			if err != nil {
-				return 0, "", common.DecodeError(err)
+				return nil, "", err
 			}
 			return resp, resp.Status, nil
 		},
-		Timeout:        time.Duration(apiClient.Timeout) * time.Minute,
+		Timeout:        apiClient.DefaultTimeout(),
 		Delay:          3 * time.Second,
-		MinTimeout:     3 * time.Second,
 		NotFoundChecks: 120,
 	}
-	_, err = createStateConf.WaitForStateContext(ctx)
+	_, err = createWaiter.Wait(ctx)
 	if err != nil {
 		return diag.Errorf("[Error] Waiting for security group rule (%s) to be created: %s", d.Id(), err)
 	}
@@ -180,7 +181,7 @@ func resourceSecurityGroupRuleCreate(ctx context.Context, d *schema.ResourceData
 }
 
 // function to read a security group rule
-func resourceSecurityGroupRuleRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceSecurityGroupRuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	apiClient := m.(*common.Client)
 	securityGroupRuleService := NewSecurityGroupRuleService(apiClient)
 
@@ -194,8 +195,13 @@ func resourceSecurityGroupRuleRead(_ context.Context, d *schema.ResourceData, m
 		return diag.Errorf("[ERR] Security group id is required")
 	}
 
-	foundSecurityGroupRule, err := securityGroupRuleService.Find(vpcId.(string), securityGroupRuleId.(string))
+	foundSecurityGroupRule, err := securityGroupRuleService.Find(ctx, vpcId.(string), securityGroupRuleId.(string))
 	if err != nil {
+		if common.IsNotFound(err) {
+			log.Printf("[WARN] Security group rule %s not found, removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return diag.Errorf("[ERR] Failed to retrieve security group rule: %s", err)
 	}
 
@@ -233,8 +239,17 @@ func resourceSecurityGroupRuleRead(_ context.Context, d *schema.ResourceData, m
 	return nil
 }
 
+// function to import a security group rule by id, as rules have no name
+func resourceSecurityGroupRuleImport(ctx context.Context, _ *schema.ResourceData, m interface{}, vpcId string, ref string) (string, error) {
+	foundSecurityGroupRule, err := NewSecurityGroupRuleService(m.(*common.Client)).Find(ctx, vpcId, ref)
+	if err != nil {
+		return "", err
+	}
+	return foundSecurityGroupRule.ID, nil
+}
+
 // function to delete a security group rule
-func resourceSecurityGroupRuleDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceSecurityGroupRuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	apiClient := m.(*common.Client)
 	securityGroupRuleService := NewSecurityGroupRuleService(apiClient)
 
@@ -246,28 +261,26 @@ func resourceSecurityGroupRuleDelete(_ context.Context, d *schema.ResourceData,
 		return diag.Errorf("[ERR] Vpc id is required")
 	}
 
-	_, err := securityGroupRuleService.Delete(vpcId.(string), d.Id())
+	_, err := securityGroupRuleService.Delete(ctx, vpcId.(string), d.Id())
 	if err != nil {
 		return diag.Errorf("[ERR] An error occurred while trying to delete the security group rule %s", err)
 	}
 
-	deleteStateConf := &retry.StateChangeConf{
-		Pending: []string{"DELETING"},
-		Target:  []string{"SUCCESS"},
-		Refresh: func() (interface{}, string, error) {
-			resp, err := securityGroupRuleService.Find(vpcId.(string), d.Id())
+	deleteWaiter := &waiter.StateWaiter[*SecurityGroupRule]{
+		Description: fmt.Sprintf("security group rule %s to be deleted", d.Id()),
+		Pending:     []string{"DELETING"},
+		Refresh: func(ctx context.Context) (*SecurityGroupRule, string, error) {
+			resp, err := securityGroupRuleService.Find(ctx, vpcId.(string), d.Id())
 			if err != nil {
-				return 1, "SUCCESS", nil
+				return nil, "", err
 			}
-
 			return resp, resp.Status, nil
 		},
-		Timeout:        time.Duration(apiClient.Timeout) * time.Minute,
-		Delay:          3 * time.Second,
-		MinTimeout:     3 * time.Second,
-		NotFoundChecks: 120,
+		Timeout:          apiClient.DefaultTimeout(),
+		Delay:            3 * time.Second,
+		NotFoundIsTarget: true,
 	}
-	_, err = deleteStateConf.WaitForStateContext(context.Background())
+	_, err = deleteWaiter.Wait(ctx)
 	if err != nil {
 		return diag.Errorf("[Error] Waiting for security group rule (%s) to be deleted: %s", d.Id(), err)
 	}
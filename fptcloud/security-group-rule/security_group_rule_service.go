@@ -1,6 +1,7 @@
 package fptcloud_security_group_rule
 
 import (
+	"context"
 	"encoding/json"
 	common "terraform-provider-fptcloud/commons"
 )
@@ -35,9 +36,9 @@ type CreateSecurityGroupRuleDto struct {
 
 // SecurityGroupRuleService defines the interface for security group rule service
 type SecurityGroupRuleService interface {
-	Find(vpcId string, ruleId string) (*SecurityGroupRule, error)
-	Create(vpcId string, createdModel CreateSecurityGroupRuleDto) (string, error)
-	Delete(vpcId string, ruleId string) (*common.SimpleResponse, error)
+	Find(ctx context.Context, vpcId string, ruleId string) (*SecurityGroupRule, error)
+	Create(ctx context.Context, vpcId string, createdModel CreateSecurityGroupRuleDto) (string, error)
+	Delete(ctx context.Context, vpcId string, ruleId string) (*common.SimpleResponse, error)
 }
 
 // SecurityGroupRuleServiceImpl is the implementation of SecurityGroupRuleService
@@ -51,9 +52,9 @@ func NewSecurityGroupRuleService(client *common.Client) SecurityGroupRuleService
 }
 
 // Find search security group rule by id
-func (s *SecurityGroupRuleServiceImpl) Find(vpcId string, ruleId string) (*SecurityGroupRule, error) {
+func (s *SecurityGroupRuleServiceImpl) Find(ctx context.Context, vpcId string, ruleId string) (*SecurityGroupRule, error) {
 	var apiPath = common.ApiPath.SecurityGroupRule(vpcId, ruleId)
-	resp, err := s.client.SendGetRequest(apiPath)
+	resp, err := s.client.SendGetRequestWithContext(ctx, apiPath)
 	if err != nil {
 		return nil, common.DecodeError(err)
 	}
@@ -68,9 +69,9 @@ func (s *SecurityGroupRuleServiceImpl) Find(vpcId string, ruleId string) (*Secur
 }
 
 // Create created a new security group rule
-func (s *SecurityGroupRuleServiceImpl) Create(vpcId string, createdModel CreateSecurityGroupRuleDto) (string, error) {
+func (s *SecurityGroupRuleServiceImpl) Create(ctx context.Context, vpcId string, createdModel CreateSecurityGroupRuleDto) (string, error) {
 	var apiPath = common.ApiPath.CreateSecurityGroupRule(vpcId)
-	resp, err := s.client.SendPostRequest(apiPath, createdModel)
+	resp, err := s.client.SendPostRequestWithContext(ctx, apiPath, createdModel)
 	if err != nil {
 		return "", common.DecodeError(err)
 	}
@@ -89,9 +90,9 @@ func (s *SecurityGroupRuleServiceImpl) Create(vpcId string, createdModel CreateS
 }
 
 // Delete deleted a security group rule
-func (s *SecurityGroupRuleServiceImpl) Delete(vpcId string, ruleId string) (*common.SimpleResponse, error) {
+func (s *SecurityGroupRuleServiceImpl) Delete(ctx context.Context, vpcId string, ruleId string) (*common.SimpleResponse, error) {
 	var apiPath = common.ApiPath.SecurityGroupRule(vpcId, ruleId)
-	_, err := s.client.SendDeleteRequest(apiPath)
+	_, err := s.client.SendDeleteRequestWithContext(ctx, apiPath)
 	if err != nil {
 		return nil, common.DecodeError(err)
 	}
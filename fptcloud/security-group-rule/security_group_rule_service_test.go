@@ -1,6 +1,7 @@
 package fptcloud_security_group_rule_test
 
 import (
+	"context"
 	"terraform-provider-fptcloud/fptcloud/security-group-rule"
 	"testing"
 
@@ -31,7 +32,7 @@ func TestFindSecurityGroupRule_ReturnsSecurityGroupRule(t *testing.T) {
 	})
 	defer server.Close()
 	service := fptcloud_security_group_rule.NewSecurityGroupRuleService(mockClient)
-	rule, err := service.Find("vpc_id", "622ae917-febe-42b3-91c4-724d92466519")
+	rule, err := service.Find(context.Background(), "vpc_id", "622ae917-febe-42b3-91c4-724d92466519")
 	assert.NoError(t, err)
 	assert.NotNil(t, rule)
 	assert.Equal(t, "622ae917-febe-42b3-91c4-724d92466519", rule.ID)
@@ -54,7 +55,7 @@ func TestFindSecurityGroupRule_ReturnsErrorOnRequestFailure(t *testing.T) {
 	})
 	defer server.Close()
 	service := fptcloud_security_group_rule.NewSecurityGroupRuleService(mockClient)
-	rule, err := service.Find("vpc_id", "rule_id")
+	rule, err := service.Find(context.Background(), "vpc_id", "rule_id")
 	assert.Error(t, err)
 	assert.Nil(t, rule)
 }
@@ -75,7 +76,7 @@ func TestCreateSecurityGroupRule_ReturnsSecurityGroupRuleId(t *testing.T) {
 		Description:     nil,
 		SecurityGroupId: "",
 	}
-	ruleId, err := service.Create("vpc_id", createModel)
+	ruleId, err := service.Create(context.Background(), "vpc_id", createModel)
 	assert.NoError(t, err)
 	assert.Equal(t, "security_group_rule_id", ruleId)
 }
@@ -86,7 +87,7 @@ func TestDeleteSecurityGroupRule_ReturnsSuccess(t *testing.T) {
 	})
 	defer server.Close()
 	service := fptcloud_security_group_rule.NewSecurityGroupRuleService(mockClient)
-	response, err := service.Delete("vpc_id", "rule_id")
+	response, err := service.Delete(context.Background(), "vpc_id", "rule_id")
 	assert.NoError(t, err)
 	assert.NotNil(t, response)
 	assert.Equal(t, "Successfully", response.Data)
@@ -22,7 +22,7 @@ func DataSourceSecurityGroup() *schema.Resource {
 	}
 }
 
-func dataSourceSecurityGroupRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func dataSourceSecurityGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	apiClient := m.(*common.Client)
 	securityGroupService := NewSecurityGroupService(apiClient)
 
@@ -40,7 +40,7 @@ func dataSourceSecurityGroupRead(_ context.Context, d *schema.ResourceData, m in
 		findSecurityGroupModel.VpcId = vpcId.(string)
 	}
 
-	foundSecurityGroup, err := securityGroupService.Find(findSecurityGroupModel)
+	foundSecurityGroup, err := securityGroupService.Find(ctx, findSecurityGroupModel)
 	if err != nil {
 		return diag.Errorf("[ERR] Failed to retrieve security group: %s", err)
 	}
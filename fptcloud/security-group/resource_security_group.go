@@ -2,11 +2,12 @@ package fptcloud_security_group
 
 import (
 	"context"
+	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"log"
 	common "terraform-provider-fptcloud/commons"
+	"terraform-provider-fptcloud/commons/waiter"
 	"time"
 )
 
@@ -14,14 +15,16 @@ import (
 // This can be used to create, read, update, and delete operations for a security group in the infrastructure.
 func ResourceSecurityGroup() *schema.Resource {
 	return &schema.Resource{
-		Description:   "Provides a Fpt cloud security group which can be attached to an instance in order to firewall.",
-		Schema:        resourceSecurityGroup,
-		CreateContext: resourceSecurityGroupCreate,
-		ReadContext:   resourceSecurityGroupRead,
-		UpdateContext: resourceSecurityGroupUpdate,
-		DeleteContext: resourceSecurityGroupDelete,
+		Description:          "Provides a Fpt cloud security group which can be attached to an instance in order to firewall.",
+		Schema:               resourceSecurityGroup,
+		CreateWithoutTimeout: common.WithResourceTimeout(schema.TimeoutCreate, resourceSecurityGroupCreate),
+		ReadWithoutTimeout:   common.WithResourceTimeout(schema.TimeoutRead, resourceSecurityGroupRead),
+		UpdateWithoutTimeout: common.WithResourceTimeout(schema.TimeoutUpdate, resourceSecurityGroupUpdate),
+		DeleteWithoutTimeout: common.WithResourceTimeout(schema.TimeoutDelete, resourceSecurityGroupDelete),
+		CustomizeDiff:        common.CustomizeDiffTagIdsAll,
+		Timeouts:             common.ResourceTimeouts(),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: common.ImportVpcResource("<vpc_id>/<id> or <vpc_id>/<name>", resourceSecurityGroupImport),
 		},
 	}
 }
@@ -55,9 +58,9 @@ func resourceSecurityGroupCreate(ctx context.Context, d *schema.ResourceData, m
 		createdModel.ApplyTo = applyToList
 	}
 
-	//if tags, ok := d.GetOk("tag_ids"); ok {
-	//	createdModel.TagIds = expandTagIDs(tags.(*schema.Set))
-	//}
+	if tags, ok := d.GetOk("tag_ids"); ok {
+		createdModel.TagIds = common.ExpandTagIds(tags.(*schema.Set))
+	}
 
 	if okVpcId {
 		createdModel.VpcId = vpcId.(string)
@@ -67,7 +70,7 @@ func resourceSecurityGroupCreate(ctx context.Context, d *schema.ResourceData, m
 		return diag.Errorf("[ERR] Subnet id is required")
 	}
 
-	securityGroupId, err := securityGroupService.Create(createdModel)
+	securityGroupId, err := securityGroupService.Create(ctx, createdModel)
 	if err != nil {
 		return diag.Errorf("[ERR] Failed to create security group: %s", err)
 	}
@@ -79,26 +82,26 @@ func resourceSecurityGroupCreate(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	// Waiting for status active
-	createStateConf := &retry.StateChangeConf{
-		Pending: []string{"PENDING", "UPDATING"},
-		Target:  []string{"REALIZED", "ACTIVE"},
-		Refresh: func() (interface{}, string, error) {
+	createWaiter := &waiter.StateWaiter[*SecurityGroup]{
+		Description: fmt.Sprintf("security group %s to be created", securityGroupId),
+		Pending:     []string{"PENDING", "UPDATING"},
+		Target:      []string{"REALIZED", "ACTIVE"},
+		Refresh: func(ctx context.Context) (*SecurityGroup, string, error) {
 			findModel := FindSecurityGroupDTO{
 				ID:    securityGroupId,
 				VpcId: vpcId.(string),
 			}
-			resp, err := securityGroupService.Find(findModel)
+			resp, err := securityGroupService.Find(ctx, findModel)
 			if err != nil {
-				return 0, "", common.DecodeError(err)
+				return nil, "", err
 			}
 			return resp, resp.Status, nil
 		},
-		Timeout:        time.Duration(apiClient.Timeout) * time.Minute,
+		Timeout:        apiClient.ResourceTimeout(d, schema.TimeoutCreate),
 		Delay:          3 * time.Second,
-		MinTimeout:     3 * time.Second,
 		NotFoundChecks: 120,
 	}
-	_, err = createStateConf.WaitForStateContext(ctx)
+	_, err = createWaiter.Wait(ctx)
 	if err != nil {
 		return diag.Errorf("[Error] Waiting for security group (%s) to be created: %s", d.Id(), err)
 	}
@@ -107,7 +110,7 @@ func resourceSecurityGroupCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 // function to read the security group
-func resourceSecurityGroupRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceSecurityGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	apiClient := m.(*common.Client)
 	securityGroupService := NewSecurityGroupService(apiClient)
 
@@ -119,8 +122,13 @@ func resourceSecurityGroupRead(_ context.Context, d *schema.ResourceData, m inte
 		findSecurityGroupModel.VpcId = vpcId.(string)
 	}
 
-	foundSecurityGroup, err := securityGroupService.Find(findSecurityGroupModel)
+	foundSecurityGroup, err := securityGroupService.Find(ctx, findSecurityGroupModel)
 	if err != nil {
+		if common.IsNotFound(err) {
+			log.Printf("[WARN] Security group %s not found, removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return diag.Errorf("[ERR] Failed to retrieve security group: %s", err)
 	}
 
@@ -151,13 +159,35 @@ func resourceSecurityGroupRead(_ context.Context, d *schema.ResourceData, m inte
 		return diag.FromErr(err)
 	}
 
-	if err := d.Set("tag_ids", foundSecurityGroup.TagIds); err != nil {
+	configuredTagIds := common.ExpandTagIds(d.Get("tag_ids").(*schema.Set))
+	if err := d.Set("tag_ids", apiClient.Tags.FilterTagIds(foundSecurityGroup.TagIds, configuredTagIds)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("tag_ids_all", apiClient.Tags.AllTagIds(foundSecurityGroup.TagIds, configuredTagIds)); err != nil {
 		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
+// function to import the security group by id or name
+func resourceSecurityGroupImport(ctx context.Context, _ *schema.ResourceData, m interface{}, vpcId string, ref string) (string, error) {
+	securityGroupService := NewSecurityGroupService(m.(*common.Client))
+
+	foundSecurityGroup, err := common.FindByIdOrName(ctx, ref,
+		func(ctx context.Context, id string) (*SecurityGroup, error) {
+			return securityGroupService.Find(ctx, FindSecurityGroupDTO{ID: id, VpcId: vpcId})
+		},
+		func(ctx context.Context, name string) (*SecurityGroup, error) {
+			return securityGroupService.Find(ctx, FindSecurityGroupDTO{Name: name, VpcId: vpcId})
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+	return foundSecurityGroup.ID, nil
+}
+
 // function to update the security group
 func resourceSecurityGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	apiClient := m.(*common.Client)
@@ -166,18 +196,18 @@ func resourceSecurityGroupUpdate(ctx context.Context, d *schema.ResourceData, m
 	if d.HasChange("type") {
 		return diag.Errorf("[ERR] Security group type can not be changed")
 	}
-	if d.HasChange("subnet_id") {
+	if previous, _ := d.GetChange("subnet_id"); previous != "" && d.HasChange("subnet_id") {
 		return diag.Errorf("[ERR] Security group subnet can not be changed")
 	}
 
 	vpcId := d.Get("vpc_id").(string)
 	hasChangedName := d.HasChange("name")
 	hasChangeApplyTo := d.HasChange("apply_to")
-	//hasChangeTags := d.HasChange("tag_ids")
+	hasChangeTags := d.HasChanges("tag_ids", "tag_ids_all")
 
 	if hasChangedName {
 		newName := d.Get("name").(string)
-		_, err := securityGroupService.Rename(vpcId, d.Id(), newName)
+		_, err := securityGroupService.Rename(ctx, vpcId, d.Id(), newName)
 		if err != nil {
 			return diag.Errorf("[ERR] An error occurred while rename security group %s", err)
 		}
@@ -195,49 +225,48 @@ func resourceSecurityGroupUpdate(ctx context.Context, d *schema.ResourceData, m
 			applyTo = append(applyTo, v.(string))
 		}
 
-		_, err := securityGroupService.UpdateApplyTo(vpcId, d.Id(), applyTo)
+		_, err := securityGroupService.UpdateApplyTo(ctx, vpcId, d.Id(), applyTo)
 		if err != nil {
 			return diag.Errorf("[ERR] An error occurred while changing apply to for security group %s: %s", d.Id(), err)
 		}
 
-		updateStateConf := &retry.StateChangeConf{
-			Pending: []string{"PENDING", "UPDATING"},
-			Target:  []string{"REALIZED", "ACTIVE"},
-			Refresh: func() (interface{}, string, error) {
+		updateWaiter := &waiter.StateWaiter[*SecurityGroup]{
+			Description: fmt.Sprintf("security group %s to be updated", d.Id()),
+			Pending:     []string{"PENDING", "UPDATING"},
+			Target:      []string{"REALIZED", "ACTIVE"},
+			Refresh: func(ctx context.Context) (*SecurityGroup, string, error) {
 				findModel := FindSecurityGroupDTO{
 					ID:    d.Id(),
 					VpcId: vpcId,
 				}
-				resp, err := securityGroupService.Find(findModel)
+				resp, err := securityGroupService.Find(ctx, findModel)
 				if err != nil {
-					return 0, "", common.DecodeError(err)
+					return nil, "", err
 				}
 				return resp, resp.Status, nil
 			},
-			Timeout:        time.Duration(apiClient.Timeout) * time.Minute,
-			Delay:          3 * time.Second,
-			MinTimeout:     3 * time.Second,
-			NotFoundChecks: 120,
+			Timeout: apiClient.ResourceTimeout(d, schema.TimeoutUpdate),
+			Delay:   3 * time.Second,
 		}
-		_, err = updateStateConf.WaitForStateContext(ctx)
+		_, err = updateWaiter.Wait(ctx)
 		if err != nil {
 			return diag.Errorf("[Error] Waiting for security group (%s) to be updated: %s", d.Id(), err)
 		}
 	}
 
-	//if hasChangeTags {
-	//	tagIds := expandTagIDs(d.Get("tag_ids").(*schema.Set))
-	//	_, err := securityGroupService.UpdateTags(vpcId, d.Id(), tagIds)
-	//	if err != nil {
-	//		return diag.Errorf("[ERR] An error occurred while updating security group tags %s", err)
-	//	}
-	//}
+	if hasChangeTags {
+		tagIds := common.ExpandTagIds(d.Get("tag_ids").(*schema.Set))
+		_, err := securityGroupService.UpdateTags(ctx, vpcId, d.Id(), tagIds)
+		if err != nil {
+			return diag.Errorf("[ERR] An error occurred while updating security group tags %s", err)
+		}
+	}
 
 	return resourceSecurityGroupRead(ctx, d, m)
 }
 
 // function to delete the security group
-func resourceSecurityGroupDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceSecurityGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	apiClient := m.(*common.Client)
 	securityGroupService := NewSecurityGroupService(apiClient)
 
@@ -249,44 +278,33 @@ func resourceSecurityGroupDelete(_ context.Context, d *schema.ResourceData, m in
 		return diag.Errorf("[ERR] Vpc id is required")
 	}
 
-	_, err := securityGroupService.Delete(vpcId.(string), d.Id())
+	_, err := securityGroupService.Delete(ctx, vpcId.(string), d.Id())
 	if err != nil {
 		return diag.Errorf("[ERR] Failed to delete security group: %s", err)
 	}
 
-	deleteStateConf := &retry.StateChangeConf{
-		Pending: []string{"DELETING"},
-		Target:  []string{"SUCCESS"},
-		Refresh: func() (interface{}, string, error) {
+	deleteWaiter := &waiter.StateWaiter[*SecurityGroup]{
+		Description: fmt.Sprintf("security group %s to be deleted", d.Id()),
+		Pending:     []string{"DELETING"},
+		Refresh: func(ctx context.Context) (*SecurityGroup, string, error) {
 			findModel := FindSecurityGroupDTO{
 				ID:    d.Id(),
 				VpcId: vpcId.(string),
 			}
-			resp, err := securityGroupService.Find(findModel)
+			resp, err := securityGroupService.Find(ctx, findModel)
 			if err != nil {
-				// If the security group is not found, consider it deleted
-				return 1, "SUCCESS", nil
+				return nil, "", err
 			}
-
 			return resp, resp.Status, nil
 		},
-		Timeout:        time.Duration(apiClient.Timeout) * time.Minute,
-		Delay:          3 * time.Second,
-		MinTimeout:     3 * time.Second,
-		NotFoundChecks: 120,
+		Timeout:          apiClient.ResourceTimeout(d, schema.TimeoutDelete),
+		Delay:            3 * time.Second,
+		NotFoundIsTarget: true,
 	}
-	_, err = deleteStateConf.WaitForStateContext(context.Background())
+	_, err = deleteWaiter.Wait(ctx)
 	if err != nil {
 		return diag.Errorf("[Error] Waiting for security group (%s) to be deleted: %s", d.Id(), err)
 	}
 
 	return nil
 }
-
-//func expandTagIDs(tagSet *schema.Set) []string {
-//	tagIds := make([]string, 0, tagSet.Len())
-//	for _, tag := range tagSet.List() {
-//		tagIds = append(tagIds, tag.(string))
-//	}
-//	return tagIds
-//}
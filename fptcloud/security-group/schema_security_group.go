@@ -3,6 +3,7 @@ package fptcloud_security_group
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	common "terraform-provider-fptcloud/commons"
 	"terraform-provider-fptcloud/commons/utils"
 )
 
@@ -125,6 +126,7 @@ var resourceSecurityGroup = map[string]*schema.Schema{
 		Optional:     true,
 		ValidateFunc: validation.NoZeroValues,
 		Description:  "The subnet id of the security group (required when creating)",
+		// The API does not return the subnet of an imported security group, which is recorded by the next update
 	},
 	"edge_gateway_id": {
 		Type:        schema.TypeString,
@@ -157,4 +159,5 @@ var resourceSecurityGroup = map[string]*schema.Schema{
 		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "List of tag IDs associated with the security group",
 	},
+	"tag_ids_all": common.TagIdsAllSchema(),
 }
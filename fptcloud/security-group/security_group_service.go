@@ -1,6 +1,7 @@
 package fptcloud_security_group
 
 import (
+	"context"
 	"encoding/json"
 	common "terraform-provider-fptcloud/commons"
 	"terraform-provider-fptcloud/commons/utils"
@@ -8,12 +9,12 @@ import (
 
 // SecurityGroupService defines the interface for security service
 type SecurityGroupService interface {
-	Find(searchModel FindSecurityGroupDTO) (*SecurityGroup, error)
-	Create(createdModel CreatedSecurityGroupDTO) (string, error)
-	Delete(vpcId string, securityGroupId string) (*common.SimpleResponse, error)
-	Rename(vpcId string, securityGroupId string, newName string) (*common.SimpleResponse, error)
-	UpdateApplyTo(vpcId string, securityGroupId string, applyTo []string) (*common.SimpleResponse, error)
-	UpdateTags(vpcId string, securityGroupId string, tagIds []string) (*common.SimpleResponse, error)
+	Find(ctx context.Context, searchModel FindSecurityGroupDTO) (*SecurityGroup, error)
+	Create(ctx context.Context, createdModel CreatedSecurityGroupDTO) (string, error)
+	Delete(ctx context.Context, vpcId string, securityGroupId string) (*common.SimpleResponse, error)
+	Rename(ctx context.Context, vpcId string, securityGroupId string, newName string) (*common.SimpleResponse, error)
+	UpdateApplyTo(ctx context.Context, vpcId string, securityGroupId string, applyTo []string) (*common.SimpleResponse, error)
+	UpdateTags(ctx context.Context, vpcId string, securityGroupId string, tagIds []string) (*common.SimpleResponse, error)
 }
 
 // SecurityGroupServiceImpl is the implementation of SecurityGroupService
@@ -27,9 +28,9 @@ func NewSecurityGroupService(client *common.Client) SecurityGroupService {
 }
 
 // Find search security group by id or name
-func (s *SecurityGroupServiceImpl) Find(searchModel FindSecurityGroupDTO) (*SecurityGroup, error) {
+func (s *SecurityGroupServiceImpl) Find(ctx context.Context, searchModel FindSecurityGroupDTO) (*SecurityGroup, error) {
 	var apiPath = common.ApiPath.SecurityGroup(searchModel.VpcId) + utils.ToQueryParams(searchModel)
-	resp, err := s.client.SendGetRequest(apiPath)
+	resp, err := s.client.SendGetRequestWithContext(ctx, apiPath)
 	if err != nil {
 		return nil, common.DecodeError(err)
 	}
@@ -44,9 +45,10 @@ func (s *SecurityGroupServiceImpl) Find(searchModel FindSecurityGroupDTO) (*Secu
 }
 
 // Create created a new security group
-func (s *SecurityGroupServiceImpl) Create(createdModel CreatedSecurityGroupDTO) (string, error) {
+func (s *SecurityGroupServiceImpl) Create(ctx context.Context, createdModel CreatedSecurityGroupDTO) (string, error) {
+	createdModel.TagIds = s.client.Tags.MergeTagIds(createdModel.TagIds, nil)
 	var apiPath = common.ApiPath.SecurityGroup(createdModel.VpcId)
-	resp, err := s.client.SendPostRequest(apiPath, createdModel)
+	resp, err := s.client.SendPostRequestWithContext(ctx, apiPath, createdModel)
 	if err != nil {
 		return "", common.DecodeError(err)
 	}
@@ -65,9 +67,9 @@ func (s *SecurityGroupServiceImpl) Create(createdModel CreatedSecurityGroupDTO)
 }
 
 // Delete deleted a security group
-func (s *SecurityGroupServiceImpl) Delete(vpcId string, securityGroupId string) (*common.SimpleResponse, error) {
+func (s *SecurityGroupServiceImpl) Delete(ctx context.Context, vpcId string, securityGroupId string) (*common.SimpleResponse, error) {
 	var apiPath = common.ApiPath.SecurityGroup(vpcId) + "/" + securityGroupId
-	_, err := s.client.SendDeleteRequest(apiPath)
+	_, err := s.client.SendDeleteRequestWithContext(ctx, apiPath)
 	if err != nil {
 		return nil, common.DecodeError(err)
 	}
@@ -81,9 +83,9 @@ func (s *SecurityGroupServiceImpl) Delete(vpcId string, securityGroupId string)
 }
 
 // Rename update name a security group
-func (s *SecurityGroupServiceImpl) Rename(vpcId string, securityGroupId string, newName string) (*common.SimpleResponse, error) {
+func (s *SecurityGroupServiceImpl) Rename(ctx context.Context, vpcId string, securityGroupId string, newName string) (*common.SimpleResponse, error) {
 	var apiPath = common.ApiPath.RenameSecurityGroup(vpcId, securityGroupId)
-	_, err := s.client.SendPutRequest(apiPath, map[string]string{"new_name": newName})
+	_, err := s.client.SendPutRequestWithContext(ctx, apiPath, map[string]string{"new_name": newName})
 	if err != nil {
 		return nil, common.DecodeError(err)
 	}
@@ -97,9 +99,9 @@ func (s *SecurityGroupServiceImpl) Rename(vpcId string, securityGroupId string,
 }
 
 // UpdateApplyTo update apply to from security group
-func (s *SecurityGroupServiceImpl) UpdateApplyTo(vpcId string, securityGroupId string, applyTo []string) (*common.SimpleResponse, error) {
+func (s *SecurityGroupServiceImpl) UpdateApplyTo(ctx context.Context, vpcId string, securityGroupId string, applyTo []string) (*common.SimpleResponse, error) {
 	var apiPath = common.ApiPath.UpdateApplyToSecurityGroup(vpcId, securityGroupId)
-	_, err := s.client.SendPutRequest(apiPath, map[string]interface{}{"apply_to": applyTo})
+	_, err := s.client.SendPutRequestWithContext(ctx, apiPath, map[string]interface{}{"apply_to": applyTo})
 	if err != nil {
 		return nil, common.DecodeError(err)
 	}
@@ -113,12 +115,23 @@ func (s *SecurityGroupServiceImpl) UpdateApplyTo(vpcId string, securityGroupId s
 }
 
 // UpdateTags updates the tags associated with a security group
-func (s *SecurityGroupServiceImpl) UpdateTags(vpcId string, securityGroupId string, tagIds []string) (*common.SimpleResponse, error) {
+func (s *SecurityGroupServiceImpl) UpdateTags(ctx context.Context, vpcId string, securityGroupId string, tagIds []string) (*common.SimpleResponse, error) {
+	tagIds, err := s.client.Tags.MergeAppliedTagIds(ctx, tagIds, func(ctx context.Context) ([]string, error) {
+		securityGroup, err := s.Find(ctx, FindSecurityGroupDTO{ID: securityGroupId, VpcId: vpcId})
+		if err != nil {
+			return nil, err
+		}
+		return securityGroup.TagIds, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	var apiPath = common.ApiPath.UpdateSecurityGroupTags(vpcId, securityGroupId)
 	payload := map[string][]string{
 		"tag_ids": tagIds,
 	}
-	_, err := s.client.SendPutRequest(apiPath, payload)
+	_, err = s.client.SendPutRequestWithContext(ctx, apiPath, payload)
 	if err != nil {
 		return nil, common.DecodeError(err)
 	}
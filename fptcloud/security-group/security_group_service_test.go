@@ -1,6 +1,7 @@
 package fptcloud_security_group_test
 
 import (
+	"context"
 	"terraform-provider-fptcloud/fptcloud/security-group"
 	"testing"
 
@@ -52,7 +53,7 @@ func TestFindSecurityGroup_ReturnsSecurityGroup(t *testing.T) {
 	defer server.Close()
 	service := fptcloud_security_group.NewSecurityGroupService(mockClient)
 	searchModel := fptcloud_security_group.FindSecurityGroupDTO{VpcId: "vpc_id", Name: "example-security-group"}
-	securityGroup, err := service.Find(searchModel)
+	securityGroup, err := service.Find(context.Background(), searchModel)
 	assert.NoError(t, err)
 	assert.NotNil(t, securityGroup)
 	assert.Equal(t, "87654321-bbbb-cccc-dddd-210987654321", securityGroup.ID)
@@ -67,7 +68,7 @@ func TestFindSecurityGroup_ReturnsErrorOnRequestFailure(t *testing.T) {
 	defer server.Close()
 	service := fptcloud_security_group.NewSecurityGroupService(mockClient)
 	searchModel := fptcloud_security_group.FindSecurityGroupDTO{VpcId: "vpc_id", Name: "security-group-name"}
-	securityGroup, err := service.Find(searchModel)
+	securityGroup, err := service.Find(context.Background(), searchModel)
 	assert.Error(t, err)
 	assert.Nil(t, securityGroup)
 }
@@ -80,7 +81,7 @@ func TestCreateSecurityGroup_ReturnsSecurityGroupId(t *testing.T) {
 	defer server.Close()
 	service := fptcloud_security_group.NewSecurityGroupService(mockClient)
 	createModel := fptcloud_security_group.CreatedSecurityGroupDTO{VpcId: "vpc_id", Name: "security-group-name"}
-	securityGroupId, err := service.Create(createModel)
+	securityGroupId, err := service.Create(context.Background(), createModel)
 	assert.NoError(t, err)
 	assert.Equal(t, "security_group_id", securityGroupId)
 }
@@ -91,7 +92,7 @@ func TestDeleteSecurityGroup_ReturnsSuccess(t *testing.T) {
 	})
 	defer server.Close()
 	service := fptcloud_security_group.NewSecurityGroupService(mockClient)
-	response, err := service.Delete("vpc_id", "security-group-name")
+	response, err := service.Delete(context.Background(), "vpc_id", "security-group-name")
 	assert.NoError(t, err)
 	assert.NotNil(t, response)
 	assert.Equal(t, "Successfully", response.Data)
@@ -103,7 +104,7 @@ func TestRenameSecurityGroup_ReturnsSuccess(t *testing.T) {
 	})
 	defer server.Close()
 	service := fptcloud_security_group.NewSecurityGroupService(mockClient)
-	response, err := service.Rename("vpc_id", "security-group-name", "new-name")
+	response, err := service.Rename(context.Background(), "vpc_id", "security-group-name", "new-name")
 	assert.NoError(t, err)
 	assert.NotNil(t, response)
 	assert.Equal(t, "Successfully", response.Data)
@@ -115,7 +116,7 @@ func TestUpdateApplyToSecurityGroup_ReturnsSuccess(t *testing.T) {
 	})
 	defer server.Close()
 	service := fptcloud_security_group.NewSecurityGroupService(mockClient)
-	response, err := service.UpdateApplyTo("vpc_id", "security_id", []string{"ip"})
+	response, err := service.UpdateApplyTo(context.Background(), "vpc_id", "security_id", []string{"ip"})
 	assert.NoError(t, err)
 	assert.NotNil(t, response)
 	assert.Equal(t, "Successfully", response.Data)
@@ -127,7 +128,7 @@ func TestUpdateSecurityGroupTags_ReturnsSuccess(t *testing.T) {
 	})
 	defer server.Close()
 	service := fptcloud_security_group.NewSecurityGroupService(mockClient)
-	response, err := service.UpdateTags("vpc_id", "security_group_id", []string{"tag-1"})
+	response, err := service.UpdateTags(context.Background(), "vpc_id", "security_group_id", []string{"tag-1"})
 	assert.NoError(t, err)
 	assert.NotNil(t, response)
 	assert.Equal(t, "Successfully", response.Data)
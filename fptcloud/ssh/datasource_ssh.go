@@ -43,7 +43,7 @@ func DataSourceSSHKey() *schema.Resource {
 	}
 }
 
-func dataSourceSSHKeyRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func dataSourceSSHKeyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	apiClient := m.(*common.Client)
 	sshService := NewSSHKeyService(apiClient)
 	var searchBy string
@@ -56,7 +56,7 @@ func dataSourceSSHKeyRead(_ context.Context, d *schema.ResourceData, m interface
 		searchBy = name.(string)
 	}
 
-	sshKey, err := sshService.FindSSHKey(searchBy)
+	sshKey, err := sshService.FindSSHKey(ctx, searchBy)
 	log.Printf("[INFO] search by : %s", searchBy)
 
 	if err != nil {
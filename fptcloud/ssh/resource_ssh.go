@@ -2,91 +2,162 @@ package fptcloud_ssh
 
 import (
 	"context"
-	"log"
+	"fmt"
+
 	common "terraform-provider-fptcloud/commons"
 	"terraform-provider-fptcloud/commons/utils"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &resourceSSHKey{}
+	_ resource.ResourceWithConfigure   = &resourceSSHKey{}
+	_ resource.ResourceWithImportState = &resourceSSHKey{}
 )
 
-// ResourceSSHKey function returns a schema.Resource that represents an SSH Key.
-// This can be used to create, read, and delete operations for an SSH Key in the infrastructure.
-func ResourceSSHKey() *schema.Resource {
-	return &schema.Resource{
+type resourceSSHKey struct {
+	sshService SSHKeyService
+}
+
+type sshKeyResourceModel struct {
+	Id        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	PublicKey types.String `tfsdk:"public_key"`
+}
+
+// NewResourceSSHKey returns the SSH key resource, which can be used to create, read, and delete SSH keys
+func NewResourceSSHKey() resource.Resource {
+	return &resourceSSHKey{}
+}
+
+func (r *resourceSSHKey) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_ssh_key"
+}
+
+func (r *resourceSSHKey) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
 		Description: "Provides a SSH key resource to allow you to manage SSH keys for instance access. Keys created with this resource can be referenced in your instance configuration via their ID.",
-		Schema: map[string]*schema.Schema{
-			"name": {
-				Type:         schema.TypeString,
-				Required:     true,
-				Description:  "a string that will be the reference for the SSH key.",
-				ValidateFunc: utils.ValidateName,
-				ForceNew:     true,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The ID of this resource.",
 			},
-			"public_key": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "a string containing the SSH public key.",
-				ForceNew:    true,
+			"name": schema.StringAttribute{
+				Required:      true,
+				Validators:    []validator.String{utils.NameValidator()},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "a string that will be the reference for the SSH key.",
+			},
+			"public_key": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "a string containing the SSH public key.",
 			},
-		},
-		CreateContext: resourceSSHKeyCreate,
-		ReadContext:   resourceSSHKeyRead,
-		DeleteContext: resourceSSHKeyDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
 		},
 	}
 }
 
-// function to create a new ssh key
-func resourceSSHKeyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*common.Client)
-	sshService := NewSSHKeyService(apiClient)
+func (r *resourceSSHKey) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
 
-	log.Printf("[INFO] creating the new ssh key %s", d.Get("name").(string))
-	sshKey, err := sshService.NewSSHKey(d.Get("name").(string), d.Get("public_key").(string))
-	if err != nil {
-		return diag.Errorf("[ERR] failed to create a new ssh key: %s", err)
+	client, ok := request.ProviderData.(*common.Client)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *common.Client, got: %T. Please report this issue to the provider developers.", request.ProviderData),
+		)
+		return
 	}
 
-	d.SetId(sshKey.ID)
+	r.sshService = NewSSHKeyService(client)
+}
+
+func (r *resourceSSHKey) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan sshKeyResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Creating the new ssh key "+plan.Name.ValueString())
+	sshKey, err := r.sshService.NewSSHKey(ctx, plan.Name.ValueString(), plan.PublicKey.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("Error creating ssh key", fmt.Sprintf("failed to create a new ssh key: %s", err))
+		return
+	}
+	plan.Id = types.StringValue(sshKey.ID)
 
-	return resourceSSHKeyRead(ctx, d, m)
+	if err = r.read(ctx, &plan); err != nil {
+		response.Diagnostics.AddError("Error reading ssh key", fmt.Sprintf("error retrieving ssh key: %s", err))
+		return
+	}
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
 }
 
-// function to read a ssh key
-func resourceSSHKeyRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*common.Client)
-	sshService := NewSSHKeyService(apiClient)
+func (r *resourceSSHKey) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state sshKeyResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
 
-	log.Printf("[INFO] retrieving the new ssh key %s", d.Get("name").(string))
-	sshKey, err := sshService.FindSSHKey(d.Id())
+	err := r.read(ctx, &state)
+	if common.IsNotFound(err) {
+		tflog.Warn(ctx, "SSH key "+state.Id.ValueString()+" not found, removing it from state")
+		response.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
-		if sshKey == nil {
-			d.SetId("")
-			return nil
-		}
+		response.Diagnostics.AddError("Error reading ssh key", fmt.Sprintf("error retrieving ssh key: %s", err))
+		return
+	}
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
 
-		return diag.Errorf("[ERR] error retrieving ssh key: %s", err)
+// Update is not called, as every attribute requires a new SSH key
+func (r *resourceSSHKey) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan sshKeyResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
 	}
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
 
-	if err := d.Set("name", sshKey.Name); err != nil {
+func (r *resourceSSHKey) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state sshKeyResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
 
-		return diag.Errorf("[ERR] error retrieving ssh key: %s", err)
+	tflog.Info(ctx, "Deleting the ssh key "+state.Id.ValueString())
+	if _, err := r.sshService.DeleteSSHKey(ctx, state.Id.ValueString()); err != nil {
+		response.Diagnostics.AddError("Error deleting ssh key", fmt.Sprintf("an error occurred while trying to delete the ssh key %s: %s", state.Id.ValueString(), err))
 	}
-	return nil
 }
 
-// function to delete the ssh key
-func resourceSSHKeyDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*common.Client)
-	sshService := NewSSHKeyService(apiClient)
+func (r *resourceSSHKey) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
+}
 
-	log.Printf("[INFO] deleting the ssh key %s", d.Id())
-	_, err := sshService.DeleteSSHKey(d.Id())
+// read sets the name of an SSH key returned by the API. The API does not return the public key as it was configured.
+func (r *resourceSSHKey) read(ctx context.Context, state *sshKeyResourceModel) error {
+	sshKey, err := r.sshService.FindSSHKey(ctx, state.Id.ValueString())
 	if err != nil {
-		return diag.Errorf("[ERR] an error occurred while trying to delete the ssh key %s", d.Id())
+		return err
 	}
+	state.Name = types.StringValue(sshKey.Name)
 	return nil
 }
@@ -1,6 +1,7 @@
 package fptcloud_ssh
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -17,10 +18,10 @@ type SSHKey struct {
 
 // SSHKeyService defines the interface for fetching SSH key details
 type SSHKeyService interface {
-	ListSSHKeys() ([]SSHKey, error)
-	FindSSHKey(search string) (*SSHKey, error)
-	NewSSHKey(name string, publicKey string) (*SSHKey, error)
-	DeleteSSHKey(ID string) (*common.SimpleResponse, error)
+	ListSSHKeys(ctx context.Context) ([]SSHKey, error)
+	FindSSHKey(ctx context.Context, search string) (*SSHKey, error)
+	NewSSHKey(ctx context.Context, name string, publicKey string) (*SSHKey, error)
+	DeleteSSHKey(ctx context.Context, ID string) (*common.SimpleResponse, error)
 }
 
 // SSHKeyServiceImpl is the implementation of SSHKeyService
@@ -34,9 +35,9 @@ func NewSSHKeyService(client *common.Client) SSHKeyService {
 }
 
 // ListSSHKeys list all SSH key for an account
-func (s *SSHKeyServiceImpl) ListSSHKeys() ([]SSHKey, error) {
+func (s *SSHKeyServiceImpl) ListSSHKeys(ctx context.Context) ([]SSHKey, error) {
 	var apiPath = common.ApiPath.SSH + "?page=1&page_size=9999"
-	resp, err := s.client.SendGetRequest(apiPath)
+	resp, err := s.client.SendGetRequestWithContext(ctx, apiPath)
 	if err != nil {
 		return nil, common.DecodeError(err)
 	}
@@ -55,8 +56,8 @@ func (s *SSHKeyServiceImpl) ListSSHKeys() ([]SSHKey, error) {
 }
 
 // FindSSHKey finds an SSH key by either part of the ID or part of the name
-func (s *SSHKeyServiceImpl) FindSSHKey(search string) (*SSHKey, error) {
-	keys, err := s.ListSSHKeys()
+func (s *SSHKeyServiceImpl) FindSSHKey(ctx context.Context, search string) (*SSHKey, error) {
+	keys, err := s.ListSSHKeys(ctx)
 	if err != nil {
 		return nil, common.DecodeError(err)
 	}
@@ -89,8 +90,8 @@ func (s *SSHKeyServiceImpl) FindSSHKey(search string) (*SSHKey, error) {
 }
 
 // DeleteSSHKey deletes an SSH key
-func (s *SSHKeyServiceImpl) DeleteSSHKey(id string) (*common.SimpleResponse, error) {
-	_, err := s.client.SendDeleteRequestWithBody(common.ApiPath.SSH, map[string]string{
+func (s *SSHKeyServiceImpl) DeleteSSHKey(ctx context.Context, id string) (*common.SimpleResponse, error) {
+	_, err := s.client.SendDeleteRequestWithBodyWithContext(ctx, common.ApiPath.SSH, map[string]string{
 		"ssh_id": id,
 	})
 	if err != nil {
@@ -106,8 +107,8 @@ func (s *SSHKeyServiceImpl) DeleteSSHKey(id string) (*common.SimpleResponse, err
 }
 
 // NewSSHKey creates a new SSH key record
-func (s *SSHKeyServiceImpl) NewSSHKey(name string, publicKey string) (*SSHKey, error) {
-	resp, err := s.client.SendPostRequest(common.ApiPath.SSH, map[string]string{
+func (s *SSHKeyServiceImpl) NewSSHKey(ctx context.Context, name string, publicKey string) (*SSHKey, error) {
+	resp, err := s.client.SendPostRequestWithContext(ctx, common.ApiPath.SSH, map[string]string{
 		"name":       name,
 		"public_key": publicKey,
 	})
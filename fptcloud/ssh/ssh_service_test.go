@@ -1,6 +1,7 @@
 package fptcloud_ssh_test
 
 import (
+	"context"
 	"strings"
 	common "terraform-provider-fptcloud/commons"
 	"testing"
@@ -29,7 +30,7 @@ func TestShouldItReturnListSSHKeys(t *testing.T) {
 
 	sshService := fptcloud_ssh.NewSSHKeyService(mockClient)
 
-	keys, err := sshService.ListSSHKeys()
+	keys, err := sshService.ListSSHKeys(context.Background())
 	assert.NoError(t, err)
 	assert.Len(t, keys, 1)
 	assert.Equal(t, "1", keys[0].ID)
@@ -65,7 +66,7 @@ func TestShouldItFindSSHKey(t *testing.T) {
 	sshService := fptcloud_ssh.NewSSHKeyService(mockClient)
 
 	t.Run("Find exact match by name", func(t *testing.T) {
-		key, err := sshService.FindSSHKey("test-key-1")
+		key, err := sshService.FindSSHKey(context.Background(), "test-key-1")
 		assert.NoError(t, err)
 		assert.NotNil(t, key)
 		assert.Equal(t, "1", key.ID)
@@ -73,7 +74,7 @@ func TestShouldItFindSSHKey(t *testing.T) {
 	})
 
 	t.Run("Find exact match by ID", func(t *testing.T) {
-		key, err := sshService.FindSSHKey("1")
+		key, err := sshService.FindSSHKey(context.Background(), "1")
 		assert.NoError(t, err)
 		assert.NotNil(t, key)
 		assert.Equal(t, "1", key.ID)
@@ -81,13 +82,13 @@ func TestShouldItFindSSHKey(t *testing.T) {
 	})
 
 	t.Run("Multiple partial matches", func(t *testing.T) {
-		_, err := sshService.FindSSHKey("test-key")
+		_, err := sshService.FindSSHKey(context.Background(), "test-key")
 		assert.Error(t, err)
 		assert.True(t, strings.Contains(err.Error(), "multiple matches"))
 	})
 
 	t.Run("No matches", func(t *testing.T) {
-		_, err := sshService.FindSSHKey("nonexistent-key")
+		_, err := sshService.FindSSHKey(context.Background(), "nonexistent-key")
 		assert.Error(t, err)
 		assert.True(t, strings.Contains(err.Error(), "zero matches"))
 	})
@@ -1,6 +1,7 @@
 package fptcloud_storage_policy
 
 import (
+	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -55,7 +56,7 @@ func flattenStoragePolicy(storagePolicy, _ interface{}, _ map[string]interface{}
 	return flattened, nil
 }
 
-func getStoragePolicies(m interface{}, extra map[string]interface{}) ([]interface{}, error) {
+func getStoragePolicies(ctx context.Context, m interface{}, extra map[string]interface{}) ([]interface{}, error) {
 	apiClient := m.(*common.Client)
 	storageService := NewStoragePolicyService(apiClient)
 
@@ -64,7 +65,7 @@ func getStoragePolicies(m interface{}, extra map[string]interface{}) ([]interfac
 		return nil, fmt.Errorf("[ERR] vpc id is required")
 	}
 
-	storagePolicies, err := storageService.ListStoragePolicy(vpcId)
+	storagePolicies, err := storageService.ListStoragePolicy(ctx, vpcId)
 	if err != nil {
 		return nil, fmt.Errorf("[ERR] Failed to retrieve storage policy: %s", err)
 	}
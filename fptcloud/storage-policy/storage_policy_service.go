@@ -1,6 +1,7 @@
 package fptcloud_storage_policy
 
 import (
+	"context"
 	"encoding/json"
 	common "terraform-provider-fptcloud/commons"
 )
@@ -13,7 +14,7 @@ type StoragePolicy struct {
 
 // StoragePolicyService defines the interface for storage policy service
 type StoragePolicyService interface {
-	ListStoragePolicy(vpcId string) (*[]StoragePolicy, error)
+	ListStoragePolicy(ctx context.Context, vpcId string) (*[]StoragePolicy, error)
 }
 
 // StoragePolicyServiceImpl is the implementation of StoragePolicyService
@@ -27,9 +28,9 @@ func NewStoragePolicyService(client *common.Client) StoragePolicyService {
 }
 
 // ListStoragePolicy get list storage policy
-func (s *StoragePolicyServiceImpl) ListStoragePolicy(vpcId string) (*[]StoragePolicy, error) {
+func (s *StoragePolicyServiceImpl) ListStoragePolicy(ctx context.Context, vpcId string) (*[]StoragePolicy, error) {
 	var apiPath = common.ApiPath.StoragePolicy(vpcId)
-	resp, err := s.client.SendGetRequest(apiPath)
+	resp, err := s.client.SendCachedGetRequestWithContext(ctx, apiPath)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package fptcloud_storage_policy_test
 
 import (
+	"context"
 	"terraform-provider-fptcloud/fptcloud/storage-policy"
 	"testing"
 
@@ -20,7 +21,7 @@ func TestListStoragePolicy_ReturnsStoragePolicies(t *testing.T) {
 	})
 	defer server.Close()
 	service := fptcloud_storage_policy.NewStoragePolicyService(mockClient)
-	storagePolicies, err := service.ListStoragePolicy("vpc_id")
+	storagePolicies, err := service.ListStoragePolicy(context.Background(), "vpc_id")
 	assert.NoError(t, err)
 	assert.NotNil(t, storagePolicies)
 	assert.Equal(t, 1, len(*storagePolicies))
@@ -37,7 +38,7 @@ func TestListStoragePolicy_ReturnsEmptyWhenNotFound(t *testing.T) {
 	})
 	defer server.Close()
 	service := fptcloud_storage_policy.NewStoragePolicyService(mockClient)
-	storagePolicies, err := service.ListStoragePolicy("vpc_id")
+	storagePolicies, err := service.ListStoragePolicy(context.Background(), "vpc_id")
 	assert.NoError(t, err)
 	assert.NotNil(t, storagePolicies)
 	assert.Equal(t, 0, len(*storagePolicies))
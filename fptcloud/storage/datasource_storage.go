@@ -78,7 +78,7 @@ func DataSourceStorage() *schema.Resource {
 	}
 }
 
-func dataSourceStorageRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func dataSourceStorageRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	apiClient := m.(*common.Client)
 	storageService := NewStorageService(apiClient)
 
@@ -96,7 +96,7 @@ func dataSourceStorageRead(_ context.Context, d *schema.ResourceData, m interfac
 		findStorageModel.VpcId = vpcId.(string)
 	}
 
-	foundStorage, err := storageService.FindStorage(findStorageModel)
+	foundStorage, err := storageService.FindStorage(ctx, findStorageModel)
 	if err != nil {
 		return diag.Errorf("[ERR] Failed to retrieve storage: %s", err)
 	}
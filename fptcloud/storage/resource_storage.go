@@ -3,13 +3,13 @@ package fptcloud_storage
 import (
 	"context"
 	"errors"
+	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
-	"strings"
 	common "terraform-provider-fptcloud/commons"
+	"terraform-provider-fptcloud/commons/waiter"
 	"time"
 )
 
@@ -68,13 +68,16 @@ func ResourceStorage() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Description: "List of tag IDs associated with the storage",
 			},
+			"tag_ids_all": common.TagIdsAllSchema(),
 		},
-		CreateContext: resourceStorageCreate,
-		ReadContext:   resourceStorageRead,
-		UpdateContext: resourceStorageUpdate,
-		DeleteContext: resourceStorageDelete,
+		CreateWithoutTimeout: common.WithResourceTimeout(schema.TimeoutCreate, resourceStorageCreate),
+		ReadWithoutTimeout:   common.WithResourceTimeout(schema.TimeoutRead, resourceStorageRead),
+		UpdateWithoutTimeout: common.WithResourceTimeout(schema.TimeoutUpdate, resourceStorageUpdate),
+		DeleteWithoutTimeout: common.WithResourceTimeout(schema.TimeoutDelete, resourceStorageDelete),
+		CustomizeDiff:        common.CustomizeDiffTagIdsAll,
+		Timeouts:             common.ResourceTimeouts(),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: common.ImportVpcResource("<vpc_id>/<id> or <vpc_id>/<name>", resourceStorageImport),
 		},
 	}
 }
@@ -119,14 +122,14 @@ func resourceStorageCreate(ctx context.Context, d *schema.ResourceData, m interf
 	}
 
 	if tags, ok := d.GetOk("tag_ids"); ok {
-		storageModel.TagIds = expandTagIDs(tags.(*schema.Set))
+		storageModel.TagIds = common.ExpandTagIds(tags.(*schema.Set))
 	}
 
 	if storageType == Local && !okInstanceId {
 		return diag.Errorf("[ERR] Instance id is required with storage type LOCAL")
 	}
 
-	storageId, err := storageService.CreateStorage(storageModel)
+	storageId, err := storageService.CreateStorage(ctx, storageModel)
 	if err != nil {
 		return diag.Errorf("[ERR] Failed to create storage: %s", err)
 	}
@@ -138,26 +141,26 @@ func resourceStorageCreate(ctx context.Context, d *schema.ResourceData, m interf
 	}
 
 	//Waiting for status active
-	createStateConf := &retry.StateChangeConf{
-		Pending: []string{"DISABLE", "PENDING", "DISABLED"},
-		Target:  []string{"ENABLED"},
-		Refresh: func() (interface{}, string, error) {
+	createWaiter := &waiter.StateWaiter[*Storage]{
+		Description: fmt.Sprintf("storage %s to be created", storageId),
+		Pending:     []string{"DISABLE", "PENDING", "DISABLED"},
+		Target:      []string{"ENABLED"},
+		Refresh: func(ctx context.Context) (*Storage, string, error) {
 			findStorageModel := FindStorageDTO{
 				ID:    storageId,
 				VpcId: vpcId.(string),
 			}
-			resp, err := storageService.FindStorage(findStorageModel)
+			resp, err := storageService.FindStorage(ctx, findStorageModel)
 			if err != nil {
-				return 0, "", common.DecodeError(err)
+				return nil, "", err
 			}
 			return resp, resp.Status, nil
 		},
-		Timeout:        time.Duration(apiClient.Timeout) * time.Minute,
+		Timeout:        apiClient.ResourceTimeout(d, schema.TimeoutCreate),
 		Delay:          3 * time.Second,
-		MinTimeout:     3 * time.Second,
 		NotFoundChecks: 120,
 	}
-	_, err = createStateConf.WaitForStateContext(ctx)
+	_, err = createWaiter.Wait(ctx)
 	if err != nil {
 		return diag.Errorf("[Error] Waiting for storage (%s) to be created: %s", d.Id(), err)
 	}
@@ -166,7 +169,7 @@ func resourceStorageCreate(ctx context.Context, d *schema.ResourceData, m interf
 }
 
 // function to read the Storage
-func resourceStorageRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceStorageRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	apiClient := m.(*common.Client)
 	storageService := NewStorageService(apiClient)
 
@@ -185,35 +188,19 @@ func resourceStorageRead(_ context.Context, d *schema.ResourceData, m interface{
 	}
 	findStorageModel.VpcId = vpcIdStr
 
-	foundStorage, err := storageService.FindStorage(findStorageModel)
+	foundStorage, err := storageService.FindStorage(ctx, findStorageModel)
 	if err != nil {
+		if common.IsNotFound(err) {
+			log.Printf("[WARN] Storage %s not found, removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
 		if errors.Is(err, common.TimeoutError) {
 			log.Printf("[WARN] Timeout while retrieving storage %s, keeping in state for retry", d.Id())
 			return diag.Errorf("[ERR] timeout while retrieving the storage: %s", err)
 		}
 
-		var httpErr common.HTTPError
-		if errors.As(err, &httpErr) {
-			if httpErr.Code == 404 {
-				log.Printf("[WARN] Storage %s not found (404), keeping in state for retry", d.Id())
-				return diag.Errorf("[ERR] Storage not found (404). Please try again later: %s", err)
-			}
-			log.Printf("[WARN] HTTP error %d while retrieving storage %s, keeping in state", httpErr.Code, d.Id())
-			return diag.Errorf("[ERR] HTTP error while retrieving the storage: %s", err)
-		}
-
-		if errors.Is(err, common.ZeroMatchesError) {
-			log.Printf("[WARN] Storage %s not found (ZeroMatchesError), keeping in state for retry", d.Id())
-			return diag.Errorf("[ERR] Storage not found. Please try again later: %s", err)
-		}
-
-		errStr := err.Error()
-		if strings.Contains(errStr, "ZeroMatchesError") ||
-			(strings.Contains(errStr, "not found") && !strings.Contains(errStr, "timeout")) {
-			log.Printf("[WARN] Storage %s not found (%s), keeping in state for retry", d.Id(), errStr)
-			return diag.Errorf("[ERR] Storage not found. Please try again later: %s", err)
-		}
-
 		log.Printf("[WARN] Error retrieving storage %s: %s. Keeping in state for retry.", d.Id(), err)
 		return diag.Errorf("[ERR] failed retrieving the storage: %s", err)
 	}
@@ -268,13 +255,35 @@ func resourceStorageRead(_ context.Context, d *schema.ResourceData, m interface{
 		return diag.FromErr(err)
 	}
 
-	if err := d.Set("tag_ids", foundStorage.TagIds); err != nil {
+	configuredTagIds := common.ExpandTagIds(d.Get("tag_ids").(*schema.Set))
+	if err := d.Set("tag_ids", apiClient.Tags.FilterTagIds(foundStorage.TagIds, configuredTagIds)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("tag_ids_all", apiClient.Tags.AllTagIds(foundStorage.TagIds, configuredTagIds)); err != nil {
 		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
+// function to import the Storage by id or name
+func resourceStorageImport(ctx context.Context, _ *schema.ResourceData, m interface{}, vpcId string, ref string) (string, error) {
+	storageService := NewStorageService(m.(*common.Client))
+
+	foundStorage, err := common.FindByIdOrName(ctx, ref,
+		func(ctx context.Context, id string) (*Storage, error) {
+			return storageService.FindStorage(ctx, FindStorageDTO{ID: id, VpcId: vpcId})
+		},
+		func(ctx context.Context, name string) (*Storage, error) {
+			return storageService.FindStorage(ctx, FindStorageDTO{Name: name, VpcId: vpcId})
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+	return foundStorage.ID, nil
+}
+
 // function to update the Storage
 func resourceStorageUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	apiClient := m.(*common.Client)
@@ -291,13 +300,13 @@ func resourceStorageUpdate(ctx context.Context, d *schema.ResourceData, m interf
 	hasChangedStoragePolicy := d.HasChange("storage_policy_id")
 	hasChangedName := d.HasChange("name")
 	hasChangeAttachedInstance := d.HasChange("instance_id")
-	hasChangeTags := d.HasChange("tag_ids")
+	hasChangeTags := d.HasChanges("tag_ids", "tag_ids_all")
 
 	if hasChangedSize || hasChangedName || hasChangedStoragePolicy {
 		updateStorageModel.Name = d.Get("name").(string)
 		updateStorageModel.SizeGb = d.Get("size_gb").(int)
 		updateStorageModel.StoragePolicyId = d.Get("storage_policy_id").(string)
-		_, err := storageService.UpdateStorage(vpcId, d.Id(), updateStorageModel)
+		_, err := storageService.UpdateStorage(ctx, vpcId, d.Id(), updateStorageModel)
 		if err != nil {
 			return diag.Errorf("[ERR] An error occurred while update storage %s", err)
 		}
@@ -317,41 +326,40 @@ func resourceStorageUpdate(ctx context.Context, d *schema.ResourceData, m interf
 			}
 		}
 
-		_, err := storageService.UpdateAttachedInstance(vpcId, d.Id(), instanceIdStr)
+		_, err := storageService.UpdateAttachedInstance(ctx, vpcId, d.Id(), instanceIdStr)
 		if err != nil {
 			return diag.Errorf("[ERR] An error occurred while change attached instance from storage %s", d.Id())
 		}
 	}
 
 	if hasChangeTags {
-		tagIds := expandTagIDs(d.Get("tag_ids").(*schema.Set))
-		_, err := storageService.UpdateTags(vpcId, d.Id(), tagIds)
+		tagIds := common.ExpandTagIds(d.Get("tag_ids").(*schema.Set))
+		_, err := storageService.UpdateTags(ctx, vpcId, d.Id(), tagIds)
 		if err != nil {
 			return diag.Errorf("[ERR] An error occurred while updating storage tags %s", err)
 		}
 	}
 
 	//Waiting for status active
-	createStateConf := &retry.StateChangeConf{
-		Pending: []string{"DISABLE", "PENDING", "UPDATING"},
-		Target:  []string{"ENABLED"},
-		Refresh: func() (interface{}, string, error) {
+	updateWaiter := &waiter.StateWaiter[*Storage]{
+		Description: fmt.Sprintf("storage %s to be updated", d.Id()),
+		Pending:     []string{"DISABLE", "PENDING", "UPDATING"},
+		Target:      []string{"ENABLED"},
+		Refresh: func(ctx context.Context) (*Storage, string, error) {
 			findStorageModel := FindStorageDTO{
 				ID:    d.Id(),
 				VpcId: vpcId,
 			}
-			resp, err := storageService.FindStorage(findStorageModel)
+			resp, err := storageService.FindStorage(ctx, findStorageModel)
 			if err != nil {
-				return 0, "", common.DecodeError(err)
+				return nil, "", err
 			}
 			return resp, resp.Status, nil
 		},
-		Timeout:        time.Duration(apiClient.Timeout) * time.Minute,
-		Delay:          3 * time.Second,
-		MinTimeout:     3 * time.Second,
-		NotFoundChecks: 120,
+		Timeout: apiClient.ResourceTimeout(d, schema.TimeoutUpdate),
+		Delay:   3 * time.Second,
 	}
-	_, err := createStateConf.WaitForStateContext(ctx)
+	_, err := updateWaiter.Wait(ctx)
 	if err != nil {
 		return diag.Errorf("[Error] Waiting for storage (%s) to be updated: %s", d.Id(), err)
 	}
@@ -359,7 +367,7 @@ func resourceStorageUpdate(ctx context.Context, d *schema.ResourceData, m interf
 }
 
 // function to delete the Storage
-func resourceStorageDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceStorageDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	apiClient := m.(*common.Client)
 	storageService := NewStorageService(apiClient)
 
@@ -371,18 +379,10 @@ func resourceStorageDelete(_ context.Context, d *schema.ResourceData, m interfac
 		return diag.Errorf("[ERR] Vpc id is required")
 	}
 
-	_, err := storageService.DeleteStorage(vpcId.(string), d.Id())
+	_, err := storageService.DeleteStorage(ctx, vpcId.(string), d.Id())
 
 	if err != nil {
 		return diag.Errorf("[ERR] An error occurred while trying to delete the storage %s", err)
 	}
 	return nil
 }
-
-func expandTagIDs(tagSet *schema.Set) []string {
-	tagIds := make([]string, 0, tagSet.Len())
-	for _, tag := range tagSet.List() {
-		tagIds = append(tagIds, tag.(string))
-	}
-	return tagIds
-}
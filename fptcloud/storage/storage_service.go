@@ -1,6 +1,7 @@
 package fptcloud_storage
 
 import (
+	"context"
 	"encoding/json"
 	common "terraform-provider-fptcloud/commons"
 	"terraform-provider-fptcloud/commons/utils"
@@ -53,12 +54,12 @@ type Storage struct {
 
 // StorageService defines the interface for storage service
 type StorageService interface {
-	FindStorage(searchModel FindStorageDTO) (*Storage, error)
-	CreateStorage(createdModel StorageDTO) (string, error)
-	UpdateStorage(vpcId string, storageId string, updatedModel UpdateStorageDTO) (*common.SimpleResponse, error)
-	UpdateTags(vpcId string, storageId string, tagIds []string) (*common.SimpleResponse, error)
-	UpdateAttachedInstance(vpcId string, storageId string, instanceId *string) (*common.SimpleResponse, error)
-	DeleteStorage(vpcId string, storageId string) (*common.SimpleResponse, error)
+	FindStorage(ctx context.Context, searchModel FindStorageDTO) (*Storage, error)
+	CreateStorage(ctx context.Context, createdModel StorageDTO) (string, error)
+	UpdateStorage(ctx context.Context, vpcId string, storageId string, updatedModel UpdateStorageDTO) (*common.SimpleResponse, error)
+	UpdateTags(ctx context.Context, vpcId string, storageId string, tagIds []string) (*common.SimpleResponse, error)
+	UpdateAttachedInstance(ctx context.Context, vpcId string, storageId string, instanceId *string) (*common.SimpleResponse, error)
+	DeleteStorage(ctx context.Context, vpcId string, storageId string) (*common.SimpleResponse, error)
 }
 
 // StorageServiceImpl is the implementation of StorageServiceImpl
@@ -72,9 +73,9 @@ func NewStorageService(client *common.Client) StorageService {
 }
 
 // FindStorage finds a storage by either part of the ID or part of the name
-func (s *StorageServiceImpl) FindStorage(searchModel FindStorageDTO) (*Storage, error) {
+func (s *StorageServiceImpl) FindStorage(ctx context.Context, searchModel FindStorageDTO) (*Storage, error) {
 	var apiPath = common.ApiPath.Storage(searchModel.VpcId) + utils.ToQueryParams(searchModel)
-	resp, err := s.client.SendGetRequest(apiPath)
+	resp, err := s.client.SendGetRequestWithContext(ctx, apiPath)
 	if err != nil {
 		return nil, common.DecodeError(err)
 	}
@@ -89,9 +90,10 @@ func (s *StorageServiceImpl) FindStorage(searchModel FindStorageDTO) (*Storage,
 }
 
 // CreateStorage create a new storage
-func (s *StorageServiceImpl) CreateStorage(createdModel StorageDTO) (string, error) {
+func (s *StorageServiceImpl) CreateStorage(ctx context.Context, createdModel StorageDTO) (string, error) {
+	createdModel.TagIds = s.client.Tags.MergeTagIds(createdModel.TagIds, nil)
 	var apiPath = common.ApiPath.Storage(createdModel.VpcId)
-	resp, err := s.client.SendPostRequest(apiPath, createdModel)
+	resp, err := s.client.SendPostRequestWithContext(ctx, apiPath, createdModel)
 
 	if err != nil {
 		return "", common.DecodeError(err)
@@ -111,9 +113,9 @@ func (s *StorageServiceImpl) CreateStorage(createdModel StorageDTO) (string, err
 }
 
 // UpdateStorage update a storage
-func (s *StorageServiceImpl) UpdateStorage(vpcId string, storageId string, updatedModel UpdateStorageDTO) (*common.SimpleResponse, error) {
+func (s *StorageServiceImpl) UpdateStorage(ctx context.Context, vpcId string, storageId string, updatedModel UpdateStorageDTO) (*common.SimpleResponse, error) {
 	var apiPath = common.ApiPath.Storage(vpcId) + "/" + storageId
-	_, err := s.client.SendPutRequest(apiPath, updatedModel)
+	_, err := s.client.SendPutRequestWithContext(ctx, apiPath, updatedModel)
 
 	if err != nil {
 		return nil, common.DecodeError(err)
@@ -128,9 +130,9 @@ func (s *StorageServiceImpl) UpdateStorage(vpcId string, storageId string, updat
 }
 
 // DeleteStorage delete a storage
-func (s *StorageServiceImpl) DeleteStorage(vpcId string, storageId string) (*common.SimpleResponse, error) {
+func (s *StorageServiceImpl) DeleteStorage(ctx context.Context, vpcId string, storageId string) (*common.SimpleResponse, error) {
 	var apiPath = common.ApiPath.Storage(vpcId) + "/" + storageId
-	_, err := s.client.SendDeleteRequest(apiPath)
+	_, err := s.client.SendDeleteRequestWithContext(ctx, apiPath)
 
 	if err != nil {
 		return nil, common.DecodeError(err)
@@ -144,10 +146,10 @@ func (s *StorageServiceImpl) DeleteStorage(vpcId string, storageId string) (*com
 	return result, nil
 }
 
-func (s *StorageServiceImpl) UpdateAttachedInstance(vpcId string, storageId string, instanceId *string) (*common.SimpleResponse, error) {
+func (s *StorageServiceImpl) UpdateAttachedInstance(ctx context.Context, vpcId string, storageId string, instanceId *string) (*common.SimpleResponse, error) {
 	var apiPath = common.ApiPath.StorageUpdateAttached(vpcId, storageId)
 
-	_, err := s.client.SendPutRequest(apiPath, map[string]interface{}{
+	_, err := s.client.SendPutRequestWithContext(ctx, apiPath, map[string]interface{}{
 		"instance_id": instanceId,
 	})
 
@@ -164,12 +166,23 @@ func (s *StorageServiceImpl) UpdateAttachedInstance(vpcId string, storageId stri
 }
 
 // UpdateTags updates the tags associated with a storage
-func (s *StorageServiceImpl) UpdateTags(vpcId string, storageId string, tagIds []string) (*common.SimpleResponse, error) {
+func (s *StorageServiceImpl) UpdateTags(ctx context.Context, vpcId string, storageId string, tagIds []string) (*common.SimpleResponse, error) {
+	tagIds, err := s.client.Tags.MergeAppliedTagIds(ctx, tagIds, func(ctx context.Context) ([]string, error) {
+		storage, err := s.FindStorage(ctx, FindStorageDTO{ID: storageId, VpcId: vpcId})
+		if err != nil {
+			return nil, err
+		}
+		return storage.TagIds, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	var apiPath = common.ApiPath.UpdateStorageTags(vpcId, storageId)
 	payload := map[string][]string{
 		"tag_ids": tagIds,
 	}
-	_, err := s.client.SendPutRequest(apiPath, payload)
+	_, err = s.client.SendPutRequestWithContext(ctx, apiPath, payload)
 	if err != nil {
 		return nil, common.DecodeError(err)
 	}
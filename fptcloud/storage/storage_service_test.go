@@ -1,6 +1,10 @@
 package fptcloud_storage_test
 
 import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"terraform-provider-fptcloud/fptcloud/storage"
 	"testing"
 
@@ -28,7 +32,7 @@ func TestFindStorage_ReturnsStorage(t *testing.T) {
 	defer server.Close()
 	service := fptcloud_storage.NewStorageService(mockClient)
 	searchModel := fptcloud_storage.FindStorageDTO{VpcId: "vpc_id", Name: "storage-name"}
-	storage, err := service.FindStorage(searchModel)
+	storage, err := service.FindStorage(context.Background(), searchModel)
 	assert.NoError(t, err)
 	assert.NotNil(t, storage)
 	assert.Equal(t, "1", storage.ID)
@@ -43,7 +47,7 @@ func TestFindStorage_ReturnsErrorOnRequestFailure(t *testing.T) {
 	defer server.Close()
 	service := fptcloud_storage.NewStorageService(mockClient)
 	searchModel := fptcloud_storage.FindStorageDTO{VpcId: "vpc_id", Name: "storage-name"}
-	storage, err := service.FindStorage(searchModel)
+	storage, err := service.FindStorage(context.Background(), searchModel)
 	assert.Error(t, err)
 	assert.Nil(t, storage)
 }
@@ -56,7 +60,7 @@ func TestCreateStorage_ReturnsStorageId(t *testing.T) {
 	defer server.Close()
 	service := fptcloud_storage.NewStorageService(mockClient)
 	createModel := fptcloud_storage.StorageDTO{VpcId: "vpc_id", Name: "storage-name", Type: "LOCAL", SizeGb: 100, StoragePolicyId: "policy-123"}
-	storageId, err := service.CreateStorage(createModel)
+	storageId, err := service.CreateStorage(context.Background(), createModel)
 	assert.NoError(t, err)
 	assert.Equal(t, "1", storageId)
 }
@@ -68,7 +72,7 @@ func TestUpdateStorage_ReturnsSuccess(t *testing.T) {
 	defer server.Close()
 	service := fptcloud_storage.NewStorageService(mockClient)
 	updateModel := fptcloud_storage.UpdateStorageDTO{Name: "storage-rename", SizeGb: 200, StoragePolicyId: "policy_id"}
-	response, err := service.UpdateStorage("vpc_id", "storage-name", updateModel)
+	response, err := service.UpdateStorage(context.Background(), "vpc_id", "storage-name", updateModel)
 	assert.NoError(t, err)
 	assert.NotNil(t, response)
 	assert.Equal(t, "Successfully", response.Data)
@@ -80,7 +84,7 @@ func TestDeleteStorage_ReturnsSuccess(t *testing.T) {
 	})
 	defer server.Close()
 	service := fptcloud_storage.NewStorageService(mockClient)
-	response, err := service.DeleteStorage("vpc-123", "storage_id")
+	response, err := service.DeleteStorage(context.Background(), "vpc-123", "storage_id")
 	assert.NoError(t, err)
 	assert.NotNil(t, response)
 	assert.Equal(t, "Successfully", response.Data)
@@ -93,7 +97,7 @@ func TestUpdateAttachedInstance_ReturnsSuccess(t *testing.T) {
 	defer server.Close()
 	service := fptcloud_storage.NewStorageService(mockClient)
 	instanceId := "instance-123"
-	response, err := service.UpdateAttachedInstance("vpc-123", "storage_id", &instanceId)
+	response, err := service.UpdateAttachedInstance(context.Background(), "vpc-123", "storage_id", &instanceId)
 	assert.NoError(t, err)
 	assert.NotNil(t, response)
 	assert.Equal(t, "Successfully", response.Data)
@@ -105,8 +109,43 @@ func TestUpdateStorageTags_ReturnsSuccess(t *testing.T) {
 	})
 	defer server.Close()
 	service := fptcloud_storage.NewStorageService(mockClient)
-	response, err := service.UpdateTags("vpc_id", "storage_id", []string{"tag-1"})
+	response, err := service.UpdateTags(context.Background(), "vpc_id", "storage_id", []string{"tag-1"})
 	assert.NoError(t, err)
 	assert.NotNil(t, response)
 	assert.Equal(t, "Successfully", response.Data)
 }
+
+func TestUpdateStorageTags_MergesProviderTagIds(t *testing.T) {
+	var sentTagIds []string
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method == http.MethodPut {
+			var payload map[string][]string
+			_ = json.NewDecoder(req.Body).Decode(&payload)
+			sentTagIds = payload["tag_ids"]
+			return
+		}
+		_, _ = rw.Write([]byte(`{"id": "storage_id", "tag_ids": ["backup", "old"]}`))
+	}))
+	defer server.Close()
+	mockClient, _ := common.NewClientForTestingWithServer(server)
+	mockClient.Tags = common.TagConfig{DefaultTagIds: []string{"owner"}, IgnoreTagIds: []string{"backup"}}
+	service := fptcloud_storage.NewStorageService(mockClient)
+	_, err := service.UpdateTags(context.Background(), "vpc_id", "storage_id", []string{"tag-1"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"tag-1", "owner", "backup"}, sentTagIds)
+}
+
+func TestFindStorage_ReturnsNotFoundError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusNotFound)
+		_, _ = rw.Write([]byte(`{"status": false, "message": "Storage not found"}`))
+	}))
+	defer server.Close()
+	mockClient, _ := common.NewClientForTestingWithServer(server)
+	service := fptcloud_storage.NewStorageService(mockClient)
+	searchModel := fptcloud_storage.FindStorageDTO{VpcId: "vpc_id", ID: "storage_id"}
+	storage, err := service.FindStorage(context.Background(), searchModel)
+	assert.Nil(t, storage)
+	assert.True(t, common.IsNotFound(err))
+	assert.Equal(t, "HttpError: Storage not found", err.Error())
+}
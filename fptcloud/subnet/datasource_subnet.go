@@ -1,6 +1,7 @@
 package fptcloud_subnet
 
 import (
+	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -113,7 +114,7 @@ func flattenSubnet(subnet, _ interface{}, _ map[string]interface{}) (map[string]
 	return flattened, nil
 }
 
-func getSubnets(m interface{}, extra map[string]interface{}) ([]interface{}, error) {
+func getSubnets(ctx context.Context, m interface{}, extra map[string]interface{}) ([]interface{}, error) {
 	apiClient := m.(*common.Client)
 	service := NewSubnetService(apiClient)
 
@@ -127,9 +128,9 @@ func getSubnets(m interface{}, extra map[string]interface{}) ([]interface{}, err
 	var result *[]Subnet
 	var err error
 	if useNetworksIaas {
-		result, err = service.ListSubnetIaas(vpcId)
+		result, err = service.ListSubnetIaas(ctx, vpcId)
 	} else {
-		result, err = service.ListSubnet(vpcId)
+		result, err = service.ListSubnet(ctx, vpcId)
 	}
 
 	if err != nil || len(*result) == 0 {
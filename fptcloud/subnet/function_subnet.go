@@ -0,0 +1,114 @@
+package fptcloud_subnet
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ function.Function = &functionSubnetStaticPool{}
+	_ function.Function = &functionIpRangeContains{}
+)
+
+// staticPoolAttributeTypes are the attributes of the object returned by subnet_static_pool
+var staticPoolAttributeTypes = map[string]attr.Type{
+	"start": types.StringType,
+	"end":   types.StringType,
+}
+
+type functionSubnetStaticPool struct{}
+
+// NewFunctionSubnetStaticPool returns the subnet_static_pool function, splitting a static IP pool like the subnet
+// resource does
+func NewFunctionSubnetStaticPool() function.Function {
+	return &functionSubnetStaticPool{}
+}
+
+func (f *functionSubnetStaticPool) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "subnet_static_pool"
+}
+
+func (f *functionSubnetStaticPool) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:     "Parse the static IP pool of a subnet",
+		Description: "Validates a static IP pool in the format of the subnet static_ip_pool attribute, such as 10.0.0.2-10.0.0.254, and returns an object with its start and end IPs.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "static_ip_pool",
+				Description: "The static IP pool, two IPv4 addresses separated by a hyphen.",
+			},
+		},
+		Return: function.ObjectReturn{AttributeTypes: staticPoolAttributeTypes},
+	}
+}
+
+func (f *functionSubnetStaticPool) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var staticIpPool string
+	response.Error = request.Arguments.Get(ctx, &staticIpPool)
+	if response.Error != nil {
+		return
+	}
+
+	start, end, err := ParseStaticIpPool(staticIpPool)
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	pool, diags := types.ObjectValue(staticPoolAttributeTypes, map[string]attr.Value{
+		"start": types.StringValue(start),
+		"end":   types.StringValue(end),
+	})
+	response.Error = function.FuncErrorFromDiags(ctx, diags)
+	if response.Error != nil {
+		return
+	}
+	response.Error = response.Result.Set(ctx, pool)
+}
+
+type functionIpRangeContains struct{}
+
+// NewFunctionIpRangeContains returns the ip_range_contains function, checking an IP against a static IP pool or a
+// CIDR block
+func NewFunctionIpRangeContains() function.Function {
+	return &functionIpRangeContains{}
+}
+
+func (f *functionIpRangeContains) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "ip_range_contains"
+}
+
+func (f *functionIpRangeContains) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:     "Check whether an IP address is in a range",
+		Description: "Returns whether an IPv4 address is in a range, either a static IP pool such as 10.0.0.2-10.0.0.254, both ends included, or a CIDR block such as 10.0.0.0/24.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "ip_range",
+				Description: "The static IP pool or the CIDR block.",
+			},
+			function.StringParameter{
+				Name:        "ip",
+				Description: "The IPv4 address.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *functionIpRangeContains) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var ipRange, ip string
+	response.Error = request.Arguments.Get(ctx, &ipRange, &ip)
+	if response.Error != nil {
+		return
+	}
+
+	contains, err := IpRangeContains(ipRange, ip)
+	if err != nil {
+		response.Error = function.NewFuncError(err.Error())
+		return
+	}
+	response.Error = response.Result.Set(ctx, contains)
+}
@@ -2,12 +2,14 @@ package fptcloud_subnet
 
 import (
 	"context"
+	"fmt"
 	"log"
 	common "terraform-provider-fptcloud/commons"
+	"terraform-provider-fptcloud/commons/waiter"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -15,14 +17,19 @@ import (
 // This can be used to create, read and delete operations for a subnet in the infrastructure.
 func ResourceSubnet() *schema.Resource {
 	return &schema.Resource{
-		Description:   "Provides a FPT cloud instance group which can be attached to an instance in order to provide expanded subnet.",
-		Schema:        resourceSubnet,
-		CreateContext: resourceSubnetCreate,
-		ReadContext:   resourceSubnetRead,
-		UpdateContext: resourceSubnetUpdate,
-		DeleteContext: resourceSubnetDelete,
+		Description:          "Provides a FPT cloud instance group which can be attached to an instance in order to provide expanded subnet.",
+		Schema:               resourceSubnet,
+		CreateWithoutTimeout: common.WithResourceTimeout(schema.TimeoutCreate, resourceSubnetCreate),
+		ReadWithoutTimeout:   common.WithResourceTimeout(schema.TimeoutRead, resourceSubnetRead),
+		UpdateWithoutTimeout: common.WithResourceTimeout(schema.TimeoutUpdate, resourceSubnetUpdate),
+		DeleteWithoutTimeout: common.WithResourceTimeout(schema.TimeoutDelete, resourceSubnetDelete),
+		Timeouts:             common.ResourceTimeouts(),
+		CustomizeDiff: customdiff.All(
+			common.ForceNewUnlessUnsetAfterImport([]string{"type", "cidr", "static_ip_pool"}, "cidr"),
+			common.CustomizeDiffTagIdsAll,
+		),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: common.ImportVpcResource("<vpc_id>/<id> or <vpc_id>/<name>", resourceSubnetImport),
 		},
 	}
 }
@@ -44,14 +51,14 @@ func resourceSubnetCreate(ctx context.Context, d *schema.ResourceData, m interfa
 		PrimaryDNSIp:   d.Get("primary_dns_ip").(string),
 		SecondaryDNSIp: d.Get("secondary_dns_ip").(string),
 	}
-	//if tags, ok := d.GetOk("tag_ids"); ok {
-	//	createModel.TagIds = expandTagIDs(tags.(*schema.Set))
-	//}
+	if tags, ok := d.GetOk("tag_ids"); ok {
+		createModel.TagIds = common.ExpandTagIds(tags.(*schema.Set))
+	}
 	if okVpcId {
 		createModel.VpcId = vpcId.(string)
 	}
 
-	result, err := service.CreateSubnet(createModel)
+	result, err := service.CreateSubnet(ctx, createModel)
 	if err != nil || result == nil {
 		return diag.Errorf("[ERR] Failed to create a new subnet: %s", err)
 	}
@@ -67,28 +74,28 @@ func resourceSubnetCreate(ctx context.Context, d *schema.ResourceData, m interfa
 	}
 
 	//Waiting for status active
-	createStateConf := &retry.StateChangeConf{
-		Pending: []string{"PENDING"},
-		Target:  []string{"COMPLETE"},
-		Refresh: func() (interface{}, string, error) {
+	createWaiter := &waiter.StateWaiter[*Subnet]{
+		Description: fmt.Sprintf("subnet %s to be created", createModel.Name),
+		Target:      []string{"COMPLETE"},
+		Refresh: func(ctx context.Context) (*Subnet, string, error) {
 			findModel := FindSubnetDTO{
 				NetworkName: result.NetworkName,
 				VpcId:       vpcId.(string),
 			}
-			resp, err := service.FindSubnetByName(findModel)
+			resp, err := service.FindSubnetByName(ctx, findModel)
 			if err != nil {
-				return 0, "", common.DecodeError(err)
+				return nil, "", err
 			}
 
 			d.SetId(resp.ID)
 			return resp, "COMPLETE", nil
 		},
-		Timeout:        time.Duration(apiClient.Timeout) * time.Minute,
+		Timeout:        apiClient.ResourceTimeout(d, schema.TimeoutCreate),
 		Delay:          10 * time.Second,
-		MinTimeout:     30 * time.Second,
+		MinInterval:    10 * time.Second,
 		NotFoundChecks: 20,
 	}
-	_, err = createStateConf.WaitForStateContext(ctx)
+	_, err = createWaiter.Wait(ctx)
 	if err != nil {
 		return diag.Errorf("[Error] Waiting for subnet (%s) to be created: %s", createModel.Name, err)
 	}
@@ -96,7 +103,7 @@ func resourceSubnetCreate(ctx context.Context, d *schema.ResourceData, m interfa
 	return resourceSubnetRead(ctx, d, m)
 }
 
-func resourceSubnetRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceSubnetRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	apiClient := m.(*common.Client)
 	service := NewSubnetService(apiClient)
 
@@ -108,8 +115,13 @@ func resourceSubnetRead(_ context.Context, d *schema.ResourceData, m interface{}
 		findModel.VpcId = vpcId.(string)
 	}
 
-	result, err := service.FindSubnet(findModel)
+	result, err := service.FindSubnet(ctx, findModel)
 	if err != nil {
+		if common.IsNotFound(err) {
+			log.Printf("[WARN] Subnet %s not found, removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return diag.Errorf("[ERR] Failed retrieving the subnet: %s", err)
 	}
 	if result == nil {
@@ -138,9 +150,13 @@ func resourceSubnetRead(_ context.Context, d *schema.ResourceData, m interface{}
 		return diag.Errorf("[ERR] Failed to set 'created_at': %s", err)
 	}
 
-	if err := d.Set("tag_ids", result.TagIds); err != nil {
+	configuredTagIds := common.ExpandTagIds(d.Get("tag_ids").(*schema.Set))
+	if err := d.Set("tag_ids", apiClient.Tags.FilterTagIds(result.TagIds, configuredTagIds)); err != nil {
 		return diag.Errorf("[ERR] Failed to set 'tag_ids': %s", err)
 	}
+	if err := d.Set("tag_ids_all", apiClient.Tags.AllTagIds(result.TagIds, configuredTagIds)); err != nil {
+		return diag.Errorf("[ERR] Failed to set 'tag_ids_all': %s", err)
+	}
 
 	if result.PrimaryDNSIp != "" {
 		if err := d.Set("primary_dns_ip", result.PrimaryDNSIp); err != nil {
@@ -157,6 +173,28 @@ func resourceSubnetRead(_ context.Context, d *schema.ResourceData, m interface{}
 	return nil
 }
 
+// resourceSubnetImport imports a subnet by id or name, setting its gateway ip which the read does not
+func resourceSubnetImport(ctx context.Context, d *schema.ResourceData, m interface{}, vpcId string, ref string) (string, error) {
+	service := NewSubnetService(m.(*common.Client))
+
+	result, err := common.FindByIdOrName(ctx, ref,
+		func(ctx context.Context, id string) (*Subnet, error) {
+			return service.FindSubnet(ctx, FindSubnetDTO{NetworkID: id, VpcId: vpcId})
+		},
+		func(ctx context.Context, name string) (*Subnet, error) {
+			return service.FindSubnetByName(ctx, FindSubnetDTO{NetworkName: name, VpcId: vpcId})
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	if err := d.Set("gateway_ip", result.Gateway); err != nil {
+		return "", err
+	}
+	return result.ID, nil
+}
+
 func resourceSubnetUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	apiClient := m.(*common.Client)
 	service := NewSubnetService(apiClient)
@@ -170,16 +208,24 @@ func resourceSubnetUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 			SecondaryDNSIp: d.Get("secondary_dns_ip").(string),
 		}
 
-		_, err := service.UpdateDNS(vpcId, d.Id(), updateDNSDto)
+		_, err := service.UpdateDNS(ctx, vpcId, d.Id(), updateDNSDto)
 		if err != nil {
 			return diag.Errorf("[ERR] An error occurred while updating subnet DNS: %s", err)
 		}
 	}
 
+	if d.HasChanges("tag_ids", "tag_ids_all") {
+		tagIds := common.ExpandTagIds(d.Get("tag_ids").(*schema.Set))
+		_, err := service.UpdateTags(ctx, vpcId, d.Id(), tagIds)
+		if err != nil {
+			return diag.Errorf("[ERR] An error occurred while updating subnet tags %s", err)
+		}
+	}
+
 	return resourceSubnetRead(ctx, d, m)
 }
 
-func resourceSubnetDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceSubnetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	apiClient := m.(*common.Client)
 	service := NewSubnetService(apiClient)
 
@@ -190,7 +236,7 @@ func resourceSubnetDelete(_ context.Context, d *schema.ResourceData, m interface
 		return diag.Errorf("[ERR] Vpc id is required")
 	}
 
-	_, err := service.DeleteSubnet(vpcId.(string), d.Id())
+	_, err := service.DeleteSubnet(ctx, vpcId.(string), d.Id())
 
 	if err != nil {
 		return diag.Errorf("[ERR] An error occurred while trying to delete the subnet %s", err)
@@ -1,11 +1,13 @@
 package fptcloud_subnet
 
 import (
+	"errors"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"net"
 	"strings"
+	common "terraform-provider-fptcloud/commons"
 )
 
 var resourceSubnet = map[string]*schema.Schema{
@@ -26,7 +28,8 @@ var resourceSubnet = map[string]*schema.Schema{
 		Type:        schema.TypeString,
 		Required:    true,
 		Description: "The type of the subnet. `NAT_ROUTED`: To the Internet via a NAT gateway. `ISOLATED`: Subnet won't route to the Internet",
-		ForceNew:    true,
+		// The type, the cidr and the static ip pool force a new subnet in the CustomizeDiff of the resource, as the API
+		// does not return them for an imported subnet
 		ValidateFunc: validation.StringInSlice([]string{
 			"ISOLATED", "NAT_ROUTED",
 		}, false)},
@@ -36,7 +39,6 @@ var resourceSubnet = map[string]*schema.Schema{
 		Required:     true,
 		ValidateFunc: validateCIDR,
 		Description:  "The network address (CIDR) of the subnet. CIDR block format: 10.0.0.1/24",
-		ForceNew:     true,
 	},
 	"gateway_ip": {
 		Type:         schema.TypeString,
@@ -50,7 +52,6 @@ var resourceSubnet = map[string]*schema.Schema{
 		Optional:     true,
 		ValidateFunc: validateIPv4Range,
 		Description:  "The static ip pool of the instance. Only if you want to create subnet with static IP pool, enter an valid IP range within provided CIDR.",
-		ForceNew:     true,
 	},
 	"network_id": {
 		Type:        schema.TypeString,
@@ -77,6 +78,7 @@ var resourceSubnet = map[string]*schema.Schema{
 		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "List of tag IDs associated with the subnet",
 	},
+	"tag_ids_all": common.TagIdsAllSchema(),
 	"primary_dns_ip": {
 		Type:         schema.TypeString,
 		Optional:     true,
@@ -178,3 +180,38 @@ func parseIPRange(ipRange string) (string, string) {
 	}
 	return ips[0], ips[1]
 }
+
+// ParseStaticIpPool validates a static IP pool like the static_ip_pool attribute, and returns its start and end IPs
+func ParseStaticIpPool(ipRange string) (string, string, error) {
+	return parseStaticIpPool(ipRange, "static_ip_pool")
+}
+
+func parseStaticIpPool(ipRange string, k string) (string, string, error) {
+	if _, es := validateIPv4Range(ipRange, k); len(es) > 0 {
+		return "", "", errors.Join(es...)
+	}
+	start, end := parseIPRange(ipRange)
+	return start, end, nil
+}
+
+// IpRangeContains reports whether an IPv4 address is in a static IP pool, such as 10.0.0.2-10.0.0.254, or in a CIDR block
+func IpRangeContains(ipRange string, ip string) (bool, error) {
+	if _, es := validateIPv4Address(ip, "ip"); len(es) > 0 {
+		return false, es[0]
+	}
+	address := net.ParseIP(ip).To4()
+
+	if strings.Contains(ipRange, "/") {
+		if _, es := validateCIDR(ipRange, "ip_range"); len(es) > 0 {
+			return false, es[0]
+		}
+		_, network, _ := net.ParseCIDR(ipRange)
+		return network.Contains(address), nil
+	}
+
+	start, end, err := parseStaticIpPool(ipRange, "ip_range")
+	if err != nil {
+		return false, err
+	}
+	return compareIPs(net.ParseIP(start).To4(), address) <= 0 && compareIPs(address, net.ParseIP(end).To4()) <= 0, nil
+}
@@ -1,6 +1,7 @@
 package fptcloud_subnet
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	common "terraform-provider-fptcloud/commons"
@@ -71,14 +72,14 @@ type ListSubnet struct {
 
 // SubnetService defines the interface for subnet service
 type SubnetService interface {
-	FindSubnet(findDto FindSubnetDTO) (*Subnet, error)
-	FindSubnetByName(findDto FindSubnetDTO) (*Subnet, error)
-	ListSubnet(vpcId string) (*[]Subnet, error)
-	ListSubnetIaas(vpcId string) (*[]Subnet, error)
-	CreateSubnet(createDto CreateSubnetDTO) (*Subnet, error)
-	DeleteSubnet(vpcId string, subnetId string) (bool, error)
-	UpdateTags(vpcId string, subnetId string, tagIds []string) (*common.SimpleResponse, error)
-	UpdateDNS(vpcId string, subnetId string, updateDto UpdateSubnetDNSDTO) (*common.SimpleResponse, error)
+	FindSubnet(ctx context.Context, findDto FindSubnetDTO) (*Subnet, error)
+	FindSubnetByName(ctx context.Context, findDto FindSubnetDTO) (*Subnet, error)
+	ListSubnet(ctx context.Context, vpcId string) (*[]Subnet, error)
+	ListSubnetIaas(ctx context.Context, vpcId string) (*[]Subnet, error)
+	CreateSubnet(ctx context.Context, createDto CreateSubnetDTO) (*Subnet, error)
+	DeleteSubnet(ctx context.Context, vpcId string, subnetId string) (bool, error)
+	UpdateTags(ctx context.Context, vpcId string, subnetId string, tagIds []string) (*common.SimpleResponse, error)
+	UpdateDNS(ctx context.Context, vpcId string, subnetId string, updateDto UpdateSubnetDNSDTO) (*common.SimpleResponse, error)
 }
 
 // SubnetServiceImpl is the implementation of SubnetServiceImpl
@@ -92,9 +93,10 @@ func NewSubnetService(client *common.Client) SubnetService {
 }
 
 // CreateSubnet create a floating ip
-func (s *SubnetServiceImpl) CreateSubnet(createDto CreateSubnetDTO) (*Subnet, error) {
+func (s *SubnetServiceImpl) CreateSubnet(ctx context.Context, createDto CreateSubnetDTO) (*Subnet, error) {
+	createDto.TagIds = s.client.Tags.MergeTagIds(createDto.TagIds, nil)
 	var apiPath = common.ApiPath.CreateSubnet(createDto.VpcId)
-	resp, err := s.client.SendPostRequest(apiPath, createDto)
+	resp, err := s.client.SendPostRequestWithContext(ctx, apiPath, createDto)
 	if err != nil {
 		return nil, common.DecodeError(err)
 	}
@@ -112,9 +114,9 @@ func (s *SubnetServiceImpl) CreateSubnet(createDto CreateSubnetDTO) (*Subnet, er
 }
 
 // FindSubnetByName find a subnet name
-func (s *SubnetServiceImpl) FindSubnetByName(findDto FindSubnetDTO) (*Subnet, error) {
+func (s *SubnetServiceImpl) FindSubnetByName(ctx context.Context, findDto FindSubnetDTO) (*Subnet, error) {
 	var apiPath = common.ApiPath.FindSubnetByName(findDto.VpcId) + utils.ToQueryParams(findDto)
-	resp, err := s.client.SendGetRequest(apiPath)
+	resp, err := s.client.SendGetRequestWithContext(ctx, apiPath)
 	if err != nil {
 		return nil, common.DecodeError(err)
 	}
@@ -132,9 +134,9 @@ func (s *SubnetServiceImpl) FindSubnetByName(findDto FindSubnetDTO) (*Subnet, er
 }
 
 // FindSubnet find a subnet id
-func (s *SubnetServiceImpl) FindSubnet(findDto FindSubnetDTO) (*Subnet, error) {
+func (s *SubnetServiceImpl) FindSubnet(ctx context.Context, findDto FindSubnetDTO) (*Subnet, error) {
 	var apiPath = common.ApiPath.FindSubnet(findDto.VpcId, findDto.NetworkID)
-	resp, err := s.client.SendGetRequest(apiPath)
+	resp, err := s.client.SendGetRequestWithContext(ctx, apiPath)
 	if err != nil {
 		return nil, common.DecodeError(err)
 	}
@@ -152,19 +154,19 @@ func (s *SubnetServiceImpl) FindSubnet(findDto FindSubnetDTO) (*Subnet, error) {
 }
 
 // ListSubnet list subnet
-func (s *SubnetServiceImpl) ListSubnet(vpcId string) (*[]Subnet, error) {
+func (s *SubnetServiceImpl) ListSubnet(ctx context.Context, vpcId string) (*[]Subnet, error) {
 	var apiPath = common.ApiPath.ListSubnets(vpcId)
-	return s.listSubnetByPath(apiPath)
+	return s.listSubnetByPath(ctx, apiPath)
 }
 
 // ListSubnetIaas list subnet from networks-iaas endpoint
-func (s *SubnetServiceImpl) ListSubnetIaas(vpcId string) (*[]Subnet, error) {
+func (s *SubnetServiceImpl) ListSubnetIaas(ctx context.Context, vpcId string) (*[]Subnet, error) {
 	var apiPath = common.ApiPath.ListSubnetsIaas(vpcId)
-	return s.listSubnetByPath(apiPath)
+	return s.listSubnetByPath(ctx, apiPath)
 }
 
-func (s *SubnetServiceImpl) listSubnetByPath(apiPath string) (*[]Subnet, error) {
-	resp, err := s.client.SendGetRequest(apiPath)
+func (s *SubnetServiceImpl) listSubnetByPath(ctx context.Context, apiPath string) (*[]Subnet, error) {
+	resp, err := s.client.SendGetRequestWithContext(ctx, apiPath)
 	if err != nil {
 		return nil, common.DecodeError(err)
 	}
@@ -185,9 +187,9 @@ func (s *SubnetServiceImpl) listSubnetByPath(apiPath string) (*[]Subnet, error)
 }
 
 // DeleteSubnet delete a subnet
-func (s *SubnetServiceImpl) DeleteSubnet(vpcId string, subnetId string) (bool, error) {
+func (s *SubnetServiceImpl) DeleteSubnet(ctx context.Context, vpcId string, subnetId string) (bool, error) {
 	var apiPath = common.ApiPath.DeleteSubnet(vpcId, subnetId)
-	resp, err := s.client.SendDeleteRequest(apiPath)
+	resp, err := s.client.SendDeleteRequestWithContext(ctx, apiPath)
 	if err != nil {
 		return false, common.DecodeError(err)
 	}
@@ -205,12 +207,23 @@ func (s *SubnetServiceImpl) DeleteSubnet(vpcId string, subnetId string) (bool, e
 }
 
 // UpdateTags updates the tags associated with a subnet
-func (s *SubnetServiceImpl) UpdateTags(vpcId string, subnetId string, tagIds []string) (*common.SimpleResponse, error) {
+func (s *SubnetServiceImpl) UpdateTags(ctx context.Context, vpcId string, subnetId string, tagIds []string) (*common.SimpleResponse, error) {
+	tagIds, err := s.client.Tags.MergeAppliedTagIds(ctx, tagIds, func(ctx context.Context) ([]string, error) {
+		subnet, err := s.FindSubnet(ctx, FindSubnetDTO{NetworkID: subnetId, VpcId: vpcId})
+		if err != nil {
+			return nil, err
+		}
+		return subnet.TagIds, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	var apiPath = common.ApiPath.UpdateSubnetTags(vpcId, subnetId)
 	payload := map[string][]string{
 		"tag_ids": tagIds,
 	}
-	_, err := s.client.SendPutRequest(apiPath, payload)
+	_, err = s.client.SendPutRequestWithContext(ctx, apiPath, payload)
 	if err != nil {
 		return nil, common.DecodeError(err)
 	}
@@ -232,9 +245,9 @@ type UpdateDNSResponse struct {
 }
 
 // UpdateDNS updates the DNS settings of a subnet
-func (s *SubnetServiceImpl) UpdateDNS(vpcId string, subnetId string, updateDto UpdateSubnetDNSDTO) (*common.SimpleResponse, error) {
+func (s *SubnetServiceImpl) UpdateDNS(ctx context.Context, vpcId string, subnetId string, updateDto UpdateSubnetDNSDTO) (*common.SimpleResponse, error) {
 	var apiPath = common.ApiPath.EditSubnetDNS(vpcId, subnetId)
-	resp, err := s.client.SendPutRequest(apiPath, updateDto)
+	resp, err := s.client.SendPutRequestWithContext(ctx, apiPath, updateDto)
 	if err != nil {
 		return nil, common.DecodeError(err)
 	}
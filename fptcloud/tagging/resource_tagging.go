@@ -81,6 +81,11 @@ func resourceTaggingRead(ctx context.Context, d *schema.ResourceData, m interfac
 
 	tagDetail, err := service.Get(ctx, d.Id())
 	if err != nil {
+		if common.IsNotFound(err) {
+			log.Printf("[WARN] Tag %s not found, removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return diag.FromErr(err)
 	}
 
@@ -41,7 +41,7 @@ func (s *TaggingServiceImpl) Create(ctx context.Context, input *CreateTagInput)
 
 	apiPath := common.ApiPath.CreateTag(tenant.Id)
 
-	resp, err := s.client.SendPostRequest(apiPath, input)
+	resp, err := s.client.SendPostRequestWithContext(ctx, apiPath, input)
 
 	if err != nil {
 		return nil, common.DecodeError(err)
@@ -64,7 +64,7 @@ func (s *TaggingServiceImpl) Get(ctx context.Context, tagId string) (*Tag, error
 	}
 
 	apiPath := common.ApiPath.GetTag(tenant.Id, tagId)
-	resp, err := s.client.SendGetRequest(apiPath)
+	resp, err := s.client.SendGetRequestWithContext(ctx, apiPath)
 	if err != nil {
 		return nil, common.DecodeError(err)
 	}
@@ -90,7 +90,7 @@ func (s *TaggingServiceImpl) Update(ctx context.Context, tagId string, input *Up
 	}
 
 	apiPath := common.ApiPath.UpdateTag(tenant.Id, tagId)
-	resp, err := s.client.SendPutRequest(apiPath, input)
+	resp, err := s.client.SendPutRequestWithContext(ctx, apiPath, input)
 	if err != nil {
 		return nil, common.DecodeError(err)
 	}
@@ -112,7 +112,7 @@ func (s *TaggingServiceImpl) Delete(ctx context.Context, tagId string) (*common.
 	}
 
 	apiPath := common.ApiPath.DeleteTag(tenant.Id, tagId)
-	_, err = s.client.SendDeleteRequest(apiPath)
+	_, err = s.client.SendDeleteRequestWithContext(ctx, apiPath)
 	if err != nil {
 		return nil, common.DecodeError(err)
 	}
@@ -144,7 +144,7 @@ func (s *TaggingServiceImpl) List(ctx context.Context, key string, value string)
 		apiPath = fmt.Sprintf("%s?%s", apiPath, encoded)
 	}
 
-	resp, err := s.client.SendGetRequest(apiPath)
+	resp, err := s.client.SendGetRequestWithContext(ctx, apiPath)
 	if err != nil {
 		return nil, common.DecodeError(err)
 	}
@@ -1,6 +1,7 @@
 package fptcloud_vgpu
 
 import (
+	"context"
 	"fmt"
 	common "terraform-provider-fptcloud/commons"
 	data_list "terraform-provider-fptcloud/commons/data-list"
@@ -113,7 +114,7 @@ func flattenVGpu(vgpu, _ interface{}, _ map[string]interface{}) (map[string]inte
 	return flattened, nil
 }
 
-func getVGpus(m interface{}, extra map[string]interface{}) ([]interface{}, error) {
+func getVGpus(ctx context.Context, m interface{}, extra map[string]interface{}) ([]interface{}, error) {
 	apiClient := m.(*common.Client)
 	vgpuService := NewVGpuService(apiClient)
 
@@ -122,7 +123,7 @@ func getVGpus(m interface{}, extra map[string]interface{}) ([]interface{}, error
 		return nil, fmt.Errorf("[ERR] vpc id is required")
 	}
 
-	vgpus, err := vgpuService.ListVGpu(vpcId)
+	vgpus, err := vgpuService.ListVGpu(ctx, vpcId)
 	if err != nil {
 		return nil, fmt.Errorf("[ERR] Failed to retrieve vGPUs: %s", err)
 	}
@@ -1,6 +1,7 @@
 package fptcloud_vgpu
 
 import (
+	"context"
 	"encoding/json"
 	common "terraform-provider-fptcloud/commons"
 )
@@ -22,7 +23,7 @@ type VGpu struct {
 
 // VGpuService defines the interface for vGPU service
 type VGpuService interface {
-	ListVGpu(vpcId string) (*[]VGpu, error)
+	ListVGpu(ctx context.Context, vpcId string) (*[]VGpu, error)
 }
 
 // VGpuServiceImpl is the implementation of VGpuService
@@ -36,9 +37,9 @@ func NewVGpuService(client *common.Client) VGpuService {
 }
 
 // ListVGpu get list vGPU
-func (s *VGpuServiceImpl) ListVGpu(vpcId string) (*[]VGpu, error) {
+func (s *VGpuServiceImpl) ListVGpu(ctx context.Context, vpcId string) (*[]VGpu, error) {
 	var apiPath = common.ApiPath.GetGPUInfo(vpcId)
-	resp, err := s.client.SendGetRequest(apiPath)
+	resp, err := s.client.SendCachedGetRequestWithContext(ctx, apiPath)
 	if err != nil {
 		return nil, common.DecodeError(err)
 	}
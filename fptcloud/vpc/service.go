@@ -26,7 +26,7 @@ func NewService(client *common.Client) Service {
 
 func (s *serviceImpl) GetTenant(ctx context.Context) (*Tenant, error) {
 	reqURL := common.ApiPath.Tenant(s.client.TenantName)
-	resp, err := s.client.SendGetRequest(reqURL)
+	resp, err := s.client.SendCachedGetRequestWithContext(ctx, reqURL)
 	if err != nil {
 		return nil, err
 	}
@@ -43,7 +43,7 @@ func (s *serviceImpl) GetTenant(ctx context.Context) (*Tenant, error) {
 
 func (s *serviceImpl) FindVPC(ctx context.Context, tenantId string, search FindVPCParam) (*VPC, error) {
 	reqURL := common.ApiPath.Vpc(tenantId) + utils.ToQueryParams(search)
-	resp, err := s.client.SendGetRequest(reqURL)
+	resp, err := s.client.SendGetRequestWithContext(ctx, reqURL)
 	if err != nil {
 		return nil, err
 	}